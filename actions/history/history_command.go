@@ -0,0 +1,114 @@
+package history
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/spf13/cobra"
+)
+
+const (
+	noOperationsMessage            = "No operations have been sent to Todoist yet"
+	irreversibleOperationNote      = " (cannot be undone, undo stops here unless --skip-irreversible is provided)"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorInvalidLimit              = "Error, the number of operations to list must be at least 1"
+)
+
+// recordColumns are the names of the fields of an operation in machine readable output
+var recordColumns = []string{"position", "time", "description", "undone", "reversible", "undo"}
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	replicaService        replica.Service
+}
+
+// NewHistoryCommand creates an instance of the command that lists the operations sent to Todoist
+func NewHistoryCommand(o io.Writer, a authentication.Service, r replica.Service) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		replicaService:        r,
+	}
+
+	limit := 20
+
+	var historyCommand = &cobra.Command{
+		Use:   "history",
+		Short: "List recent operations",
+		Long: "List the most recent operations sent to Todoist.com, oldest first, showing which have been undone and which cannot be undone. " +
+			"'todoist undo' does not go past an operation that cannot be undone unless --skip-irreversible is provided.",
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, limit)
+		},
+	}
+
+	historyCommand.Flags().IntVarP(&limit, "limit", "n", 20, "the number of most recent operations to list")
+
+	return historyCommand
+}
+
+func execute(d *dependencies, limit int) error {
+	if limit < 1 {
+		return failures.New(failures.Validation, errorInvalidLimit)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	history, err := d.replicaService.History()
+	if err != nil {
+		return err
+	}
+
+	first := 0
+	if len(history.Entries) > limit {
+		first = len(history.Entries) - limit
+	}
+	entries := history.Entries[first:]
+
+	records := make([]output.Record, len(entries))
+	for index, entry := range entries {
+		records[index] = output.Record{
+			{Name: "position", Value: first + index + 1},
+			{Name: "time", Value: entry.Time.Local().Format("2006-01-02T15:04:05")},
+			{Name: "description", Value: entry.Description},
+			{Name: "undone", Value: history.IsUndone(entry.ID)},
+			{Name: "reversible", Value: entry.Reversible},
+			{Name: "undo", Value: entry.IsUndo()},
+		}
+	}
+
+	return output.WriteList(d.outputStream, recordColumns, records, func() {
+		writeText(d, history, entries, first)
+	})
+}
+
+func writeText(d *dependencies, history *journal.Journal, entries []journal.Entry, first int) {
+	if len(entries) == 0 {
+		fmt.Fprint(d.outputStream, noOperationsMessage)
+		return
+	}
+
+	writer := tabwriter.NewWriter(d.outputStream, 0, 8, 1, '\t', 0)
+	for index, entry := range entries {
+		description := entry.Description
+		switch {
+		case history.IsUndone(entry.ID):
+			description += " (undone)"
+		case !entry.Reversible && !entry.IsUndo():
+			description += irreversibleOperationNote
+		}
+		fmt.Fprintf(writer, "[%d]\t%s\t%s\n", first+index+1, entry.Time.Local().Format("2006-01-02 15:04"), description)
+	}
+	writer.Flush()
+}
@@ -0,0 +1,109 @@
+package history
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+
+	historyCommand := NewHistoryCommand(&bytes.Buffer{}, mockAuthenticationService, nil)
+	err := historyCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	operationTime := time.Date(2020, 4, 10, 9, 30, 0, 0, time.Local)
+	history := &journal.Journal{
+		Entries: []journal.Entry{
+			{ID: "1", Time: operationTime, Description: "delete task 'Old'"},
+			{ID: "2", Time: operationTime, Description: "complete task 'Buy milk'", Reversible: true},
+			{ID: "3", Time: operationTime, Description: "add task 'Pay rent'", Reversible: true},
+			{ID: "4", Time: operationTime, Description: "undo add task 'Pay rent'", Undoes: []string{"3"}},
+		},
+	}
+	mockReplicaService := &mocks.MockReplicaService{
+		HistoryFunc: func() (*journal.Journal, error) {
+			return history, nil
+		},
+	}
+
+	t.Run("When listing the history, then each operation is written with whether it has been or can be undone", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		historyCommand := NewHistoryCommand(mockOutputStream, authenticated, mockReplicaService)
+		err := historyCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "[1]\t2020-04-10 09:30\tdelete task 'Old'"+irreversibleOperationNote+"\n"+
+			"[2]\t2020-04-10 09:30\tcomplete task 'Buy milk'\n"+
+			"[3]\t2020-04-10 09:30\tadd task 'Pay rent' (undone)\n"+
+			"[4]\t2020-04-10 09:30\tundo add task 'Pay rent'\n", mockOutputStream.String())
+
+	})
+
+	t.Run("When listing a limited number of operations, then only the most recent operations are written", func(t *testing.T) {
+
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("json")
+
+		historyCommand := NewHistoryCommand(outputStream, authenticated, mockReplicaService)
+		historyCommand.SetArgs([]string{"--limit", "1"})
+		historyCommand.Execute()
+
+		assert.Contains(t, buffer.String(), `"position": 4`)
+		assert.Contains(t, buffer.String(), `"undo": true`)
+		assert.NotContains(t, buffer.String(), `"position": 3`)
+
+	})
+
+	t.Run("When nothing has been sent to Todoist, then a message is written", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		emptyReplicaService := &mocks.MockReplicaService{
+			HistoryFunc: func() (*journal.Journal, error) {
+				return &journal.Journal{}, nil
+			},
+		}
+
+		historyCommand := NewHistoryCommand(mockOutputStream, authenticated, emptyReplicaService)
+		historyCommand.Execute()
+
+		assert.Equal(t, noOperationsMessage, mockOutputStream.String())
+
+	})
+
+	t.Run("When the history cannot be read, then the error is returned", func(t *testing.T) {
+
+		failingReplicaService := &mocks.MockReplicaService{
+			HistoryFunc: func() (*journal.Journal, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		historyCommand := NewHistoryCommand(&bytes.Buffer{}, authenticated, failingReplicaService)
+		err := historyCommand.Execute()
+
+		assert.Equal(t, "test error", err.Error())
+
+	})
+
+}
@@ -0,0 +1,70 @@
+package add
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/labels/services"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successfullyAddedLabel = "Label has been added"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNameNotProvided           = "Error, a name must be provided when creating a label"
+	errorLabelNotAdded             = "Error, the label could not be added, please try again later"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	labelService          services.LabelService
+}
+
+// NewAddLabelCommand creates an instance of the command that adds a label on Todoist
+func NewAddLabelCommand(o io.Writer, a authentication.Service, l services.LabelService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		labelService:          l,
+	}
+
+	name := ""
+
+	var addLabelCommand = &cobra.Command{
+		Use:   "add",
+		Short: "Add label",
+		Long:  "Adds a label that can be attached to tasks",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, name)
+		},
+	}
+
+	addLabelCommand.Flags().StringVarP(&name, "name", "n", "", "the name of the label")
+
+	return addLabelCommand
+}
+
+func execute(d *dependencies, name string) error {
+	if name == "" {
+		return failures.New(failures.Validation, errorNameNotProvided)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.labelService.AddLabel(name)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorLabelNotAdded)
+	}
+
+	output.WriteMessage(d.outputStream, successfullyAddedLabel)
+	return warning
+}
@@ -0,0 +1,95 @@
+package add
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	addLabelCommand := NewAddLabelCommand(mockOutputStream, mockAuthenticationService, nil)
+	addLabelCommand.SetArgs([]string{
+		`-n=errand`,
+	})
+
+	err := addLabelCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestInputParameters(t *testing.T) {
+
+	t.Run("If no name is provided, then an error stating so is written to the console", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		addLabelCommand := NewAddLabelCommand(mockOutputStream, mockAuthenticationService, nil)
+		err := addLabelCommand.Execute()
+
+		assert.Equal(t, errorNameNotProvided, err.Error())
+
+	})
+}
+
+func TestAddingALabel(t *testing.T) {
+
+	t.Run("When creating a label and an error occurs, an error stating that the label wasn't added is written to console", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockLabelService := &mocks.MockLabelService{
+			AddLabelFunc: func(name string) error {
+				return errors.New("error while adding label")
+			},
+		}
+
+		addLabelCommand := NewAddLabelCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		addLabelCommand.SetArgs([]string{
+			`-n=errand`,
+		})
+
+		err := addLabelCommand.Execute()
+
+		assert.Equal(t, errorLabelNotAdded, err.Error())
+
+	})
+
+	t.Run("When creating a label and no error occurs, then a message stating that the label was created is written to console", func(t *testing.T) {
+		addedName := ""
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockLabelService := &mocks.MockLabelService{
+			AddLabelFunc: func(name string) error {
+				addedName = name
+				return nil
+			},
+		}
+
+		addLabelCommand := NewAddLabelCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		addLabelCommand.SetArgs([]string{
+			`-n=errand`,
+		})
+
+		addLabelCommand.Execute()
+
+		assert.Equal(t, successfullyAddedLabel, mockOutputStream.String())
+		assert.Equal(t, "errand", addedName)
+
+	})
+
+}
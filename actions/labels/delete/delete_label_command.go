@@ -0,0 +1,64 @@
+package delete
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/labels/services"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorFailedToDeleteLabel       = "An error occurred while deleting the label"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	successLabelDeleted            = "The label has successfully been deleted"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	labelService          services.LabelService
+}
+
+// NewDeleteLabelCommand creates an instance of the command that deletes a label, the tasks it was attached to are kept without it
+func NewDeleteLabelCommand(o io.Writer, a authentication.Service, l services.LabelService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		labelService:          l,
+	}
+
+	labelID := 0
+
+	var deleteLabelCommand = &cobra.Command{
+		Use:   "delete",
+		Short: "Delete label",
+		Long:  "Delete a label and remove it from all tasks given a label id",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(labelID))
+		},
+	}
+
+	deleteLabelCommand.Flags().IntVarP(&labelID, "id", "i", 0, "the id of the label to delete")
+
+	return deleteLabelCommand
+}
+
+func execute(d *dependencies, labelID uint32) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.labelService.DeleteLabel(labelID)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToDeleteLabel)
+	}
+
+	output.WriteMessage(d.outputStream, successLabelDeleted)
+	return warning
+}
@@ -0,0 +1,69 @@
+package delete
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	deleteLabelCommand := NewDeleteLabelCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := deleteLabelCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and an error occurs, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockLabelService := &mocks.MockLabelService{
+			DeleteLabelFunc: func(uint32) error {
+				return errors.New("test error")
+			},
+		}
+
+		deleteLabelCommand := NewDeleteLabelCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		err := deleteLabelCommand.Execute()
+
+		assert.Equal(t, errorFailedToDeleteLabel, err.Error())
+
+	})
+
+	t.Run("When authenticated and no error occurs, then message is written to output stream", func(t *testing.T) {
+
+		var requestedLabelID uint32
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockLabelService := &mocks.MockLabelService{
+			DeleteLabelFunc: func(labelID uint32) error {
+				requestedLabelID = labelID
+				return nil
+			},
+		}
+
+		deleteLabelCommand := NewDeleteLabelCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		deleteLabelCommand.SetArgs([]string{"-i=3"})
+		deleteLabelCommand.Execute()
+
+		assert.Equal(t, successLabelDeleted, mockOutputStream.String())
+		assert.Equal(t, uint32(3), requestedLabelID)
+
+	})
+
+}
@@ -0,0 +1,29 @@
+package labels
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/labels/add"
+	"github.com/kpdowns/todoist-cli/actions/labels/delete"
+	"github.com/kpdowns/todoist-cli/actions/labels/list"
+	"github.com/kpdowns/todoist-cli/actions/labels/rename"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/labels/services"
+	"github.com/spf13/cobra"
+)
+
+// NewLabelsCommand creates a new instance of the labels command
+func NewLabelsCommand(o io.Writer, authenticationService authentication.Service, labelService services.LabelService) *cobra.Command {
+	var labelsCommand = &cobra.Command{
+		Use:   "labels",
+		Short: "Manage labels",
+		Long:  "Manage the labels that can be attached to tasks on Todoist.com",
+	}
+
+	labelsCommand.AddCommand(list.NewListLabelsCommand(o, authenticationService, labelService))
+	labelsCommand.AddCommand(add.NewAddLabelCommand(o, authenticationService, labelService))
+	labelsCommand.AddCommand(rename.NewRenameLabelCommand(o, authenticationService, labelService))
+	labelsCommand.AddCommand(delete.NewDeleteLabelCommand(o, authenticationService, labelService))
+
+	return labelsCommand
+}
@@ -0,0 +1,39 @@
+package labels
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandCreation(t *testing.T) {
+
+	expectedSubCommands := []string{"list", "add", "rename", "delete"}
+
+	for _, expectedSubCommand := range expectedSubCommands {
+		expectedSubCommand := expectedSubCommand
+
+		t.Run("Sub command '"+expectedSubCommand+"' is added", func(t *testing.T) {
+
+			mockOutputStream := &bytes.Buffer{}
+			mockAuthenticationService := &mocks.MockAuthenticationService{}
+			mockLabelService := &mocks.MockLabelService{}
+
+			labelsCommand := NewLabelsCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+
+			found := false
+			for _, registeredCommand := range labelsCommand.Commands() {
+				if registeredCommand.Use == expectedSubCommand {
+					found = true
+					break
+				}
+			}
+
+			assert.True(t, found)
+
+		})
+	}
+
+}
@@ -0,0 +1,65 @@
+package list
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/labels/services"
+	"github.com/kpdowns/todoist-cli/labels/types"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	noLabelsMessage                = "There are no labels on your account"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	labelService          services.LabelService
+}
+
+// NewListLabelsCommand creates an instance of the command that prints all labels to the console
+func NewListLabelsCommand(o io.Writer, a authentication.Service, l services.LabelService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		labelService:          l,
+	}
+
+	var listLabelsCommand = &cobra.Command{
+		Use:   "list",
+		Short: "List labels",
+		Long:  "List all personal labels on Todoist.com",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies)
+		},
+	}
+
+	return listLabelsCommand
+}
+
+func execute(d *dependencies) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	labels, err := d.labelService.GetAllLabels()
+	if err != nil {
+		return err
+	}
+
+	return output.WriteList(d.outputStream, types.RecordColumns(), labels.AsRecords(), func() {
+		writeText(d, labels)
+	})
+}
+
+func writeText(d *dependencies, labels types.LabelList) {
+	output.WriteRows(d.outputStream, noLabelsMessage, len(labels), func(index int) string {
+		return labels[index].AsString()
+	})
+}
@@ -0,0 +1,111 @@
+package list
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/labels/types"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	listLabelsCommand := NewListLabelsCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := listLabelsCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and an error occurs while retrieving labels, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockLabelService := &mocks.MockLabelService{
+			GetAllLabelsFunc: func() (types.LabelList, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listLabelsCommand := NewListLabelsCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		err := listLabelsCommand.Execute()
+
+		assert.Equal(t, "test error", err.Error())
+
+	})
+
+	t.Run("When authenticated and there are no labels, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockLabelService := &mocks.MockLabelService{
+			GetAllLabelsFunc: func() (types.LabelList, error) {
+				return types.LabelList{}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listLabelsCommand := NewListLabelsCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		listLabelsCommand.Execute()
+
+		assert.Equal(t, noLabelsMessage, mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and there are labels, those labels are written to output stream", func(t *testing.T) {
+
+		labelToBeWritten := types.Label{
+			ID:   1,
+			Name: "errand",
+		}
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockLabelService := &mocks.MockLabelService{
+			GetAllLabelsFunc: func() (types.LabelList, error) {
+				return types.LabelList{labelToBeWritten}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listLabelsCommand := NewListLabelsCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		listLabelsCommand.Execute()
+
+		assert.Equal(t, labelToBeWritten.AsString()+"\n", mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and the output is YAML, then the labels are written as a YAML list of records", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockLabelService := &mocks.MockLabelService{
+			GetAllLabelsFunc: func() (types.LabelList, error) {
+				return types.LabelList{{ID: 1, TodoistID: 10, Name: "errand"}}, nil
+			},
+		}
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("yaml")
+
+		listLabelsCommand := NewListLabelsCommand(outputStream, mockAuthenticationService, mockLabelService)
+		listLabelsCommand.Execute()
+
+		assert.Equal(t, "- id: 1\n  todoist_id: 10\n  name: errand\n  color: 0\n  item_order: 0\n  favorite: false\n", buffer.String())
+
+	})
+
+}
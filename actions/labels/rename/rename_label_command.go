@@ -0,0 +1,72 @@
+package rename
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/labels/services"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successfullyRenamedLabel = "The label has successfully been renamed"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNameNotProvided           = "Error, a new name must be provided when renaming a label"
+	errorFailedToRenameLabel       = "An error occurred while renaming the label"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	labelService          services.LabelService
+}
+
+// NewRenameLabelCommand creates an instance of the command that renames a label
+func NewRenameLabelCommand(o io.Writer, a authentication.Service, l services.LabelService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		labelService:          l,
+	}
+
+	labelID := 0
+	name := ""
+
+	var renameLabelCommand = &cobra.Command{
+		Use:   "rename",
+		Short: "Rename label",
+		Long:  "Change the name of a label given a label id",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(labelID), name)
+		},
+	}
+
+	renameLabelCommand.Flags().IntVarP(&labelID, "id", "i", 0, "the id of the label to rename")
+	renameLabelCommand.Flags().StringVarP(&name, "name", "n", "", "the new name of the label")
+
+	return renameLabelCommand
+}
+
+func execute(d *dependencies, labelID uint32, name string) error {
+	if name == "" {
+		return failures.New(failures.Validation, errorNameNotProvided)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.labelService.RenameLabel(labelID, name)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToRenameLabel)
+	}
+
+	output.WriteMessage(d.outputStream, successfullyRenamedLabel)
+	return warning
+}
@@ -0,0 +1,89 @@
+package rename
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	renameLabelCommand := NewRenameLabelCommand(mockOutputStream, mockAuthenticationService, nil)
+	renameLabelCommand.SetArgs([]string{"-i=1", "-n=chores"})
+	err := renameLabelCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("If no name is provided, then an error stating so is written to the output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		renameLabelCommand := NewRenameLabelCommand(mockOutputStream, mockAuthenticationService, nil)
+		renameLabelCommand.SetArgs([]string{"-i=1"})
+		err := renameLabelCommand.Execute()
+
+		assert.Equal(t, errorNameNotProvided, err.Error())
+
+	})
+
+	t.Run("When authenticated and an error occurs while renaming the label, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockLabelService := &mocks.MockLabelService{
+			RenameLabelFunc: func(uint32, string) error {
+				return errors.New("test error")
+			},
+		}
+
+		renameLabelCommand := NewRenameLabelCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		renameLabelCommand.SetArgs([]string{"-i=1", "-n=chores"})
+		err := renameLabelCommand.Execute()
+
+		assert.Equal(t, errorFailedToRenameLabel, err.Error())
+
+	})
+
+	t.Run("When authenticated and no error occurs while renaming the label, then message is written to output stream", func(t *testing.T) {
+
+		var renamedLabelID uint32
+		renamedTo := ""
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockLabelService := &mocks.MockLabelService{
+			RenameLabelFunc: func(labelID uint32, name string) error {
+				renamedLabelID = labelID
+				renamedTo = name
+				return nil
+			},
+		}
+
+		renameLabelCommand := NewRenameLabelCommand(mockOutputStream, mockAuthenticationService, mockLabelService)
+		renameLabelCommand.SetArgs([]string{"-i=2", "-n=chores"})
+		renameLabelCommand.Execute()
+
+		assert.Equal(t, successfullyRenamedLabel, mockOutputStream.String())
+		assert.Equal(t, uint32(2), renamedLabelID)
+		assert.Equal(t, "chores", renamedTo)
+
+	})
+
+}
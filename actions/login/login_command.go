@@ -1,13 +1,13 @@
 package login
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
 	"github.com/kpdowns/todoist-cli/authentication"
-
 	"github.com/kpdowns/todoist-cli/config"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
 	"github.com/spf13/cobra"
 )
 
@@ -39,11 +39,8 @@ func NewLoginCommand(outputStream io.Writer, authenticationService authenticatio
 		Short: "Start the authentication process against Todoist",
 		Long:  "Starts the Oauth login flow on Todoist.com which will allow todoist-cli to access your tasks and projects",
 		Args:  cobra.NoArgs,
-		Run: func(command *cobra.Command, args []string) {
-			err := execute(dependencies)
-			if err != nil {
-				fmt.Fprint(outputStream, err.Error())
-			}
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies)
 		},
 	}
 
@@ -53,7 +50,7 @@ func NewLoginCommand(outputStream io.Writer, authenticationService authenticatio
 func execute(d *dependencies) error {
 	isAuthenticated, err := d.authenticationService.IsAuthenticated()
 	if isAuthenticated {
-		return errors.New(errorAlreadyAuthenticatedText)
+		return failures.New(failures.Validation, errorAlreadyAuthenticatedText)
 	}
 
 	if err != nil {
@@ -66,10 +63,10 @@ func execute(d *dependencies) error {
 
 	err = d.authenticationService.SignIn(d.guid)
 	if err != nil {
-		return errors.New(errorDuringAuthentication)
+		return failures.Wrap(err, errorDuringAuthentication)
 	}
 
-	fmt.Fprint(d.outputStream, successfullyAuthenticated)
+	output.WriteMessage(d.outputStream, successfullyAuthenticated)
 
 	return nil
 }
@@ -42,9 +42,9 @@ func TestIfAlreadyAuthenticatedThenErrorIsReturnedWhenExecutingCommand(t *testin
 	}
 
 	loginCommand := NewLoginCommand(mockOutputStream, authenticationService, guid)
-	loginCommand.Execute()
+	err := loginCommand.Execute()
 
-	actualText := mockOutputStream.String()
+	actualText := err.Error()
 	expectedText := errorAlreadyAuthenticatedText
 	if actualText != expectedText {
 		t.Errorf("Expected '%s', but got '%s'", errorAlreadyAuthenticatedText, actualText)
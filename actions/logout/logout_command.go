@@ -1,11 +1,15 @@
 package logout
 
 import (
-	"errors"
-	"fmt"
 	"io"
 
 	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	labelRepositories "github.com/kpdowns/todoist-cli/labels/repositories"
+	"github.com/kpdowns/todoist-cli/output"
+	projectRepositories "github.com/kpdowns/todoist-cli/projects/repositories"
+	"github.com/kpdowns/todoist-cli/replica"
+	taskRepositories "github.com/kpdowns/todoist-cli/tasks/repositories"
 	"github.com/spf13/cobra"
 )
 
@@ -17,13 +21,21 @@ const (
 type dependencies struct {
 	outputStream          io.Writer
 	authenticationService authentication.Service
+	replicaService        replica.Service
+	taskRepository        taskRepositories.TaskRepository
+	projectRepository     projectRepositories.ProjectRepository
+	labelRepository       labelRepositories.LabelRepository
 }
 
-// NewLogoutCommand creates a new instance of the authentication command
-func NewLogoutCommand(outputStream io.Writer, authenticationService authentication.Service) *cobra.Command {
+// NewLogoutCommand creates a new instance of the authentication command, logging out also clears the tasks, projects and labels stored locally
+func NewLogoutCommand(outputStream io.Writer, authenticationService authentication.Service, replicaService replica.Service, taskRepository taskRepositories.TaskRepository, projectRepository projectRepositories.ProjectRepository, labelRepository labelRepositories.LabelRepository) *cobra.Command {
 	var dependencies = &dependencies{
 		outputStream:          outputStream,
 		authenticationService: authenticationService,
+		replicaService:        replicaService,
+		taskRepository:        taskRepository,
+		projectRepository:     projectRepository,
+		labelRepository:       labelRepository,
 	}
 
 	var authenticateCommand = &cobra.Command{
@@ -31,11 +43,8 @@ func NewLogoutCommand(outputStream io.Writer, authenticationService authenticati
 		Short: "Logout of Todoist.com",
 		Long:  "Logout of Todoist.com by clearing saved access tokens and revoking access",
 		Args:  cobra.NoArgs,
-		Run: func(command *cobra.Command, args []string) {
-			err := execute(dependencies)
-			if err != nil {
-				fmt.Fprint(outputStream, err.Error())
-			}
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies)
 		},
 	}
 
@@ -45,7 +54,7 @@ func NewLogoutCommand(outputStream io.Writer, authenticationService authenticati
 func execute(dependencies *dependencies) error {
 	isAuthenticated, _ := dependencies.authenticationService.IsAuthenticated()
 	if !isAuthenticated {
-		return errors.New(errorNotCurrentlyAuthenticated)
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
 	}
 
 	err := dependencies.authenticationService.SignOut()
@@ -53,7 +62,27 @@ func execute(dependencies *dependencies) error {
 		return err
 	}
 
-	fmt.Fprint(dependencies.outputStream, successfullyLoggedOut)
+	err = dependencies.replicaService.Clear()
+	if err != nil {
+		return err
+	}
+
+	err = dependencies.taskRepository.DeleteAll()
+	if err != nil {
+		return err
+	}
+
+	err = dependencies.projectRepository.DeleteAll()
+	if err != nil {
+		return err
+	}
+
+	err = dependencies.labelRepository.DeleteAll()
+	if err != nil {
+		return err
+	}
+
+	output.WriteMessage(dependencies.outputStream, successfullyLoggedOut)
 
 	return nil
 }
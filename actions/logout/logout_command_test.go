@@ -2,6 +2,7 @@ package logout
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/kpdowns/todoist-cli/mocks"
@@ -13,11 +14,15 @@ func TestIfNotAuthenticatedThenLoggingOutThrowsAnError(t *testing.T) {
 		AuthenticatedStateToReturn: false,
 	}
 
-	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService)
-	logoutCommand.Execute()
+	mockReplicaService := &mocks.MockReplicaService{
+		ClearFunc: func() error { return nil },
+	}
+
+	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService, mockReplicaService, newMockTaskRepository(), newMockProjectRepository(), newMockLabelRepository())
+	err := logoutCommand.Execute()
 
 	expectedPrompt := errorNotCurrentlyAuthenticated
-	actualPrompt := mockOutputStream.String()
+	actualPrompt := err.Error()
 	if expectedPrompt != actualPrompt {
 		t.Errorf("Received '%s', expected '%s'", actualPrompt, expectedPrompt)
 	}
@@ -29,7 +34,11 @@ func TestIfAuthenticatedAndRevokingAccessTokensReturnsNoErrorsThenNoErrorsAreRet
 		AuthenticatedStateToReturn: true,
 	}
 
-	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService)
+	mockReplicaService := &mocks.MockReplicaService{
+		ClearFunc: func() error { return nil },
+	}
+
+	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService, mockReplicaService, newMockTaskRepository(), newMockProjectRepository(), newMockLabelRepository())
 	logoutCommand.Execute()
 
 	expectedPrompt := successfullyLoggedOut
@@ -45,7 +54,11 @@ func TestIfAuthenticatedAndLoggingOutThenSuccessfullyLoggedOut(t *testing.T) {
 		AuthenticatedStateToReturn: true,
 	}
 
-	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService)
+	mockReplicaService := &mocks.MockReplicaService{
+		ClearFunc: func() error { return nil },
+	}
+
+	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService, mockReplicaService, newMockTaskRepository(), newMockProjectRepository(), newMockLabelRepository())
 	logoutCommand.Execute()
 
 	isLoggedOut, _ := mockAuthenticationService.IsAuthenticated()
@@ -53,3 +66,105 @@ func TestIfAuthenticatedAndLoggingOutThenSuccessfullyLoggedOut(t *testing.T) {
 		t.Error("Expected to have been logged out")
 	}
 }
+
+func TestIfAuthenticatedAndLoggingOutThenTheLocalReplicaIsCleared(t *testing.T) {
+	mockOutputStream := &bytes.Buffer{}
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	wasReplicaCleared := false
+	mockReplicaService := &mocks.MockReplicaService{
+		ClearFunc: func() error {
+			wasReplicaCleared = true
+			return nil
+		},
+	}
+
+	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService, mockReplicaService, newMockTaskRepository(), newMockProjectRepository(), newMockLabelRepository())
+	logoutCommand.Execute()
+
+	if !wasReplicaCleared {
+		t.Error("Expected the local replica to have been cleared")
+	}
+}
+
+func TestIfAuthenticatedAndLoggingOutThenTheLocallyStoredTasksProjectsAndLabelsAreDeleted(t *testing.T) {
+	mockOutputStream := &bytes.Buffer{}
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	mockReplicaService := &mocks.MockReplicaService{
+		ClearFunc: func() error { return nil },
+	}
+
+	wereTasksDeleted, wereProjectsDeleted, wereLabelsDeleted := false, false, false
+	mockTaskRepository := &mocks.MockTaskRepository{
+		DeleteAllFunc: func() error {
+			wereTasksDeleted = true
+			return nil
+		},
+	}
+	mockProjectRepository := &mocks.MockProjectRepository{
+		DeleteAllFunc: func() error {
+			wereProjectsDeleted = true
+			return nil
+		},
+	}
+	mockLabelRepository := &mocks.MockLabelRepository{
+		DeleteAllFunc: func() error {
+			wereLabelsDeleted = true
+			return nil
+		},
+	}
+
+	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService, mockReplicaService, mockTaskRepository, mockProjectRepository, mockLabelRepository)
+	err := logoutCommand.Execute()
+
+	if err != nil {
+		t.Errorf("Received '%s', expected no error", err)
+	}
+	if !wereTasksDeleted {
+		t.Error("Expected the locally stored tasks to have been deleted")
+	}
+	if !wereProjectsDeleted {
+		t.Error("Expected the locally stored projects to have been deleted")
+	}
+	if !wereLabelsDeleted {
+		t.Error("Expected the locally stored labels to have been deleted")
+	}
+}
+
+func TestIfAuthenticatedAndDeletingTheLocallyStoredTasksFailsThenTheErrorIsReturned(t *testing.T) {
+	mockOutputStream := &bytes.Buffer{}
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	mockReplicaService := &mocks.MockReplicaService{
+		ClearFunc: func() error { return nil },
+	}
+	mockTaskRepository := &mocks.MockTaskRepository{
+		DeleteAllFunc: func() error { return errors.New("test error") },
+	}
+
+	logoutCommand := NewLogoutCommand(mockOutputStream, mockAuthenticationService, mockReplicaService, mockTaskRepository, newMockProjectRepository(), newMockLabelRepository())
+	err := logoutCommand.Execute()
+
+	if err == nil || err.Error() != "test error" {
+		t.Errorf("Received '%v', expected 'test error'", err)
+	}
+}
+
+func newMockTaskRepository() *mocks.MockTaskRepository {
+	return &mocks.MockTaskRepository{DeleteAllFunc: func() error { return nil }}
+}
+
+func newMockProjectRepository() *mocks.MockProjectRepository {
+	return &mocks.MockProjectRepository{DeleteAllFunc: func() error { return nil }}
+}
+
+func newMockLabelRepository() *mocks.MockLabelRepository {
+	return &mocks.MockLabelRepository{DeleteAllFunc: func() error { return nil }}
+}
@@ -0,0 +1,70 @@
+package add
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successfullyAddedProject = "Project has been added"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNameNotProvided           = "Error, a name must be provided when creating a project"
+	errorProjectNotAdded           = "Error, the project could not be added, please try again later"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	projectService        services.ProjectService
+}
+
+// NewAddProjectCommand creates an instance of the command that adds a project on Todoist
+func NewAddProjectCommand(o io.Writer, a authentication.Service, p services.ProjectService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		projectService:        p,
+	}
+
+	name := ""
+
+	var addProjectCommand = &cobra.Command{
+		Use:   "add",
+		Short: "Add project",
+		Long:  "Adds a project",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, name)
+		},
+	}
+
+	addProjectCommand.Flags().StringVarP(&name, "name", "n", "", "the name of the project")
+
+	return addProjectCommand
+}
+
+func execute(d *dependencies, name string) error {
+	if name == "" {
+		return failures.New(failures.Validation, errorNameNotProvided)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.projectService.AddProject(name)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorProjectNotAdded)
+	}
+
+	output.WriteMessage(d.outputStream, successfullyAddedProject)
+	return warning
+}
@@ -0,0 +1,95 @@
+package add
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	addProjectCommand := NewAddProjectCommand(mockOutputStream, mockAuthenticationService, nil)
+	addProjectCommand.SetArgs([]string{
+		`-n=Work`,
+	})
+
+	err := addProjectCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestInputParameters(t *testing.T) {
+
+	t.Run("If no name is provided, then an error stating so is written to the console", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		addProjectCommand := NewAddProjectCommand(mockOutputStream, mockAuthenticationService, nil)
+		err := addProjectCommand.Execute()
+
+		assert.Equal(t, errorNameNotProvided, err.Error())
+
+	})
+}
+
+func TestAddingAProject(t *testing.T) {
+
+	t.Run("When creating a project and an error occurs, an error stating that the project wasn't added is written to console", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			AddProjectFunc: func(name string) error {
+				return errors.New("error while adding project")
+			},
+		}
+
+		addProjectCommand := NewAddProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		addProjectCommand.SetArgs([]string{
+			`-n=Work`,
+		})
+
+		err := addProjectCommand.Execute()
+
+		assert.Equal(t, errorProjectNotAdded, err.Error())
+
+	})
+
+	t.Run("When creating a project and no error occurs, then a message stating that the project was created is written to console", func(t *testing.T) {
+		addedName := ""
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			AddProjectFunc: func(name string) error {
+				addedName = name
+				return nil
+			},
+		}
+
+		addProjectCommand := NewAddProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		addProjectCommand.SetArgs([]string{
+			`-n=Work`,
+		})
+
+		addProjectCommand.Execute()
+
+		assert.Equal(t, successfullyAddedProject, mockOutputStream.String())
+		assert.Equal(t, "Work", addedName)
+
+	})
+
+}
@@ -0,0 +1,64 @@
+package archive
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorFailedToArchiveProject    = "An error occurred while archiving the project"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	successProjectArchived         = "The project has successfully been archived"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	projectService        services.ProjectService
+}
+
+// NewArchiveProjectCommand creates an instance of the command that archives a project
+func NewArchiveProjectCommand(o io.Writer, a authentication.Service, p services.ProjectService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		projectService:        p,
+	}
+
+	projectID := 0
+
+	var archiveProjectCommand = &cobra.Command{
+		Use:   "archive",
+		Short: "Archive project",
+		Long:  "Archive a project given a project id",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(projectID))
+		},
+	}
+
+	archiveProjectCommand.Flags().IntVarP(&projectID, "id", "i", 0, "the id of the project to archive")
+
+	return archiveProjectCommand
+}
+
+func execute(d *dependencies, projectID uint32) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.projectService.ArchiveProject(projectID)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToArchiveProject)
+	}
+
+	output.WriteMessage(d.outputStream, successProjectArchived)
+	return warning
+}
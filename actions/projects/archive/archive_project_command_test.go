@@ -0,0 +1,69 @@
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	archiveProjectCommand := NewArchiveProjectCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := archiveProjectCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and an error occurs, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			ArchiveProjectFunc: func(uint32) error {
+				return errors.New("test error")
+			},
+		}
+
+		archiveProjectCommand := NewArchiveProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		err := archiveProjectCommand.Execute()
+
+		assert.Equal(t, errorFailedToArchiveProject, err.Error())
+
+	})
+
+	t.Run("When authenticated and no error occurs, then message is written to output stream", func(t *testing.T) {
+
+		var requestedProjectID uint32
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			ArchiveProjectFunc: func(projectID uint32) error {
+				requestedProjectID = projectID
+				return nil
+			},
+		}
+
+		archiveProjectCommand := NewArchiveProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		archiveProjectCommand.SetArgs([]string{"-i=3"})
+		archiveProjectCommand.Execute()
+
+		assert.Equal(t, successProjectArchived, mockOutputStream.String())
+		assert.Equal(t, uint32(3), requestedProjectID)
+
+	})
+
+}
@@ -0,0 +1,97 @@
+package comment
+
+import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/notes/services"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successCommentAdded = "The comment has successfully been added"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNoProjectReferenced       = "Error, the id of the project to comment on must be provided using --id"
+	errorNoContent                 = "Error, the text of the comment must be provided"
+	errorCannotReadFile            = "Error, the file '%s' could not be read"
+	errorFailedToAddComment        = "An error occurred while adding the comment"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	noteService           services.NoteService
+}
+
+// NewAddCommentCommand creates an instance of the command that posts a comment on a project, optionally with a file attached
+func NewAddCommentCommand(o io.Writer, a authentication.Service, n services.NoteService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		noteService:           n,
+	}
+
+	projectID := 0
+	filePath := ""
+
+	var addCommentCommand = &cobra.Command{
+		Use:   "comment",
+		Short: "Comment on a project",
+		Long:  "Post a comment on a project given a project id, optionally with a file attached",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(projectID), strings.Join(args, " "), filePath)
+		},
+	}
+
+	addCommentCommand.Flags().IntVarP(&projectID, "id", "i", 0, "the id of the project to comment on")
+	addCommentCommand.Flags().StringVarP(&filePath, "file", "f", "", "the path of a file to upload and attach to the comment")
+
+	return addCommentCommand
+}
+
+func execute(d *dependencies, projectID uint32, content string, filePath string) error {
+	if projectID == 0 {
+		return failures.New(failures.Validation, errorNoProjectReferenced)
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return failures.New(failures.Validation, errorNoContent)
+	}
+
+	var attachment *types.Attachment
+	if filePath != "" {
+		contents, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return failures.Newf(failures.Validation, errorCannotReadFile, filePath)
+		}
+		attachment = &types.Attachment{
+			FileName: filepath.Base(filePath),
+			Contents: contents,
+		}
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.noteService.AddProjectNote(projectID, content, attachment)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.Validation) || failures.Is(err, failures.NotFound) || failures.Is(err, failures.Network) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToAddComment)
+	}
+
+	output.WriteMessage(d.outputStream, successCommentAdded)
+	return warning
+}
@@ -0,0 +1,121 @@
+package comment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	addCommentCommand := NewAddCommentCommand(mockOutputStream, mockAuthenticationService, nil)
+	addCommentCommand.SetArgs([]string{"--id", "1", "Kick-off on Monday"})
+	err := addCommentCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When no project is referenced, then a validation error is returned", func(t *testing.T) {
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, &mocks.MockAuthenticationService{}, nil)
+		addCommentCommand.SetArgs([]string{"Kick-off on Monday"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, errorNoProjectReferenced, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When no text is provided, then a validation error is returned", func(t *testing.T) {
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, &mocks.MockAuthenticationService{}, nil)
+		addCommentCommand.SetArgs([]string{"--id", "1"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, errorNoContent, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When the file to attach cannot be read, then a validation error is returned", func(t *testing.T) {
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, &mocks.MockAuthenticationService{}, nil)
+		addCommentCommand.SetArgs([]string{"--id", "1", "--file", "does-not-exist.pdf", "Agenda"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, fmt.Sprintf(errorCannotReadFile, "does-not-exist.pdf"), err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When authenticated and the comment is added, then the text and attachment are provided to the note service and a message is written to output stream", func(t *testing.T) {
+
+		directory, _ := ioutil.TempDir("", "todoist-cli")
+		defer os.RemoveAll(directory)
+		filePath := filepath.Join(directory, "agenda.pdf")
+		ioutil.WriteFile(filePath, []byte("contents"), 0600)
+
+		var requestedProjectID uint32
+		var requestedContent string
+		var requestedAttachment *types.Attachment
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			AddProjectNoteFunc: func(projectID uint32, content string, attachment *types.Attachment) error {
+				requestedProjectID = projectID
+				requestedContent = content
+				requestedAttachment = attachment
+				return nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		addCommentCommand := NewAddCommentCommand(mockOutputStream, mockAuthenticationService, mockNoteService)
+		addCommentCommand.SetArgs([]string{"--id", "2", "--file", filePath, "Kick-off", "on", "Monday"})
+		err := addCommentCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), requestedProjectID)
+		assert.Equal(t, "Kick-off on Monday", requestedContent)
+		assert.Equal(t, &types.Attachment{FileName: "agenda.pdf", Contents: []byte("contents")}, requestedAttachment)
+		assert.Equal(t, successCommentAdded, mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and an error occurs while adding the comment, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			AddProjectNoteFunc: func(projectID uint32, content string, attachment *types.Attachment) error {
+				return errors.New("test error")
+			},
+		}
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, mockAuthenticationService, mockNoteService)
+		addCommentCommand.SetArgs([]string{"--id", "2", "Agenda"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, errorFailedToAddComment, err.Error())
+
+	})
+
+}
@@ -0,0 +1,70 @@
+package comments
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/notes/services"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	noCommentsMessage              = "There are no comments on this project"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	noteService           services.NoteService
+}
+
+// NewListCommentsCommand creates an instance of the command that prints the comments on a project to the console
+func NewListCommentsCommand(o io.Writer, a authentication.Service, n services.NoteService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		noteService:           n,
+	}
+
+	projectID := 0
+
+	var listCommentsCommand = &cobra.Command{
+		Use:   "comments",
+		Short: "List comments on a project",
+		Long:  "List the comments on a project given a project id, from the oldest to the most recently posted",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(projectID))
+		},
+	}
+
+	listCommentsCommand.Flags().IntVarP(&projectID, "id", "i", 0, "the id of the project to list the comments on")
+
+	return listCommentsCommand
+}
+
+func execute(d *dependencies, projectID uint32) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	notes, err := d.noteService.GetProjectNotes(projectID)
+	if err != nil {
+		return err
+	}
+
+	return output.WriteList(d.outputStream, types.RecordColumns(), notes.AsRecords(), func() {
+		writeText(d, notes)
+	})
+}
+
+func writeText(d *dependencies, notes types.NoteList) {
+	output.WriteRows(d.outputStream, noCommentsMessage, len(notes), func(index int) string {
+		return notes[index].AsString()
+	})
+}
@@ -0,0 +1,91 @@
+package comments
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	listCommentsCommand := NewListCommentsCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := listCommentsCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and an error occurs while retrieving comments, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			GetProjectNotesFunc: func(projectID uint32) (types.NoteList, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		listCommentsCommand := NewListCommentsCommand(&bytes.Buffer{}, mockAuthenticationService, mockNoteService)
+		listCommentsCommand.SetArgs([]string{"--id", "1"})
+		err := listCommentsCommand.Execute()
+
+		assert.Equal(t, "test error", err.Error())
+
+	})
+
+	t.Run("When authenticated and there are no comments, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			GetProjectNotesFunc: func(projectID uint32) (types.NoteList, error) {
+				return types.NoteList{}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listCommentsCommand := NewListCommentsCommand(mockOutputStream, mockAuthenticationService, mockNoteService)
+		listCommentsCommand.SetArgs([]string{"--id", "1"})
+		listCommentsCommand.Execute()
+
+		assert.Equal(t, noCommentsMessage, mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and there are comments, then the comments on the project are written to output stream", func(t *testing.T) {
+
+		var requestedProjectID uint32
+		noteToBeWritten := types.Note{Content: "Kick-off on Monday"}
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			GetProjectNotesFunc: func(projectID uint32) (types.NoteList, error) {
+				requestedProjectID = projectID
+				return types.NoteList{noteToBeWritten}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listCommentsCommand := NewListCommentsCommand(mockOutputStream, mockAuthenticationService, mockNoteService)
+		listCommentsCommand.SetArgs([]string{"-i=2"})
+		listCommentsCommand.Execute()
+
+		assert.Equal(t, uint32(2), requestedProjectID)
+		assert.Equal(t, noteToBeWritten.AsString()+"\n", mockOutputStream.String())
+
+	})
+
+}
@@ -0,0 +1,64 @@
+package delete
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorFailedToDeleteProject     = "An error occurred while deleting the project"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	successProjectDeleted          = "The project has successfully been deleted"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	projectService        services.ProjectService
+}
+
+// NewDeleteProjectCommand creates an instance of the command that deletes a project and all of its tasks
+func NewDeleteProjectCommand(o io.Writer, a authentication.Service, p services.ProjectService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		projectService:        p,
+	}
+
+	projectID := 0
+
+	var deleteProjectCommand = &cobra.Command{
+		Use:   "delete",
+		Short: "Delete project",
+		Long:  "Delete a project and all of its tasks given a project id",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(projectID))
+		},
+	}
+
+	deleteProjectCommand.Flags().IntVarP(&projectID, "id", "i", 0, "the id of the project to delete")
+
+	return deleteProjectCommand
+}
+
+func execute(d *dependencies, projectID uint32) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.projectService.DeleteProject(projectID)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToDeleteProject)
+	}
+
+	output.WriteMessage(d.outputStream, successProjectDeleted)
+	return warning
+}
@@ -0,0 +1,69 @@
+package delete
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	deleteProjectCommand := NewDeleteProjectCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := deleteProjectCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and an error occurs, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			DeleteProjectFunc: func(uint32) error {
+				return errors.New("test error")
+			},
+		}
+
+		deleteProjectCommand := NewDeleteProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		err := deleteProjectCommand.Execute()
+
+		assert.Equal(t, errorFailedToDeleteProject, err.Error())
+
+	})
+
+	t.Run("When authenticated and no error occurs, then message is written to output stream", func(t *testing.T) {
+
+		var requestedProjectID uint32
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			DeleteProjectFunc: func(projectID uint32) error {
+				requestedProjectID = projectID
+				return nil
+			},
+		}
+
+		deleteProjectCommand := NewDeleteProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		deleteProjectCommand.SetArgs([]string{"-i=3"})
+		deleteProjectCommand.Execute()
+
+		assert.Equal(t, successProjectDeleted, mockOutputStream.String())
+		assert.Equal(t, uint32(3), requestedProjectID)
+
+	})
+
+}
@@ -0,0 +1,65 @@
+package list
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/kpdowns/todoist-cli/projects/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	noProjectsMessage              = "There are no projects on your account"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	projectService        services.ProjectService
+}
+
+// NewListProjectsCommand creates an instance of the command that prints all projects to the console
+func NewListProjectsCommand(o io.Writer, a authentication.Service, p services.ProjectService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		projectService:        p,
+	}
+
+	var listProjectsCommand = &cobra.Command{
+		Use:   "list",
+		Short: "List projects",
+		Long:  "List all projects on Todoist.com",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies)
+		},
+	}
+
+	return listProjectsCommand
+}
+
+func execute(d *dependencies) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	projects, err := d.projectService.GetAllProjects()
+	if err != nil {
+		return err
+	}
+
+	return output.WriteList(d.outputStream, types.RecordColumns(), projects.AsRecords(), func() {
+		writeText(d, projects)
+	})
+}
+
+func writeText(d *dependencies, projects types.ProjectList) {
+	output.WriteRows(d.outputStream, noProjectsMessage, len(projects), func(index int) string {
+		return projects[index].AsString()
+	})
+}
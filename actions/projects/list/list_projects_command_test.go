@@ -0,0 +1,111 @@
+package list
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/projects/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	listProjectsCommand := NewListProjectsCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := listProjectsCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and an error occurs while retrieving projects, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockProjectService := &mocks.MockProjectService{
+			GetAllProjectsFunc: func() (types.ProjectList, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listProjectsCommand := NewListProjectsCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		err := listProjectsCommand.Execute()
+
+		assert.Equal(t, "test error", err.Error())
+
+	})
+
+	t.Run("When authenticated and there are no projects, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockProjectService := &mocks.MockProjectService{
+			GetAllProjectsFunc: func() (types.ProjectList, error) {
+				return types.ProjectList{}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listProjectsCommand := NewListProjectsCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		listProjectsCommand.Execute()
+
+		assert.Equal(t, noProjectsMessage, mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and there are projects, those projects are written to output stream", func(t *testing.T) {
+
+		projectToBeWritten := types.Project{
+			ID:   1,
+			Name: "Inbox",
+		}
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockProjectService := &mocks.MockProjectService{
+			GetAllProjectsFunc: func() (types.ProjectList, error) {
+				return types.ProjectList{projectToBeWritten}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listProjectsCommand := NewListProjectsCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		listProjectsCommand.Execute()
+
+		assert.Equal(t, projectToBeWritten.AsString()+"\n", mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and the output is YAML, then the projects are written as a YAML list of records", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockProjectService := &mocks.MockProjectService{
+			GetAllProjectsFunc: func() (types.ProjectList, error) {
+				return types.ProjectList{{ID: 1, TodoistID: 10, Name: "Inbox"}}, nil
+			},
+		}
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("yaml")
+
+		listProjectsCommand := NewListProjectsCommand(outputStream, mockAuthenticationService, mockProjectService)
+		listProjectsCommand.Execute()
+
+		assert.Equal(t, "- id: 1\n  todoist_id: 10\n  name: Inbox\n  color: 0\n  child_order: 0\n  archived: false\n  favorite: false\n", buffer.String())
+
+	})
+
+}
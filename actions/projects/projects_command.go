@@ -0,0 +1,38 @@
+package projects
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/projects/add"
+	"github.com/kpdowns/todoist-cli/actions/projects/archive"
+	"github.com/kpdowns/todoist-cli/actions/projects/comment"
+	"github.com/kpdowns/todoist-cli/actions/projects/comments"
+	"github.com/kpdowns/todoist-cli/actions/projects/delete"
+	"github.com/kpdowns/todoist-cli/actions/projects/list"
+	"github.com/kpdowns/todoist-cli/actions/projects/rename"
+	"github.com/kpdowns/todoist-cli/actions/projects/unarchive"
+	"github.com/kpdowns/todoist-cli/authentication"
+	noteServices "github.com/kpdowns/todoist-cli/notes/services"
+	"github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/spf13/cobra"
+)
+
+// NewProjectsCommand creates a new instance of the projects command
+func NewProjectsCommand(o io.Writer, authenticationService authentication.Service, projectService services.ProjectService, noteService noteServices.NoteService) *cobra.Command {
+	var projectsCommand = &cobra.Command{
+		Use:   "projects",
+		Short: "Manage projects",
+		Long:  "Manage projects on Todoist.com",
+	}
+
+	projectsCommand.AddCommand(list.NewListProjectsCommand(o, authenticationService, projectService))
+	projectsCommand.AddCommand(add.NewAddProjectCommand(o, authenticationService, projectService))
+	projectsCommand.AddCommand(rename.NewRenameProjectCommand(o, authenticationService, projectService))
+	projectsCommand.AddCommand(archive.NewArchiveProjectCommand(o, authenticationService, projectService))
+	projectsCommand.AddCommand(unarchive.NewUnarchiveProjectCommand(o, authenticationService, projectService))
+	projectsCommand.AddCommand(delete.NewDeleteProjectCommand(o, authenticationService, projectService))
+	projectsCommand.AddCommand(comments.NewListCommentsCommand(o, authenticationService, noteService))
+	projectsCommand.AddCommand(comment.NewAddCommentCommand(o, authenticationService, noteService))
+
+	return projectsCommand
+}
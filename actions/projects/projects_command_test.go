@@ -0,0 +1,39 @@
+package projects
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandCreation(t *testing.T) {
+
+	expectedSubCommands := []string{"list", "add", "rename", "archive", "unarchive", "delete", "comments", "comment"}
+
+	for _, expectedSubCommand := range expectedSubCommands {
+		expectedSubCommand := expectedSubCommand
+
+		t.Run("Sub command '"+expectedSubCommand+"' is added", func(t *testing.T) {
+
+			mockOutputStream := &bytes.Buffer{}
+			mockAuthenticationService := &mocks.MockAuthenticationService{}
+			mockProjectService := &mocks.MockProjectService{}
+
+			projectsCommand := NewProjectsCommand(mockOutputStream, mockAuthenticationService, mockProjectService, &mocks.MockNoteService{})
+
+			found := false
+			for _, registeredCommand := range projectsCommand.Commands() {
+				if registeredCommand.Use == expectedSubCommand {
+					found = true
+					break
+				}
+			}
+
+			assert.True(t, found)
+
+		})
+	}
+
+}
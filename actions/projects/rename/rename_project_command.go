@@ -0,0 +1,72 @@
+package rename
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successfullyRenamedProject = "The project has successfully been renamed"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNameNotProvided           = "Error, a new name must be provided when renaming a project"
+	errorFailedToRenameProject     = "An error occurred while renaming the project"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	projectService        services.ProjectService
+}
+
+// NewRenameProjectCommand creates an instance of the command that renames a project
+func NewRenameProjectCommand(o io.Writer, a authentication.Service, p services.ProjectService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		projectService:        p,
+	}
+
+	projectID := 0
+	name := ""
+
+	var renameProjectCommand = &cobra.Command{
+		Use:   "rename",
+		Short: "Rename project",
+		Long:  "Change the name of a project given a project id",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(projectID), name)
+		},
+	}
+
+	renameProjectCommand.Flags().IntVarP(&projectID, "id", "i", 0, "the id of the project to rename")
+	renameProjectCommand.Flags().StringVarP(&name, "name", "n", "", "the new name of the project")
+
+	return renameProjectCommand
+}
+
+func execute(d *dependencies, projectID uint32, name string) error {
+	if name == "" {
+		return failures.New(failures.Validation, errorNameNotProvided)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.projectService.RenameProject(projectID, name)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToRenameProject)
+	}
+
+	output.WriteMessage(d.outputStream, successfullyRenamedProject)
+	return warning
+}
@@ -0,0 +1,89 @@
+package rename
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	renameProjectCommand := NewRenameProjectCommand(mockOutputStream, mockAuthenticationService, nil)
+	renameProjectCommand.SetArgs([]string{"-i=1", "-n=Personal"})
+	err := renameProjectCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("If no name is provided, then an error stating so is written to the output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		renameProjectCommand := NewRenameProjectCommand(mockOutputStream, mockAuthenticationService, nil)
+		renameProjectCommand.SetArgs([]string{"-i=1"})
+		err := renameProjectCommand.Execute()
+
+		assert.Equal(t, errorNameNotProvided, err.Error())
+
+	})
+
+	t.Run("When authenticated and an error occurs while renaming the project, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			RenameProjectFunc: func(uint32, string) error {
+				return errors.New("test error")
+			},
+		}
+
+		renameProjectCommand := NewRenameProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		renameProjectCommand.SetArgs([]string{"-i=1", "-n=Personal"})
+		err := renameProjectCommand.Execute()
+
+		assert.Equal(t, errorFailedToRenameProject, err.Error())
+
+	})
+
+	t.Run("When authenticated and no error occurs while renaming the project, then message is written to output stream", func(t *testing.T) {
+
+		var renamedProjectID uint32
+		renamedTo := ""
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			RenameProjectFunc: func(projectID uint32, name string) error {
+				renamedProjectID = projectID
+				renamedTo = name
+				return nil
+			},
+		}
+
+		renameProjectCommand := NewRenameProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		renameProjectCommand.SetArgs([]string{"-i=2", "-n=Personal"})
+		renameProjectCommand.Execute()
+
+		assert.Equal(t, successfullyRenamedProject, mockOutputStream.String())
+		assert.Equal(t, uint32(2), renamedProjectID)
+		assert.Equal(t, "Personal", renamedTo)
+
+	})
+
+}
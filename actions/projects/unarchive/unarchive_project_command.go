@@ -0,0 +1,64 @@
+package unarchive
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorFailedToUnarchiveProject  = "An error occurred while unarchiving the project"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	successProjectUnarchived       = "The project has successfully been unarchived"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	projectService        services.ProjectService
+}
+
+// NewUnarchiveProjectCommand creates an instance of the command that restores an archived project
+func NewUnarchiveProjectCommand(o io.Writer, a authentication.Service, p services.ProjectService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		projectService:        p,
+	}
+
+	projectID := 0
+
+	var unarchiveProjectCommand = &cobra.Command{
+		Use:   "unarchive",
+		Short: "Unarchive project",
+		Long:  "Restore an archived project given a project id",
+		Args:  cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, uint32(projectID))
+		},
+	}
+
+	unarchiveProjectCommand.Flags().IntVarP(&projectID, "id", "i", 0, "the id of the project to unarchive")
+
+	return unarchiveProjectCommand
+}
+
+func execute(d *dependencies, projectID uint32) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.projectService.UnarchiveProject(projectID)
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUnarchiveProject)
+	}
+
+	output.WriteMessage(d.outputStream, successProjectUnarchived)
+	return warning
+}
@@ -0,0 +1,69 @@
+package unarchive
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	unarchiveProjectCommand := NewUnarchiveProjectCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := unarchiveProjectCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and an error occurs, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			UnarchiveProjectFunc: func(uint32) error {
+				return errors.New("test error")
+			},
+		}
+
+		unarchiveProjectCommand := NewUnarchiveProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		err := unarchiveProjectCommand.Execute()
+
+		assert.Equal(t, errorFailedToUnarchiveProject, err.Error())
+
+	})
+
+	t.Run("When authenticated and no error occurs, then message is written to output stream", func(t *testing.T) {
+
+		var requestedProjectID uint32
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockProjectService := &mocks.MockProjectService{
+			UnarchiveProjectFunc: func(projectID uint32) error {
+				requestedProjectID = projectID
+				return nil
+			},
+		}
+
+		unarchiveProjectCommand := NewUnarchiveProjectCommand(mockOutputStream, mockAuthenticationService, mockProjectService)
+		unarchiveProjectCommand.SetArgs([]string{"-i=3"})
+		unarchiveProjectCommand.Execute()
+
+		assert.Equal(t, successProjectUnarchived, mockOutputStream.String())
+		assert.Equal(t, uint32(3), requestedProjectID)
+
+	})
+
+}
@@ -0,0 +1,59 @@
+package drop
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorFailedToDropQueue         = "An error occurred while discarding the queued commands"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	successQueueDropped            = "The queued commands have been discarded"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	replicaService        replica.Service
+}
+
+// NewDropQueueCommand creates an instance of the command that discards the commands queued while offline
+func NewDropQueueCommand(o io.Writer, a authentication.Service, r replica.Service) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		replicaService:        r,
+	}
+
+	var dropQueueCommand = &cobra.Command{
+		Use:   "drop",
+		Short: "Discard queued commands",
+		Long:  "Discard the commands queued while offline without sending them to Todoist.com",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies)
+		},
+	}
+
+	return dropQueueCommand
+}
+
+func execute(d *dependencies) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.replicaService.DropQueuedCommands()
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToDropQueue)
+	}
+
+	output.WriteMessage(d.outputStream, successQueueDropped)
+	return warning
+}
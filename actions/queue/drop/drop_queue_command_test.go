@@ -0,0 +1,85 @@
+package drop
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	dropQueueCommand := NewDropQueueCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := dropQueueCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When an error occurs, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			DropQueuedCommandsFunc: func() error {
+				return errors.New("test error")
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		dropQueueCommand := NewDropQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		err := dropQueueCommand.Execute()
+
+		assert.Equal(t, errorFailedToDropQueue, err.Error())
+
+	})
+
+	t.Run("When no error occurs, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			DropQueuedCommandsFunc: func() error {
+				return nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		dropQueueCommand := NewDropQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		dropQueueCommand.Execute()
+
+		assert.Equal(t, successQueueDropped, mockOutputStream.String())
+
+	})
+
+	t.Run("When the commands are discarded with a warning, then the message is written and the warning is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			DropQueuedCommandsFunc: func() error {
+				return failures.NewWarning(errors.New("test error"))
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		dropQueueCommand := NewDropQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		err := dropQueueCommand.Execute()
+
+		assert.True(t, failures.IsWarning(err))
+		assert.Equal(t, successQueueDropped, mockOutputStream.String())
+
+	})
+
+}
@@ -0,0 +1,63 @@
+package flush
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorStillOffline              = "Todoist could not be reached, the queued commands will be sent on the next sync"
+	successQueueFlushed            = "The queued commands have successfully been sent to Todoist"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	replicaService        replica.Service
+}
+
+// NewFlushQueueCommand creates an instance of the command that sends the commands queued while offline to Todoist
+func NewFlushQueueCommand(o io.Writer, a authentication.Service, r replica.Service) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		replicaService:        r,
+	}
+
+	var flushQueueCommand = &cobra.Command{
+		Use:   "flush",
+		Short: "Send queued commands",
+		Long:  "Send the commands queued while offline to Todoist.com in a single batch",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies)
+		},
+	}
+
+	return flushQueueCommand
+}
+
+func execute(d *dependencies) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err := d.replicaService.FlushQueuedCommands()
+	if err == todoist.ErrUnreachable {
+		return failures.New(failures.Network, errorStillOffline)
+	}
+	warning, err := failures.SeparateWarning(err)
+	if err != nil {
+		return err
+	}
+
+	output.WriteMessage(d.outputStream, successQueueFlushed)
+	return warning
+}
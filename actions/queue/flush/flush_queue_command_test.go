@@ -0,0 +1,84 @@
+package flush
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	flushQueueCommand := NewFlushQueueCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := flushQueueCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When Todoist cannot be reached, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			FlushQueuedCommandsFunc: func() error {
+				return todoist.ErrUnreachable
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		flushQueueCommand := NewFlushQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		err := flushQueueCommand.Execute()
+
+		assert.Equal(t, errorStillOffline, err.Error())
+
+	})
+
+	t.Run("When the queued commands are rejected, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			FlushQueuedCommandsFunc: func() error {
+				return errors.New("test error")
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		flushQueueCommand := NewFlushQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		err := flushQueueCommand.Execute()
+
+		assert.Equal(t, "test error", err.Error())
+
+	})
+
+	t.Run("When the queued commands are sent, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			FlushQueuedCommandsFunc: func() error {
+				return nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		flushQueueCommand := NewFlushQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		flushQueueCommand.Execute()
+
+		assert.Equal(t, successQueueFlushed, mockOutputStream.String())
+
+	})
+
+}
@@ -0,0 +1,90 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/spf13/cobra"
+)
+
+const (
+	noQueuedCommandsMessage        = "There are no commands waiting to be sent to Todoist"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+)
+
+// recordColumns are the names of the fields of a queued command in machine readable output
+var recordColumns = []string{"position", "type", "uuid", "temp_id", "args"}
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	replicaService        replica.Service
+}
+
+// NewListQueueCommand creates an instance of the command that prints the commands queued while offline to the console
+func NewListQueueCommand(o io.Writer, a authentication.Service, r replica.Service) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		replicaService:        r,
+	}
+
+	var listQueueCommand = &cobra.Command{
+		Use:   "list",
+		Short: "List queued commands",
+		Long:  "List the commands queued while offline that will be sent to Todoist.com on the next sync",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies)
+		},
+	}
+
+	return listQueueCommand
+}
+
+func execute(d *dependencies) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	queuedCommands, err := d.replicaService.GetQueuedCommands()
+	if err != nil {
+		return err
+	}
+
+	records := make([]output.Record, len(queuedCommands))
+	for index, queuedCommand := range queuedCommands {
+		records[index] = output.Record{
+			{Name: "position", Value: index + 1},
+			{Name: "type", Value: queuedCommand.Type},
+			{Name: "uuid", Value: queuedCommand.UUID},
+			{Name: "temp_id", Value: queuedCommand.TemporaryID},
+			{Name: "args", Value: queuedCommand.Arguments},
+		}
+	}
+
+	return output.WriteList(d.outputStream, recordColumns, records, func() {
+		writeText(d, queuedCommands)
+	})
+}
+
+func writeText(d *dependencies, queuedCommands []requests.CommandDetail) {
+	if len(queuedCommands) == 0 {
+		fmt.Fprint(d.outputStream, noQueuedCommandsMessage)
+		return
+	}
+
+	writer := tabwriter.NewWriter(d.outputStream, 0, 8, 1, '\t', 0)
+	for index, queuedCommand := range queuedCommands {
+		arguments, _ := json.Marshal(queuedCommand.Arguments)
+		fmt.Fprintf(writer, "[%d]\t%s\t%s\n", index+1, queuedCommand.Type, arguments)
+	}
+	writer.Flush()
+}
@@ -0,0 +1,112 @@
+package list
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	listQueueCommand := NewListQueueCommand(mockOutputStream, mockAuthenticationService, nil)
+	err := listQueueCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When an error occurs while retrieving the queue, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			GetQueuedCommandsFunc: func() ([]requests.CommandDetail, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listQueueCommand := NewListQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		err := listQueueCommand.Execute()
+
+		assert.Equal(t, "test error", err.Error())
+
+	})
+
+	t.Run("When there are no queued commands, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			GetQueuedCommandsFunc: func() ([]requests.CommandDetail, error) {
+				return nil, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listQueueCommand := NewListQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		listQueueCommand.Execute()
+
+		assert.Equal(t, noQueuedCommandsMessage, mockOutputStream.String())
+
+	})
+
+	t.Run("When there are queued commands, then they are written to output stream in order", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			GetQueuedCommandsFunc: func() ([]requests.CommandDetail, error) {
+				return []requests.CommandDetail{
+					{Type: commands.ItemAdd, Arguments: map[string]interface{}{"content": "test"}},
+					{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": 1}},
+				}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listQueueCommand := NewListQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+		listQueueCommand.Execute()
+
+		assert.Equal(t, "[1]\titem_add\t{\"content\":\"test\"}\n[2]\titem_close\t{\"id\":1}\n", mockOutputStream.String())
+
+	})
+
+	t.Run("When the output is TSV, then the queued commands are written as records", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			GetQueuedCommandsFunc: func() ([]requests.CommandDetail, error) {
+				return []requests.CommandDetail{
+					{Type: commands.ItemClose, UUID: "uuid", Arguments: map[string]interface{}{"id": 1}},
+				}, nil
+			},
+		}
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("tsv")
+
+		listQueueCommand := NewListQueueCommand(outputStream, mockAuthenticationService, mockReplicaService)
+		listQueueCommand.Execute()
+
+		assert.Equal(t, "position\ttype\tuuid\ttemp_id\targs\n1\titem_close\tuuid\t\t\"{\"\"id\"\":1}\"\n", buffer.String())
+
+	})
+
+}
@@ -0,0 +1,27 @@
+package queue
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/queue/drop"
+	"github.com/kpdowns/todoist-cli/actions/queue/flush"
+	"github.com/kpdowns/todoist-cli/actions/queue/list"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/spf13/cobra"
+)
+
+// NewQueueCommand creates a new instance of the queue command
+func NewQueueCommand(o io.Writer, authenticationService authentication.Service, replicaService replica.Service) *cobra.Command {
+	var queueCommand = &cobra.Command{
+		Use:   "queue",
+		Short: "Manage commands queued while offline",
+		Long:  "Manage the commands that could not be sent to Todoist.com while offline and are waiting to be sent on the next sync",
+	}
+
+	queueCommand.AddCommand(list.NewListQueueCommand(o, authenticationService, replicaService))
+	queueCommand.AddCommand(flush.NewFlushQueueCommand(o, authenticationService, replicaService))
+	queueCommand.AddCommand(drop.NewDropQueueCommand(o, authenticationService, replicaService))
+
+	return queueCommand
+}
@@ -0,0 +1,39 @@
+package queue
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandCreation(t *testing.T) {
+
+	expectedSubCommands := []string{"list", "flush", "drop"}
+
+	for _, expectedSubCommand := range expectedSubCommands {
+		expectedSubCommand := expectedSubCommand
+
+		t.Run("Sub command '"+expectedSubCommand+"' is added", func(t *testing.T) {
+
+			mockOutputStream := &bytes.Buffer{}
+			mockAuthenticationService := &mocks.MockAuthenticationService{}
+			mockReplicaService := &mocks.MockReplicaService{}
+
+			queueCommand := NewQueueCommand(mockOutputStream, mockAuthenticationService, mockReplicaService)
+
+			found := false
+			for _, registeredCommand := range queueCommand.Commands() {
+				if registeredCommand.Use == expectedSubCommand {
+					found = true
+					break
+				}
+			}
+
+			assert.True(t, found)
+
+		})
+	}
+
+}
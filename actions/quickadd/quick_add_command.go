@@ -0,0 +1,79 @@
+package quickadd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successfullyAddedTask  = "Task has been added with Todoist id %d"
+	successfullyQueuedTask = "Todoist could not be reached, the task will be added on the next sync"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorTextNotProvided           = "Error, the text of the task must be provided, e.g. todoist add \"Buy milk tomorrow #Groceries\""
+	errorTaskNotAdded              = "Error, the task could not be added, please try again later"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+}
+
+// NewQuickAddCommand creates an instance of the command that adds a task from a line of text the way Todoist's quick add does
+func NewQuickAddCommand(o io.Writer, a authentication.Service, t services.TaskService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+	}
+
+	var quickAddCommand = &cobra.Command{
+		Use:   "add",
+		Short: "Quick add a task",
+		Long: "Adds a task from a line of text the way Todoist's quick add does, e.g. todoist add \"Buy milk tomorrow 5pm #Groceries @errand p2\".\n" +
+			"The due date, #project, @labels and priority (p1 being the most urgent) are read out of the text. When Todoist cannot be reached\n" +
+			"the text is read locally, recognizing common date phrases, and the task is added on the next sync.",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, strings.Join(args, " "))
+		},
+	}
+
+	return quickAddCommand
+}
+
+func execute(d *dependencies, text string) error {
+	if strings.TrimSpace(text) == "" {
+		return failures.New(failures.Validation, errorTextNotProvided)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	todoistID, err := d.taskService.QuickAddTask(text)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.NotFound) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorTaskNotAdded)
+	}
+
+	if todoistID == 0 {
+		output.WriteMessage(d.outputStream, successfullyQueuedTask)
+		return warning
+	}
+
+	output.WriteMessage(d.outputStream, fmt.Sprintf(successfullyAddedTask, todoistID), output.Field{Name: "todoist_id", Value: todoistID})
+	return warning
+}
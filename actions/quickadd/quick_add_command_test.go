@@ -0,0 +1,122 @@
+package quickadd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	quickAddCommand := NewQuickAddCommand(mockOutputStream, mockAuthenticationService, nil)
+	quickAddCommand.SetArgs([]string{"Buy milk"})
+
+	err := quickAddCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestQuickAddingATask(t *testing.T) {
+
+	t.Run("When no text is provided, then an error stating so is returned", func(t *testing.T) {
+		mockOutputStream := &bytes.Buffer{}
+
+		quickAddCommand := NewQuickAddCommand(mockOutputStream, &mocks.MockAuthenticationService{}, nil)
+		quickAddCommand.SetArgs([]string{" "})
+
+		err := quickAddCommand.Execute()
+
+		assert.Equal(t, errorTextNotProvided, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+	})
+
+	t.Run("When the text is provided as several arguments, then they are joined and provided to the task service", func(t *testing.T) {
+		var providedText string
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			QuickAddTaskFunc: func(text string) (int64, error) {
+				providedText = text
+				return 12345, nil
+			},
+		}
+
+		quickAddCommand := NewQuickAddCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		quickAddCommand.SetArgs([]string{"Buy", "milk", "tomorrow", "#Groceries", "@errand", "p2"})
+
+		err := quickAddCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Buy milk tomorrow #Groceries @errand p2", providedText)
+		assert.Equal(t, "Task has been added with Todoist id 12345", mockOutputStream.String())
+	})
+
+	t.Run("When Todoist could not be reached, then a message stating that the task was queued is written to console", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			QuickAddTaskFunc: func(text string) (int64, error) {
+				return 0, nil
+			},
+		}
+
+		quickAddCommand := NewQuickAddCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		quickAddCommand.SetArgs([]string{"Buy milk tomorrow"})
+
+		quickAddCommand.Execute()
+
+		assert.Equal(t, successfullyQueuedTask, mockOutputStream.String())
+	})
+
+	t.Run("When the project in the text does not exist, then the error is returned as is", func(t *testing.T) {
+		expectedError := failures.New(failures.NotFound, "The project 'Groceriez' does not exist.")
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockTaskService := &mocks.MockTaskService{
+			QuickAddTaskFunc: func(text string) (int64, error) {
+				return 0, expectedError
+			},
+		}
+
+		quickAddCommand := NewQuickAddCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		quickAddCommand.SetArgs([]string{"Buy milk #Groceriez"})
+
+		err := quickAddCommand.Execute()
+
+		assert.Equal(t, expectedError, err)
+	})
+
+	t.Run("When an error occurs, then an error stating that the task wasn't added is returned", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockTaskService := &mocks.MockTaskService{
+			QuickAddTaskFunc: func(text string) (int64, error) {
+				return 0, errors.New("test error")
+			},
+		}
+
+		quickAddCommand := NewQuickAddCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		quickAddCommand.SetArgs([]string{"Buy milk"})
+
+		err := quickAddCommand.Execute()
+
+		assert.Equal(t, errorTaskNotAdded, err.Error())
+	})
+
+}
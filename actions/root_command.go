@@ -4,14 +4,32 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/beevik/guid"
 	"github.com/fatih/color"
+	"github.com/kpdowns/todoist-cli/actions/history"
+	labelCommands "github.com/kpdowns/todoist-cli/actions/labels"
 	"github.com/kpdowns/todoist-cli/actions/login"
 	"github.com/kpdowns/todoist-cli/actions/logout"
+	"github.com/kpdowns/todoist-cli/actions/projects"
+	queueCommands "github.com/kpdowns/todoist-cli/actions/queue"
+	"github.com/kpdowns/todoist-cli/actions/quickadd"
 	"github.com/kpdowns/todoist-cli/actions/tasks"
+	"github.com/kpdowns/todoist-cli/actions/undo"
 	"github.com/kpdowns/todoist-cli/authentication"
 	"github.com/kpdowns/todoist-cli/config"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
+	labelRepositories "github.com/kpdowns/todoist-cli/labels/repositories"
+	labelServices "github.com/kpdowns/todoist-cli/labels/services"
+	noteServices "github.com/kpdowns/todoist-cli/notes/services"
+	"github.com/kpdowns/todoist-cli/output"
+	projectRepositories "github.com/kpdowns/todoist-cli/projects/repositories"
+	projectServices "github.com/kpdowns/todoist-cli/projects/services"
+	"github.com/kpdowns/todoist-cli/queue"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/kpdowns/todoist-cli/rest"
 	"github.com/kpdowns/todoist-cli/storage"
 	"github.com/kpdowns/todoist-cli/tasks/repositories"
 	"github.com/kpdowns/todoist-cli/tasks/services"
@@ -19,22 +37,61 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// Initialize creates an instance of the root command and registers all other commands of the todoist-cli
+// Initialize creates an instance of the root command, registers all other commands of the todoist-cli and executes the requested command.
+// Errors are written to stderr and returned so that the exit code can be determined using failures.ExitCode.
 func Initialize() error {
 	var rootCommand = &cobra.Command{
-		Use:   "todoist",
-		Short: "A CLI tool that provides functionality that integrates with Todoist.com",
-		Long:  "todoist-cli is a tool that allows you to interact with Todoist.com directly from the command line without using a browser.",
+		Use:           "todoist",
+		Short:         "A CLI tool that provides functionality that integrates with Todoist.com",
+		Long:          "todoist-cli is a tool that allows you to interact with Todoist.com directly from the command line without using a browser.",
+		SilenceErrors: true,
+		SilenceUsage:  true,
 	}
+	rootCommand.SetFlagErrorFunc(func(command *cobra.Command, err error) error {
+		return failures.New(failures.Validation, err.Error())
+	})
 
-	config := config.LoadConfiguration()
+	errorStream := output.NewWriter(color.Error)
+	err := initialize(rootCommand, errorStream)
+	if err != nil {
+		output.WriteError(errorStream, err)
+		if output.IsText(errorStream) {
+			fmt.Fprintln(errorStream)
+		}
+	}
+
+	return err
+}
 
+func initialize(rootCommand *cobra.Command, errorStream *output.Writer) error {
 	currentExecutablePath, err := filepath.Abs(filepath.Dir(os.Args[0]))
 	if err != nil {
 		panic("Cannot determine the location todoist-cli is running from")
 	}
 
-	outputStream := color.Output
+	templates, err := config.LoadTemplates(fmt.Sprintf("%s/config.yml", currentExecutablePath))
+	if err != nil {
+		return err
+	}
+
+	config := config.LoadConfiguration()
+
+	outputStream := output.NewWriter(color.Output)
+	outputStream.SetTemplates(templates)
+	outputFormat := output.Text
+	rootCommand.PersistentFlags().StringVar(&outputFormat, "output", output.Text, fmt.Sprintf("the format output is written in, options are %s", strings.Join(output.Formats(), ", ")))
+	rootCommand.PersistentPreRunE = func(command *cobra.Command, args []string) error {
+		err := outputStream.SetFormat(outputFormat)
+		if err != nil {
+			return err
+		}
+		return errorStream.SetFormat(outputFormat)
+	}
+
+	retryPolicy := rest.DefaultRetryPolicy
+	retryPolicy.MaximumAttempts = config.MaximumRequestAttempts
+	rest.Configure(config.RequestTimeout, retryPolicy)
+
 	api := todoist.NewAPI(*config)
 
 	authenticationFilePath := fmt.Sprintf("%s/authentication.data", currentExecutablePath)
@@ -42,14 +99,38 @@ func Initialize() error {
 	authenticationRepository := authentication.NewAuthenticationRepository(storage.NewFile(authenticationFilePath))
 	authenticationService := authentication.NewAuthenticationService(api, authenticationRepository, *config, authenticationServer)
 
+	replicaFilePath := fmt.Sprintf("%s/replica.data", currentExecutablePath)
+	replicaRepository := replica.NewReplicaRepository(storage.NewFile(replicaFilePath))
+	queueFilePath := fmt.Sprintf("%s/queue.data", currentExecutablePath)
+	queueRepository := queue.NewQueueRepository(storage.NewFile(queueFilePath))
+	journalFilePath := fmt.Sprintf("%s/journal.data", currentExecutablePath)
+	journalRepository := journal.NewJournalRepository(storage.NewFile(journalFilePath))
+	replicaService := replica.NewReplicaService(api, authenticationService, replicaRepository, queueRepository, journalRepository)
+
 	tasksFilePath := fmt.Sprintf("%s/tasks.data", currentExecutablePath)
 	tasksFile := storage.NewFile(tasksFilePath)
 	taskRepository := repositories.NewTaskRepository(tasksFile)
-	taskService := services.NewTaskService(api, authenticationService, taskRepository)
+	taskService := services.NewTaskService(api, authenticationService, replicaService, taskRepository)
+
+	projectsFilePath := fmt.Sprintf("%s/projects.data", currentExecutablePath)
+	projectRepository := projectRepositories.NewProjectRepository(storage.NewFile(projectsFilePath))
+	projectService := projectServices.NewProjectService(api, authenticationService, replicaService, projectRepository)
+
+	labelsFilePath := fmt.Sprintf("%s/labels.data", currentExecutablePath)
+	labelRepository := labelRepositories.NewLabelRepository(storage.NewFile(labelsFilePath))
+	labelService := labelServices.NewLabelService(api, authenticationService, replicaService, labelRepository)
+
+	noteService := noteServices.NewNoteService(api, authenticationService, replicaService, taskRepository, projectRepository)
 
 	rootCommand.AddCommand(login.NewLoginCommand(outputStream, authenticationService, guid.NewString()))
-	rootCommand.AddCommand(logout.NewLogoutCommand(outputStream, authenticationService))
-	rootCommand.AddCommand(tasks.NewTasksCommand(outputStream, authenticationService, taskService))
+	rootCommand.AddCommand(logout.NewLogoutCommand(outputStream, authenticationService, replicaService, taskRepository, projectRepository, labelRepository))
+	rootCommand.AddCommand(quickadd.NewQuickAddCommand(outputStream, authenticationService, taskService))
+	rootCommand.AddCommand(tasks.NewTasksCommand(outputStream, authenticationService, taskService, noteService))
+	rootCommand.AddCommand(projects.NewProjectsCommand(outputStream, authenticationService, projectService, noteService))
+	rootCommand.AddCommand(labelCommands.NewLabelsCommand(outputStream, authenticationService, labelService))
+	rootCommand.AddCommand(queueCommands.NewQueueCommand(outputStream, authenticationService, replicaService))
+	rootCommand.AddCommand(undo.NewUndoCommand(outputStream, authenticationService, replicaService))
+	rootCommand.AddCommand(history.NewHistoryCommand(outputStream, authenticationService, replicaService))
 
 	return rootCommand.Execute()
 }
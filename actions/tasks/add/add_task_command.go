@@ -1,17 +1,22 @@
 package add
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
 	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
 	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
 	"github.com/spf13/cobra"
 )
 
 const (
-	successfullyAddedTask = "Task has been added"
+	successfullyAddedTask  = "Task has been added with Todoist id %d"
+	successfullyQueuedTask = "Todoist could not be reached, the task will be added on the next sync"
 
 	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
 	errorContentNotProvided        = "Error, content must be provided when creating a task"
@@ -20,6 +25,7 @@ const (
 )
 
 type dependencies struct {
+	inputStream           io.Reader
 	outputStream          io.Writer
 	authenticationService authentication.Service
 	taskService           services.TaskService
@@ -33,49 +39,68 @@ func NewAddTaskCommand(o io.Writer, a authentication.Service, t services.TaskSer
 		taskService:           t,
 	}
 
-	content := ""
-	due := ""
-	priority := 1
+	options := types.AddTaskOptions{}
+	parent := ""
 
 	var addTaskCommand = &cobra.Command{
 		Use:   "add",
 		Short: "Add task",
 		Long:  "Adds a task",
 		Args:  cobra.OnlyValidArgs,
-		Run: func(command *cobra.Command, args []string) {
-			err := execute(dependencies, content, due, priority)
-			if err != nil {
-				fmt.Fprint(dependencies.outputStream, err.Error())
-			}
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			return execute(dependencies, options, parent)
 		},
 	}
 
-	addTaskCommand.Flags().StringVarP(&content, "content", "c", "", "the content of the task")
-	addTaskCommand.Flags().StringVarP(&due, "due", "d", "today", "the due date of the task (either in plain-text 'today', 'tomorrow', etc, or in long format)")
-	addTaskCommand.Flags().IntVarP(&priority, "priority", "p", 1, "the priority of the task, options are 1 - 4 with 4 being the highest")
+	addTaskCommand.Flags().StringVarP(&options.Content, "content", "c", "", "the content of the task")
+	addTaskCommand.Flags().StringVarP(&options.Due, "due", "d", "today", "the due date of the task (either in plain-text 'today', 'tomorrow', etc, or in long format)")
+	addTaskCommand.Flags().IntVarP(&options.Priority, "priority", "p", 1, "the priority of the task, options are 1 - 4 with 4 being the highest")
+	addTaskCommand.Flags().StringVar(&options.Project, "project", "", "the name or Todoist id of the project to add the task to, defaults to the Inbox")
+	addTaskCommand.Flags().StringVar(&options.Section, "section", "", "the name or Todoist id of the section to add the task to")
+	addTaskCommand.Flags().StringVar(&options.Deadline, "deadline", "", "the date the task has to be done by as YYYY-MM-DD, unlike the due date a deadline has no time and does not recur")
+	addTaskCommand.Flags().StringArrayVar(&options.Labels, "label", nil, "the name of a label to attach to the task, can be repeated, @label in the content also attaches a label")
+	addTaskCommand.Flags().StringVar(&parent, "parent", "", "the task to add the task underneath as a sub-task, as its id, its Todoist id, e.g. t:123456, or part of its content")
 
 	return addTaskCommand
 }
 
-func execute(d *dependencies, content string, due string, priority int) error {
-	if content == "" {
-		return errors.New(errorContentNotProvided)
+func execute(d *dependencies, options types.AddTaskOptions, parent string) error {
+	if options.Content == "" {
+		return failures.New(failures.Validation, errorContentNotProvided)
 	}
 
-	if !((priority <= 4) && (priority >= 1)) {
-		return errors.New(errorInvalidPriority)
+	if !((options.Priority <= 4) && (options.Priority >= 1)) {
+		return failures.New(failures.Validation, errorInvalidPriority)
 	}
 
 	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
 	if !isAuthenticated {
-		return errors.New(errorNotCurrentlyAuthenticated)
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
 	}
 
-	err := d.taskService.AddTask(content, due, priority)
+	if parent != "" {
+		parentID, err := resolve.TaskID(d.inputStream, d.outputStream, d.taskService, parent)
+		if err != nil {
+			return err
+		}
+		options.ParentID = parentID
+	}
+
+	todoistID, err := d.taskService.AddTask(options)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.NotFound) || failures.Is(err, failures.Validation) {
+		return err
+	}
 	if err != nil {
-		return errors.New(errorTaskNotAdded)
+		return failures.Wrap(err, errorTaskNotAdded)
+	}
+
+	if todoistID == 0 {
+		output.WriteMessage(d.outputStream, successfullyQueuedTask)
+		return warning
 	}
 
-	fmt.Fprint(d.outputStream, successfullyAddedTask)
-	return nil
+	output.WriteMessage(d.outputStream, fmt.Sprintf(successfullyAddedTask, todoistID), output.Field{Name: "todoist_id", Value: todoistID})
+	return warning
 }
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/kpdowns/todoist-cli/failures"
 	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,9 +23,9 @@ func TestNotAuthenticated(t *testing.T) {
 		`-c="test content"`,
 	})
 
-	addTaskCommand.Execute()
+	err := addTaskCommand.Execute()
 
-	assert.Equal(t, errorNotCurrentlyAuthenticated, mockOutputStream.String())
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
 }
 
 func TestInputParameters(t *testing.T) {
@@ -40,9 +43,9 @@ func TestInputParameters(t *testing.T) {
 			`-p=5`,
 		})
 
-		addTaskCommand.Execute()
+		err := addTaskCommand.Execute()
 
-		assert.Equal(t, errorInvalidPriority, mockOutputStream.String())
+		assert.Equal(t, errorInvalidPriority, err.Error())
 
 	})
 
@@ -54,9 +57,9 @@ func TestInputParameters(t *testing.T) {
 		mockOutputStream := &bytes.Buffer{}
 
 		addTaskCommand := NewAddTaskCommand(mockOutputStream, mockAuthenticationService, nil)
-		addTaskCommand.Execute()
+		err := addTaskCommand.Execute()
 
-		assert.Equal(t, errorContentNotProvided, mockOutputStream.String())
+		assert.Equal(t, errorContentNotProvided, err.Error())
 
 	})
 }
@@ -69,8 +72,8 @@ func TestAddingATask(t *testing.T) {
 		}
 		mockOutputStream := &bytes.Buffer{}
 		mockTaskService := &mocks.MockTaskService{
-			AddTaskFunctionToExecute: func(content string, due string, priority int) error {
-				return errors.New("error while adding task")
+			AddTaskFunctionToExecute: func(options types.AddTaskOptions) (int64, error) {
+				return 0, errors.New("error while adding task")
 			},
 		}
 
@@ -79,9 +82,9 @@ func TestAddingATask(t *testing.T) {
 			`-c="test content"`,
 		})
 
-		addTaskCommand.Execute()
+		err := addTaskCommand.Execute()
 
-		assert.Equal(t, errorTaskNotAdded, mockOutputStream.String())
+		assert.Equal(t, errorTaskNotAdded, err.Error())
 
 	})
 
@@ -91,8 +94,54 @@ func TestAddingATask(t *testing.T) {
 		}
 		mockOutputStream := &bytes.Buffer{}
 		mockTaskService := &mocks.MockTaskService{
-			AddTaskFunctionToExecute: func(content string, due string, priority int) error {
-				return nil
+			AddTaskFunctionToExecute: func(options types.AddTaskOptions) (int64, error) {
+				return 12345, nil
+			},
+		}
+
+		addTaskCommand := NewAddTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		addTaskCommand.SetArgs([]string{
+			`-c="test content"`,
+		})
+
+		addTaskCommand.Execute()
+
+		assert.Equal(t, "Task has been added with Todoist id 12345", mockOutputStream.String())
+
+	})
+
+	t.Run("When creating a task and Todoist rejects it, then the reason given by Todoist is written to console", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		rejection := todoist.CommandErrors{{UUID: "uuid", Code: 36, Message: "Date is invalid"}}
+		mockTaskService := &mocks.MockTaskService{
+			AddTaskFunctionToExecute: func(options types.AddTaskOptions) (int64, error) {
+				return 0, rejection
+			},
+		}
+
+		addTaskCommand := NewAddTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		addTaskCommand.SetArgs([]string{
+			`-c="test content"`,
+		})
+
+		err := addTaskCommand.Execute()
+
+		assert.Equal(t, rejection.Error(), err.Error())
+
+	})
+
+	t.Run("When creating a task and Todoist rejects a command queued while offline, then the task is reported as created and the rejection is returned as a warning", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		warning := failures.NewWarning(todoist.CommandErrors{{UUID: "queued", Code: 22, Message: "Item not found", Queued: true}})
+		mockTaskService := &mocks.MockTaskService{
+			AddTaskFunctionToExecute: func(options types.AddTaskOptions) (int64, error) {
+				return 12345, warning
 			},
 		}
 
@@ -101,9 +150,74 @@ func TestAddingATask(t *testing.T) {
 			`-c="test content"`,
 		})
 
+		err := addTaskCommand.Execute()
+
+		assert.Equal(t, "Task has been added with Todoist id 12345", mockOutputStream.String())
+		assert.Equal(t, warning, err)
+		assert.Equal(t, 0, failures.ExitCode(err))
+
+	})
+
+	t.Run("When creating a task while offline, then a message stating that the task was queued is written to console", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			AddTaskFunctionToExecute: func(options types.AddTaskOptions) (int64, error) {
+				return 0, nil
+			},
+		}
+
+		addTaskCommand := NewAddTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		addTaskCommand.SetArgs([]string{
+			`-c="test content"`,
+		})
+
+		addTaskCommand.Execute()
+
+		assert.Equal(t, successfullyQueuedTask, mockOutputStream.String())
+
+	})
+
+	t.Run("When creating a task with a project, section, parent and labels, then those are provided to the task service", func(t *testing.T) {
+		var providedOptions types.AddTaskOptions
+		var providedReference types.TaskReference
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			AddTaskFunctionToExecute: func(options types.AddTaskOptions) (int64, error) {
+				providedOptions = options
+				return 12345, nil
+			},
+			ResolveTaskFunc: func(reference types.TaskReference) (*types.Task, error) {
+				providedReference = reference
+				return &types.Task{ID: 3}, nil
+			},
+		}
+
+		addTaskCommand := NewAddTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		addTaskCommand.SetArgs([]string{
+			`-c=test content`,
+			`--project=Work`,
+			`--section=Meetings`,
+			`--parent=plan holiday`,
+			`--label=errand`,
+			`--label=@focus`,
+		})
+
 		addTaskCommand.Execute()
 
-		assert.Equal(t, successfullyAddedTask, mockOutputStream.String())
+		assert.Equal(t, "Task has been added with Todoist id 12345", mockOutputStream.String())
+		assert.Equal(t, "test content", providedOptions.Content)
+		assert.Equal(t, "Work", providedOptions.Project)
+		assert.Equal(t, "Meetings", providedOptions.Section)
+		assert.Equal(t, uint32(3), providedOptions.ParentID)
+		assert.Equal(t, "plan holiday", providedReference.Content)
+		assert.Equal(t, []string{"errand", "@focus"}, providedOptions.Labels)
 
 	})
 
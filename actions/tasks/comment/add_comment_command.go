@@ -0,0 +1,109 @@
+package comment
+
+import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	noteServices "github.com/kpdowns/todoist-cli/notes/services"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successCommentAdded = "The comment has successfully been added"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNoTaskReferenced          = "Error, the task to comment on must be provided using --id"
+	errorNoContent                 = "Error, the text of the comment must be provided"
+	errorCannotReadFile            = "Error, the file '%s' could not be read"
+	errorFailedToAddComment        = "An error occurred while adding the comment"
+)
+
+type dependencies struct {
+	inputStream           io.Reader
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+	noteService           noteServices.NoteService
+}
+
+// NewAddCommentCommand creates an instance of the command that posts a comment on a task, optionally with a file attached
+func NewAddCommentCommand(o io.Writer, a authentication.Service, t services.TaskService, n noteServices.NoteService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+		noteService:           n,
+	}
+
+	taskReference := ""
+	filePath := ""
+
+	var addCommentCommand = &cobra.Command{
+		Use:   "comment",
+		Short: "Comment on a task",
+		Long: "Post a comment on a task, optionally with a file attached. " +
+			"The task is referenced by its id, its Todoist id, e.g. t:123456, or part of its content.",
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			return execute(dependencies, taskReference, strings.Join(args, " "), filePath)
+		},
+	}
+
+	addCommentCommand.Flags().StringVarP(&taskReference, "id", "i", "", "the task to comment on, as its id, its Todoist id, e.g. t:123456, or part of its content")
+	addCommentCommand.Flags().StringVarP(&filePath, "file", "f", "", "the path of a file to upload and attach to the comment")
+
+	return addCommentCommand
+}
+
+func execute(d *dependencies, taskReference string, content string, filePath string) error {
+	if taskReference == "" {
+		return failures.New(failures.Validation, errorNoTaskReferenced)
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return failures.New(failures.Validation, errorNoContent)
+	}
+
+	var attachment *types.Attachment
+	if filePath != "" {
+		contents, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return failures.Newf(failures.Validation, errorCannotReadFile, filePath)
+		}
+		attachment = &types.Attachment{
+			FileName: filepath.Base(filePath),
+			Contents: contents,
+		}
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	taskID, err := resolve.TaskID(d.inputStream, d.outputStream, d.taskService, taskReference)
+	if err != nil {
+		return err
+	}
+
+	err = d.noteService.AddTaskNote(taskID, content, attachment)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.Validation) || failures.Is(err, failures.NotFound) || failures.Is(err, failures.Network) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToAddComment)
+	}
+
+	output.WriteMessage(d.outputStream, successCommentAdded)
+	return warning
+}
@@ -0,0 +1,163 @@
+package comment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	addCommentCommand := NewAddCommentCommand(mockOutputStream, mockAuthenticationService, nil, nil)
+	addCommentCommand.SetArgs([]string{"--id", "1", "Called the plumber"})
+	err := addCommentCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When no task is referenced, then a validation error is returned", func(t *testing.T) {
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, &mocks.MockAuthenticationService{}, nil, nil)
+		addCommentCommand.SetArgs([]string{"Called the plumber"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, errorNoTaskReferenced, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When no text is provided, then a validation error is returned", func(t *testing.T) {
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, &mocks.MockAuthenticationService{}, nil, nil)
+		addCommentCommand.SetArgs([]string{"--id", "1"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, errorNoContent, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When the file to attach cannot be read, then a validation error is returned", func(t *testing.T) {
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, &mocks.MockAuthenticationService{}, nil, nil)
+		addCommentCommand.SetArgs([]string{"--id", "1", "--file", "does-not-exist.pdf", "Quote"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, fmt.Sprintf(errorCannotReadFile, "does-not-exist.pdf"), err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When authenticated and the comment is added, then the text is provided to the note service and a message is written to output stream", func(t *testing.T) {
+
+		var requestedTaskID uint32
+		var requestedContent string
+		var requestedAttachment *types.Attachment
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			AddTaskNoteFunc: func(taskID uint32, content string, attachment *types.Attachment) error {
+				requestedTaskID = taskID
+				requestedContent = content
+				requestedAttachment = attachment
+				return nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		addCommentCommand := NewAddCommentCommand(mockOutputStream, mockAuthenticationService, &mocks.MockTaskService{}, mockNoteService)
+		addCommentCommand.SetArgs([]string{"--id", "3", "Called", "the", "plumber"})
+		addCommentCommand.Execute()
+
+		assert.Equal(t, uint32(3), requestedTaskID)
+		assert.Equal(t, "Called the plumber", requestedContent)
+		assert.Nil(t, requestedAttachment)
+		assert.Equal(t, successCommentAdded, mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and a file is attached, then the name and contents of the file are provided to the note service", func(t *testing.T) {
+
+		directory, _ := ioutil.TempDir("", "todoist-cli")
+		defer os.RemoveAll(directory)
+		filePath := filepath.Join(directory, "quote.pdf")
+		ioutil.WriteFile(filePath, []byte("contents"), 0600)
+
+		var requestedAttachment *types.Attachment
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			AddTaskNoteFunc: func(taskID uint32, content string, attachment *types.Attachment) error {
+				requestedAttachment = attachment
+				return nil
+			},
+		}
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{}, mockNoteService)
+		addCommentCommand.SetArgs([]string{"--id", "3", "--file", filePath, "Quote"})
+		err := addCommentCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, &types.Attachment{FileName: "quote.pdf", Contents: []byte("contents")}, requestedAttachment)
+
+	})
+
+	t.Run("When authenticated and Todoist cannot be reached to upload the attachment, then the network error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			AddTaskNoteFunc: func(taskID uint32, content string, attachment *types.Attachment) error {
+				return todoist.ErrUnreachable
+			},
+		}
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{}, mockNoteService)
+		addCommentCommand.SetArgs([]string{"--id", "3", "Quote"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, todoist.ErrUnreachable, err)
+
+	})
+
+	t.Run("When authenticated and an error occurs while adding the comment, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			AddTaskNoteFunc: func(taskID uint32, content string, attachment *types.Attachment) error {
+				return errors.New("test error")
+			},
+		}
+
+		addCommentCommand := NewAddCommentCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{}, mockNoteService)
+		addCommentCommand.SetArgs([]string{"--id", "3", "Quote"})
+		err := addCommentCommand.Execute()
+
+		assert.Equal(t, errorFailedToAddComment, err.Error())
+
+	})
+
+}
@@ -0,0 +1,85 @@
+package comments
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	noteServices "github.com/kpdowns/todoist-cli/notes/services"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/spf13/cobra"
+)
+
+const (
+	noCommentsMessage              = "There are no comments on this task"
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+)
+
+type dependencies struct {
+	inputStream           io.Reader
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+	noteService           noteServices.NoteService
+}
+
+// NewListCommentsCommand creates an instance of the command that prints the comments on a task to the console
+func NewListCommentsCommand(o io.Writer, a authentication.Service, t services.TaskService, n noteServices.NoteService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+		noteService:           n,
+	}
+
+	taskReference := ""
+
+	var listCommentsCommand = &cobra.Command{
+		Use:   "comments",
+		Short: "List comments on a task",
+		Long: "List the comments on a task, from the oldest to the most recently posted. " +
+			"The task is referenced by its id, its Todoist id, e.g. t:123456, or part of its content.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			if taskReference == "" && len(args) == 1 {
+				taskReference = args[0]
+			}
+			return execute(dependencies, taskReference)
+		},
+	}
+
+	listCommentsCommand.Flags().StringVarP(&taskReference, "id", "i", "", "the task to list the comments on, as its id, its Todoist id, e.g. t:123456, or part of its content")
+
+	return listCommentsCommand
+}
+
+func execute(d *dependencies, taskReference string) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	taskID, err := resolve.TaskID(d.inputStream, d.outputStream, d.taskService, taskReference)
+	if err != nil {
+		return err
+	}
+
+	notes, err := d.noteService.GetTaskNotes(taskID)
+	if err != nil {
+		return err
+	}
+
+	return output.WriteList(d.outputStream, types.RecordColumns(), notes.AsRecords(), func() {
+		writeText(d, notes)
+	})
+}
+
+func writeText(d *dependencies, notes types.NoteList) {
+	output.WriteRows(d.outputStream, noCommentsMessage, len(notes), func(index int) string {
+		return notes[index].AsString()
+	})
+}
@@ -0,0 +1,135 @@
+package comments
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/kpdowns/todoist-cli/output"
+	taskTypes "github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	listCommentsCommand := NewListCommentsCommand(mockOutputStream, mockAuthenticationService, nil, nil)
+	listCommentsCommand.SetArgs([]string{"--id", "1"})
+	err := listCommentsCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestWrittingToOutputStream(t *testing.T) {
+
+	t.Run("When authenticated and no task is referenced, then a validation error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		listCommentsCommand := NewListCommentsCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{}, &mocks.MockNoteService{})
+		err := listCommentsCommand.Execute()
+
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When authenticated and an error occurs while retrieving comments, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			GetTaskNotesFunc: func(taskID uint32) (types.NoteList, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		listCommentsCommand := NewListCommentsCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{}, mockNoteService)
+		listCommentsCommand.SetArgs([]string{"--id", "1"})
+		err := listCommentsCommand.Execute()
+
+		assert.Equal(t, "test error", err.Error())
+
+	})
+
+	t.Run("When authenticated and there are no comments, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			GetTaskNotesFunc: func(taskID uint32) (types.NoteList, error) {
+				return types.NoteList{}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listCommentsCommand := NewListCommentsCommand(mockOutputStream, mockAuthenticationService, &mocks.MockTaskService{}, mockNoteService)
+		listCommentsCommand.SetArgs([]string{"1"})
+		listCommentsCommand.Execute()
+
+		assert.Equal(t, noCommentsMessage, mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and the task is referenced by content, then the comments on the matching task are written to output stream", func(t *testing.T) {
+
+		var requestedTaskID uint32
+		noteToBeWritten := types.Note{Content: "Called the plumber"}
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockTaskService := &mocks.MockTaskService{
+			ResolveTaskFunc: func(reference taskTypes.TaskReference) (*taskTypes.Task, error) {
+				return &taskTypes.Task{ID: 9, Content: "Fix the sink"}, nil
+			},
+		}
+		mockNoteService := &mocks.MockNoteService{
+			GetTaskNotesFunc: func(taskID uint32) (types.NoteList, error) {
+				requestedTaskID = taskID
+				return types.NoteList{noteToBeWritten}, nil
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listCommentsCommand := NewListCommentsCommand(mockOutputStream, mockAuthenticationService, mockTaskService, mockNoteService)
+		listCommentsCommand.SetArgs([]string{"fix the sink"})
+		listCommentsCommand.Execute()
+
+		assert.Equal(t, uint32(9), requestedTaskID)
+		assert.Equal(t, noteToBeWritten.AsString()+"\n", mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and the output is YAML, then the comments are written as a YAML list of records", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockNoteService := &mocks.MockNoteService{
+			GetTaskNotesFunc: func(taskID uint32) (types.NoteList, error) {
+				return types.NoteList{{TodoistID: 10, Content: "Called the plumber", PostedBy: "Sam"}}, nil
+			},
+		}
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("yaml")
+
+		listCommentsCommand := NewListCommentsCommand(outputStream, mockAuthenticationService, &mocks.MockTaskService{}, mockNoteService)
+		listCommentsCommand.SetArgs([]string{"--id", "1"})
+		listCommentsCommand.Execute()
+
+		assert.Equal(t, "- todoist_id: 10\n  posted: \"\"\n  posted_by: Sam\n  content: Called the plumber\n  attachment_name: \"\"\n  attachment_url: \"\"\n", buffer.String())
+
+	})
+
+}
@@ -1,12 +1,16 @@
 package complete
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
 	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
 	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
 	"github.com/spf13/cobra"
 )
 
@@ -14,9 +18,12 @@ const (
 	errorFailedToCompleteTask      = "An error occurred while completing the task"
 	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
 	successTaskFlaggedAsCompleted  = "The task has successfully been completed"
+	successTasksFlaggedAsCompleted = "%d tasks have successfully been completed"
+	successTaskRescheduled         = "[%d] '%s' is recurring and is next due %s"
 )
 
 type dependencies struct {
+	inputStream           io.Reader
 	outputStream          io.Writer
 	authenticationService authentication.Service
 	taskService           services.TaskService
@@ -30,37 +37,66 @@ func NewCompleteTaskCommand(o io.Writer, a authentication.Service, t services.Ta
 		taskService:           t,
 	}
 
-	taskID := 0
+	taskIDs := ""
+	options := types.CompleteTaskOptions{}
 
 	var completeTaskCommand = &cobra.Command{
 		Use:   "complete",
 		Short: "Complete task",
-		Long:  "Flag a task as completed given a task id",
-		Args:  cobra.OnlyValidArgs,
-		Run: func(command *cobra.Command, args []string) {
-			err := execute(dependencies, uint32(taskID))
-			if err != nil {
-				fmt.Fprint(o, err.Error())
+		Long: "Flag tasks as completed given a comma separated list of task ids, ranges of task ids and Todoist ids, e.g. 1,3,7, 2-5 or t:123456, " +
+			"or part of the content of a task, e.g. todoist tasks complete \"buy milk\".\n\n" +
+			"Todoist completes the sub-tasks of a task along with it, so tasks with uncompleted sub-tasks are only completed with --with-sub-tasks.\n\n" +
+			"Recurring tasks are rescheduled to their next occurrence, which is shown once the task has been completed. Use --forever to complete them for good.",
+		Args: cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			if taskIDs != "" {
+				args = append([]string{taskIDs}, args...)
 			}
+			return execute(dependencies, args, options)
 		},
 	}
 
-	completeTaskCommand.Flags().IntVarP(&taskID, "id", "i", 0, "the id of the task to flag as completed")
+	completeTaskCommand.Flags().StringVarP(&taskIDs, "id", "i", "", "the tasks to flag as completed, as ids, ranges of ids or Todoist ids, e.g. 1,3,7, 2-5 or t:123456, or part of the content of a task")
+	completeTaskCommand.Flags().BoolVar(&options.WithSubTasks, "with-sub-tasks", false, "complete the uncompleted sub-tasks of the tasks as well")
+	completeTaskCommand.Flags().BoolVar(&options.Forever, "forever", false, "complete recurring tasks for good instead of rescheduling them to their next occurrence")
 
 	return completeTaskCommand
 }
 
-func execute(d *dependencies, taskID uint32) error {
+func execute(d *dependencies, taskReferences []string, options types.CompleteTaskOptions) error {
 	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
 	if !isAuthenticated {
-		return errors.New(errorNotCurrentlyAuthenticated)
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
 	}
 
-	err := d.taskService.CompleteTask(taskID)
+	parsedTaskIDs, err := resolve.TaskIDs(d.inputStream, d.outputStream, d.taskService, taskReferences)
 	if err != nil {
-		return errors.New(errorFailedToCompleteTask)
+		return err
 	}
 
-	fmt.Fprintf(d.outputStream, successTaskFlaggedAsCompleted)
-	return nil
+	rescheduledTasks, err := d.taskService.CompleteTasks(parsedTaskIDs, options)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.Validation) || failures.Is(err, failures.NotFound) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToCompleteTask)
+	}
+
+	message := successTaskFlaggedAsCompleted
+	if len(parsedTaskIDs) > 1 {
+		message = fmt.Sprintf(successTasksFlaggedAsCompleted, len(parsedTaskIDs))
+	}
+	for _, rescheduledTask := range rescheduledTasks {
+		message += "\n" + fmt.Sprintf(successTaskRescheduled, rescheduledTask.ID, rescheduledTask.Content, rescheduledTask.DisplayedDueDate())
+	}
+
+	if len(rescheduledTasks) == 0 {
+		output.WriteMessage(d.outputStream, message)
+		return warning
+	}
+
+	output.WriteMessage(d.outputStream, message, output.Field{Name: "rescheduled", Value: rescheduledTasks.AsRecords()})
+	return warning
 }
@@ -3,11 +3,16 @@ package complete
 import (
 	"bytes"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kpdowns/todoist-cli/failures"
 	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/tasks/repositories"
+	"github.com/kpdowns/todoist-cli/tasks/types"
 )
 
 func TestNotAuthenticated(t *testing.T) {
@@ -17,14 +22,14 @@ func TestNotAuthenticated(t *testing.T) {
 	mockOutputStream := &bytes.Buffer{}
 
 	completeTasksCommand := NewCompleteTaskCommand(mockOutputStream, mockAuthenticationService, nil)
-	completeTasksCommand.Execute()
+	err := completeTasksCommand.Execute()
 
-	assert.Equal(t, errorNotCurrentlyAuthenticated, mockOutputStream.String())
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
 }
 
 func TestWrittingToOutputStream(t *testing.T) {
 
-	t.Run("When authenticated and an error occurs while completing the task, then message is written to output stream", func(t *testing.T) {
+	t.Run("When authenticated and an error occurs while completing the task, then the error is returned", func(t *testing.T) {
 
 		mockAuthenticationService := &mocks.MockAuthenticationService{
 			AuthenticatedStateToReturn: true,
@@ -33,15 +38,60 @@ func TestWrittingToOutputStream(t *testing.T) {
 		mockOutputStream := &bytes.Buffer{}
 
 		mockTaskService := &mocks.MockTaskService{
-			CompleteTaskFunc: func(uint32) error {
-				return errors.New("Test error")
+			CompleteTasksFunc: func([]uint32, types.CompleteTaskOptions) (types.TaskList, error) {
+				return nil, errors.New("Test error")
 			},
 		}
 
 		completeTasksCommand := NewCompleteTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
-		completeTasksCommand.Execute()
+		completeTasksCommand.SetArgs([]string{"-i=1"})
+		err := completeTasksCommand.Execute()
+
+		assert.Equal(t, errorFailedToCompleteTask, err.Error())
+
+	})
+
+	t.Run("When authenticated and the task does not exist, then the error returned is a not found error", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockTaskService := &mocks.MockTaskService{
+			CompleteTasksFunc: func([]uint32, types.CompleteTaskOptions) (types.TaskList, error) {
+				return nil, failures.New(failures.NotFound, "The requested task 1 does not exist.")
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"-i=1"})
+		err := completeTasksCommand.Execute()
+
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+		assert.Equal(t, 4, failures.ExitCode(err))
+
+	})
 
-		assert.Equal(t, errorFailedToCompleteTask, mockOutputStream.String())
+	t.Run("When authenticated and the id of the task has been retired, then the error describing the stale reference is returned", func(t *testing.T) {
+
+		retiredTaskError := &repositories.RetiredTaskError{ID: 1, Content: "buy milk"}
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockTaskService := &mocks.MockTaskService{
+			CompleteTasksFunc: func([]uint32, types.CompleteTaskOptions) (types.TaskList, error) {
+				return nil, retiredTaskError
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"-i=1"})
+		err := completeTasksCommand.Execute()
+
+		assert.Equal(t, retiredTaskError.Error(), err.Error())
+		assert.Equal(t, 4, failures.ExitCode(err))
 
 	})
 
@@ -54,16 +104,182 @@ func TestWrittingToOutputStream(t *testing.T) {
 		mockOutputStream := &bytes.Buffer{}
 
 		mockTaskService := &mocks.MockTaskService{
-			CompleteTaskFunc: func(uint32) error {
-				return nil
+			CompleteTasksFunc: func([]uint32, types.CompleteTaskOptions) (types.TaskList, error) {
+				return nil, nil
 			},
 		}
 
 		completeTasksCommand := NewCompleteTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"-i=1"})
 		completeTasksCommand.Execute()
 
 		assert.Equal(t, successTaskFlaggedAsCompleted, mockOutputStream.String())
 
 	})
 
+	t.Run("When authenticated and the task ids are not valid, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockOutputStream := &bytes.Buffer{}
+
+		completeTasksCommand := NewCompleteTaskCommand(mockOutputStream, mockAuthenticationService, &mocks.MockTaskService{})
+		completeTasksCommand.SetArgs([]string{"-i=5-2"})
+		err := completeTasksCommand.Execute()
+
+		_, expectedError := types.ParseTaskIDs("5-2")
+		assert.Equal(t, expectedError.Error(), err.Error())
+
+	})
+
+	t.Run("When authenticated and completing several tasks, then every task id is provided to the task service", func(t *testing.T) {
+
+		var requestedTaskIDs []uint32
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockOutputStream := &bytes.Buffer{}
+
+		mockTaskService := &mocks.MockTaskService{
+			CompleteTasksFunc: func(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error) {
+				requestedTaskIDs = taskIDs
+				return nil, nil
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"--id", "1,3,7-8"})
+		completeTasksCommand.Execute()
+
+		assert.Equal(t, []uint32{1, 3, 7, 8}, requestedTaskIDs)
+		assert.Equal(t, "4 tasks have successfully been completed", mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and tasks are referenced by content, then the matching tasks are completed, asking which task was meant when several match", func(t *testing.T) {
+
+		var requestedTaskIDs []uint32
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockTaskService := &mocks.MockTaskService{
+			ResolveTaskFunc: func(reference types.TaskReference) (*types.Task, error) {
+				if reference.Content == "buy" {
+					return nil, &types.AmbiguousTaskError{Reference: "buy", Matches: types.TaskList{{ID: 4, Content: "Buy milk"}, {ID: 5, Content: "Buy bread"}}}
+				}
+				return &types.Task{ID: 9, Content: "Write report"}, nil
+			},
+			CompleteTasksFunc: func(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error) {
+				requestedTaskIDs = taskIDs
+				return nil, nil
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetIn(strings.NewReader("2\n"))
+		completeTasksCommand.SetArgs([]string{"-i=1", "write report", "buy"})
+		completeTasksCommand.Execute()
+
+		assert.Equal(t, []uint32{1, 9, 5}, requestedTaskIDs)
+
+	})
+
+	t.Run("When authenticated and completing a task with its sub-tasks, then the task service is asked to complete the sub-tasks as well", func(t *testing.T) {
+
+		var requestedWithSubTasks bool
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockTaskService := &mocks.MockTaskService{
+			CompleteTasksFunc: func(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error) {
+				requestedWithSubTasks = options.WithSubTasks
+				return nil, nil
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"-i=1", "--with-sub-tasks"})
+		completeTasksCommand.Execute()
+
+		assert.True(t, requestedWithSubTasks)
+
+	})
+
+	t.Run("When authenticated and completing a recurring task forever, then the task service is asked to complete it for good", func(t *testing.T) {
+
+		var requestedOptions types.CompleteTaskOptions
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockTaskService := &mocks.MockTaskService{
+			CompleteTasksFunc: func(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error) {
+				requestedOptions = options
+				return nil, nil
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"1", "--forever"})
+		completeTasksCommand.Execute()
+
+		assert.Equal(t, types.CompleteTaskOptions{Forever: true}, requestedOptions)
+
+	})
+
+	t.Run("When authenticated and a recurring task is rescheduled, then its next occurrence is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockOutputStream := &bytes.Buffer{}
+
+		mockTaskService := &mocks.MockTaskService{
+			CompleteTasksFunc: func(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error) {
+				return types.TaskList{
+					{ID: 1, Content: "Water plants", DueDate: time.Date(2020, 4, 17, 9, 0, 0, 0, time.UTC), DueHasTime: true, IsRecurring: true},
+				}, nil
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"1"})
+		completeTasksCommand.Execute()
+
+		assert.Equal(t, successTaskFlaggedAsCompleted+"\n[1] 'Water plants' is recurring and is next due 2020-04-17 09:00", mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and the task has uncompleted sub-tasks, then the validation error of the task service is returned", func(t *testing.T) {
+
+		expectedError := failures.New(failures.Validation, "The task 1 has 2 uncompleted sub-tasks")
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		mockTaskService := &mocks.MockTaskService{
+			CompleteTasksFunc: func([]uint32, types.CompleteTaskOptions) (types.TaskList, error) {
+				return nil, expectedError
+			},
+		}
+
+		completeTasksCommand := NewCompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		completeTasksCommand.SetArgs([]string{"-i=1"})
+		err := completeTasksCommand.Execute()
+
+		assert.Equal(t, expectedError, err)
+
+	})
+
 }
@@ -0,0 +1,111 @@
+package delete
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/prompt"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	questionDeleteTask  = "Delete task %d and its sub-tasks? This cannot be undone."
+	questionDeleteTasks = "Delete %d tasks and their sub-tasks? This cannot be undone."
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorConfirmationRequired      = "Error, deleting tasks cannot be undone, use --yes to delete them without being asked"
+	errorFailedToDeleteTask        = "An error occurred while deleting the task"
+
+	successTaskDeleted  = "The task has successfully been deleted"
+	successTasksDeleted = "%d tasks have successfully been deleted"
+	successNothingDone  = "No tasks were deleted"
+)
+
+type dependencies struct {
+	inputStream           io.Reader
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+}
+
+// NewDeleteTaskCommand creates an instance of the command that deletes tasks along with their sub-tasks
+func NewDeleteTaskCommand(o io.Writer, a authentication.Service, t services.TaskService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+	}
+
+	taskIDs := ""
+	confirmed := false
+
+	var deleteTaskCommand = &cobra.Command{
+		Use:   "delete",
+		Short: "Delete task",
+		Long: "Delete tasks along with their sub-tasks given their ids, ranges of ids, Todoist ids, e.g. 1,3,7, 2-5 or t:123456, or part of their content. " +
+			"Deleted tasks cannot be restored, so you are asked to confirm unless --yes is provided.",
+		Args: cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			if taskIDs != "" {
+				args = append([]string{taskIDs}, args...)
+			}
+			return execute(dependencies, args, confirmed)
+		},
+	}
+
+	deleteTaskCommand.Flags().StringVarP(&taskIDs, "id", "i", "", "the tasks to delete, as ids, ranges of ids or Todoist ids, e.g. 1,3,7, 2-5 or t:123456")
+	deleteTaskCommand.Flags().BoolVarP(&confirmed, "yes", "y", false, "delete the tasks without asking for confirmation")
+
+	return deleteTaskCommand
+}
+
+func execute(d *dependencies, values []string, confirmed bool) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	taskIDs, err := resolve.TaskIDs(d.inputStream, d.outputStream, d.taskService, values)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		if !prompt.IsInteractive(d.inputStream, d.outputStream) {
+			return failures.New(failures.Validation, errorConfirmationRequired)
+		}
+
+		question := fmt.Sprintf(questionDeleteTasks, len(taskIDs))
+		if len(taskIDs) == 1 {
+			question = fmt.Sprintf(questionDeleteTask, taskIDs[0])
+		}
+		if !prompt.Confirm(d.inputStream, d.outputStream, question) {
+			output.WriteMessage(d.outputStream, successNothingDone)
+			return nil
+		}
+	}
+
+	err = d.taskService.DeleteTasks(taskIDs)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.NotFound) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToDeleteTask)
+	}
+
+	if len(taskIDs) == 1 {
+		output.WriteMessage(d.outputStream, successTaskDeleted)
+		return warning
+	}
+
+	output.WriteMessage(d.outputStream, fmt.Sprintf(successTasksDeleted, len(taskIDs)))
+	return warning
+}
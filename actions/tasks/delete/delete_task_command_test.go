@@ -0,0 +1,137 @@
+package delete
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+
+	deleteTaskCommand := NewDeleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, nil)
+	deleteTaskCommand.SetArgs([]string{"1", "--yes"})
+	err := deleteTaskCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestDeletingTasks(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	t.Run("When deleting tasks with --yes, then the tasks are deleted without asking", func(t *testing.T) {
+
+		var requestedTaskIDs []uint32
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			DeleteTasksFunc: func(taskIDs []uint32) error {
+				requestedTaskIDs = taskIDs
+				return nil
+			},
+		}
+
+		deleteTaskCommand := NewDeleteTaskCommand(mockOutputStream, authenticated, mockTaskService)
+		deleteTaskCommand.SetArgs([]string{"--id", "1,3", "--yes"})
+		err := deleteTaskCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{1, 3}, requestedTaskIDs)
+		assert.Equal(t, "2 tasks have successfully been deleted", mockOutputStream.String())
+
+	})
+
+	t.Run("When deleting a task and the deletion is confirmed, then the task is deleted", func(t *testing.T) {
+
+		var requestedTaskIDs []uint32
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			DeleteTasksFunc: func(taskIDs []uint32) error {
+				requestedTaskIDs = taskIDs
+				return nil
+			},
+		}
+
+		deleteTaskCommand := NewDeleteTaskCommand(mockOutputStream, authenticated, mockTaskService)
+		deleteTaskCommand.SetIn(strings.NewReader("y\n"))
+		deleteTaskCommand.SetArgs([]string{"1"})
+		err := deleteTaskCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{1}, requestedTaskIDs)
+		assert.Equal(t, "Delete task 1 and its sub-tasks? This cannot be undone. [y/N]: "+successTaskDeleted, mockOutputStream.String())
+
+	})
+
+	t.Run("When deleting a task and the deletion is declined, then nothing is deleted", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		deleteTaskCommand := NewDeleteTaskCommand(mockOutputStream, authenticated, &mocks.MockTaskService{})
+		deleteTaskCommand.SetIn(strings.NewReader("n\n"))
+		deleteTaskCommand.SetArgs([]string{"1"})
+		err := deleteTaskCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.True(t, strings.HasSuffix(mockOutputStream.String(), successNothingDone))
+
+	})
+
+	t.Run("When deleting a task without --yes and nobody can confirm, then a validation error is returned", func(t *testing.T) {
+
+		outputStream := output.NewWriter(&bytes.Buffer{})
+		outputStream.SetFormat("json")
+
+		deleteTaskCommand := NewDeleteTaskCommand(outputStream, authenticated, &mocks.MockTaskService{})
+		deleteTaskCommand.SetArgs([]string{"1"})
+		err := deleteTaskCommand.Execute()
+
+		assert.Equal(t, errorConfirmationRequired, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When deleting a task that does not exist, then the not found error is returned", func(t *testing.T) {
+
+		expectedError := failures.New(failures.NotFound, "The requested task 1 does not exist.")
+		mockTaskService := &mocks.MockTaskService{
+			DeleteTasksFunc: func([]uint32) error {
+				return expectedError
+			},
+		}
+
+		deleteTaskCommand := NewDeleteTaskCommand(&bytes.Buffer{}, authenticated, mockTaskService)
+		deleteTaskCommand.SetArgs([]string{"1", "-y"})
+		err := deleteTaskCommand.Execute()
+
+		assert.Equal(t, expectedError, err)
+
+	})
+
+	t.Run("When deleting a task and an error occurs, then an error is returned", func(t *testing.T) {
+
+		mockTaskService := &mocks.MockTaskService{
+			DeleteTasksFunc: func([]uint32) error {
+				return errors.New("test error")
+			},
+		}
+
+		deleteTaskCommand := NewDeleteTaskCommand(&bytes.Buffer{}, authenticated, mockTaskService)
+		deleteTaskCommand.SetArgs([]string{"1", "-y"})
+		err := deleteTaskCommand.Execute()
+
+		assert.Equal(t, errorFailedToDeleteTask, err.Error())
+
+	})
+
+}
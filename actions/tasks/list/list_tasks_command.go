@@ -1,19 +1,34 @@
 package list
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"text/tabwriter"
+	"time"
 
+	"github.com/fatih/color"
 	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/filters"
 	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
 	"github.com/spf13/cobra"
 )
 
 const (
+	groupByProject = "project"
+
 	noTasksMessage                 = "No tasks to complete across any of your projects"
+	noTasksInProjectMessage        = "No tasks to complete in project '%s'"
+	noMatchingTasksMessage         = "No tasks to complete match the provided filters"
+	noTasksInQueryMessage          = "No tasks to complete match this query"
 	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorInvalidGroupBy            = "Error, tasks can only be grouped by 'project'"
+	errorFormatRequiresText        = "Error, --format can only be used with text output"
+	errorFilterWithGroupBy         = "Error, tasks listed with --filter are already grouped by query and cannot be grouped by project"
+	errorDepthWithoutTree          = "Error, --depth can only be used with --tree"
+	errorInvalidDepth              = "Error, --depth must be 0 to show every level of sub-tasks, or the number of levels to show"
 )
 
 type dependencies struct {
@@ -22,6 +37,21 @@ type dependencies struct {
 	taskService           services.TaskService
 }
 
+type options struct {
+	project  string
+	due      string
+	priority string
+	labels   []string
+	search   string
+	filter   string
+	limit    int
+	cached   bool
+	groupBy  string
+	format   string
+	tree     bool
+	depth    int
+}
+
 // NewListTasksCommand creates an instance of the command that prints all tasks to the console
 func NewListTasksCommand(o io.Writer, a authentication.Service, t services.TaskService) *cobra.Command {
 	var dependencies = &dependencies{
@@ -30,41 +60,257 @@ func NewListTasksCommand(o io.Writer, a authentication.Service, t services.TaskS
 		taskService:           t,
 	}
 
+	options := &options{}
+
 	var listTasksCommand = &cobra.Command{
 		Use:   "list",
 		Short: "List tasks",
-		Long:  "List tasks for all or only a specific project",
-		Run: func(command *cobra.Command, args []string) {
-			err := execute(dependencies)
-			if err != nil {
-				fmt.Fprint(o, err.Error())
-			}
+		Long: "List tasks across all projects, optionally filtered by project, due date, priority, label or content. Every filter provided must match.\n\n" +
+			"--filter accepts the Todoist filter language, e.g. '(today | overdue) & #Work & p1'. Queries separated by commas are listed as separate sections.\n\n" +
+			"--tree lists sub-tasks indented below their parent task, sub-tasks deeper than --depth levels are collapsed into a count.",
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, options)
 		},
 	}
 
+	listTasksCommand.Flags().StringVar(&options.project, "project", "", "only list tasks in the project with this name or Todoist id")
+	listTasksCommand.Flags().StringVar(&options.due, "due", "", "only list tasks due today, tomorrow, overdue, this-week, before:YYYY-MM-DD or after:YYYY-MM-DD")
+	listTasksCommand.Flags().StringVar(&options.priority, "priority", "", "only list tasks with this priority from 1 to 4, or compared to it, e.g. '>=3'")
+	listTasksCommand.Flags().StringArrayVar(&options.labels, "label", nil, "only list tasks with this label, can be repeated to require several labels")
+	listTasksCommand.Flags().StringVar(&options.search, "search", "", "only list tasks whose content or description contains this text, or matches a /regular expression/")
+	listTasksCommand.Flags().StringVar(&options.filter, "filter", "", "only list tasks matching a Todoist filter query, e.g. 'today & @errand', comma separated queries are listed in sections")
+	listTasksCommand.Flags().IntVar(&options.limit, "limit", 0, "list at most this many tasks, or this many tasks per query of --filter")
+	listTasksCommand.Flags().BoolVar(&options.cached, "cached", false, "list the tasks as they were after the last sync without contacting Todoist")
+	listTasksCommand.Flags().StringVar(&options.groupBy, "group-by", "", "group the listed tasks, the only option is 'project'")
+	listTasksCommand.Flags().BoolVar(&options.tree, "tree", false, "list sub-tasks indented below their parent task")
+	listTasksCommand.Flags().IntVar(&options.depth, "depth", 0, "with --tree, the number of levels of tasks to show, deeper sub-tasks are collapsed into a count, 0 shows every level")
+	listTasksCommand.Flags().StringVar(&options.format, "format", "", "a Go template, or the name of a template in config.yml, written for each task, e.g. '{{.ID}} {{.Content}}'")
+
 	return listTasksCommand
 }
 
-func execute(d *dependencies) error {
+func execute(d *dependencies, o *options) error {
+	if o.groupBy != "" && o.groupBy != groupByProject {
+		return failures.New(failures.Validation, errorInvalidGroupBy)
+	}
+
+	if o.filter != "" && o.groupBy != "" {
+		return failures.New(failures.Validation, errorFilterWithGroupBy)
+	}
+
+	if o.depth != 0 && !o.tree {
+		return failures.New(failures.Validation, errorDepthWithoutTree)
+	}
+
+	if o.depth < 0 {
+		return failures.New(failures.Validation, errorInvalidDepth)
+	}
+
+	now := time.Now()
+	predicates, err := o.predicates(now)
+	if err != nil {
+		return err
+	}
+
+	var queries []filters.Query
+	if o.filter != "" {
+		queries, err = filters.Parse(o.filter, now)
+		if err != nil {
+			return err
+		}
+	}
+
 	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
 	if !isAuthenticated {
-		return errors.New(errorNotCurrentlyAuthenticated)
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	if o.format != "" && !output.IsText(d.outputStream) {
+		return failures.New(failures.Validation, errorFormatRequiresText)
 	}
 
-	tasks, err := d.taskService.GetAllTasks()
+	tasks, err := getTasks(d, o)
 	if err != nil {
 		return err
 	}
 
+	if queries != nil {
+		return writeQueries(d, o, tasks, queries, predicates)
+	}
+
+	tasks, err = tasks.Filter(predicates...).Limit(o.limit)
+	if err != nil {
+		return err
+	}
+
+	if o.format != "" {
+		return writeTemplate(d, o, tasks)
+	}
+
+	return output.WriteList(d.outputStream, types.RecordColumns(), tasks.AsRecords(), func() {
+		writeText(d, o, tasks)
+	})
+}
+
+func getTasks(d *dependencies, o *options) (types.TaskList, error) {
+	if o.cached {
+		return d.taskService.GetCachedTasks()
+	}
+	return d.taskService.GetAllTasks()
+}
+
+// predicates converts the filters provided as flags into predicates that every listed task must satisfy
+func (o *options) predicates(now time.Time) ([]types.TaskPredicate, error) {
+	var predicates []types.TaskPredicate
+
+	if o.project != "" {
+		predicates = append(predicates, types.InProject(o.project))
+	}
+
+	if o.due != "" {
+		predicate, err := types.ParseDueFilter(o.due, now)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	if o.priority != "" {
+		predicate, err := types.ParsePriorityFilter(o.priority)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	for _, label := range o.labels {
+		predicates = append(predicates, types.HasLabel(label))
+	}
+
+	if o.search != "" {
+		predicate, err := types.ParseSearchFilter(o.search)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	return predicates, nil
+}
+
+// isFilteredBeyondProject returns true if filters other than the project were provided
+func (o *options) isFilteredBeyondProject() bool {
+	return o.due != "" || o.priority != "" || len(o.labels) > 0 || o.search != ""
+}
+
+func writeText(d *dependencies, o *options, tasks types.TaskList) {
+	if len(tasks) == 0 && o.isFilteredBeyondProject() {
+		fmt.Fprint(d.outputStream, noMatchingTasksMessage)
+		return
+	}
+
+	if len(tasks) == 0 && o.project != "" {
+		fmt.Fprintf(d.outputStream, noTasksInProjectMessage, o.project)
+		return
+	}
+
 	if len(tasks) == 0 {
 		fmt.Fprint(d.outputStream, noTasksMessage)
 	}
 
 	writer := tabwriter.NewWriter(d.outputStream, 0, 8, 1, '\t', 0)
+	if o.groupBy == groupByProject {
+		for _, group := range tasks.GroupByProject() {
+			fmt.Fprintln(writer, color.New(color.Bold).Sprintf("#%s", group.Name))
+			writeTasks(writer, o, group.Tasks)
+		}
+	} else {
+		writeTasks(writer, o, tasks)
+	}
+	writer.Flush()
+}
+
+func writeTasks(writer io.Writer, o *options, tasks types.TaskList) {
+	if o.tree {
+		writeTrees(writer, o, tasks.Tree(), 0)
+		return
+	}
+
 	for _, task := range tasks {
 		fmt.Fprintln(writer, task.AsString())
 	}
-	writer.Flush()
+}
+
+// writeTrees writes each task followed by its sub-tasks indented one level deeper, the sub-tasks of tasks at the deepest level
+// shown are collapsed into a count
+func writeTrees(writer io.Writer, o *options, trees []types.TaskTree, depth int) {
+	for _, tree := range trees {
+		if o.depth != 0 && depth == o.depth-1 {
+			fmt.Fprintln(writer, tree.Task.AsIndentedString(depth, tree.CountSubTasks()))
+			continue
+		}
+
+		fmt.Fprintln(writer, tree.Task.AsIndentedString(depth, 0))
+		writeTrees(writer, o, tree.SubTasks, depth+1)
+	}
+}
+
+// writeTemplate writes a line for every task using the template, grouping is ignored so that every line has the same shape
+func writeTemplate(d *dependencies, o *options, tasks types.TaskList) error {
+	taskTemplate, err := output.NewTemplate(d.outputStream, o.format, types.TemplateFuncs())
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if err := taskTemplate.Execute(d.outputStream, &task); err != nil {
+			return err
+		}
+		fmt.Fprintln(d.outputStream)
+	}
 
 	return nil
 }
+
+// writeQueries lists the tasks matching each query of the filter in a section headed by the query, the other filters apply to every query
+func writeQueries(d *dependencies, o *options, tasks types.TaskList, queries []filters.Query, predicates []types.TaskPredicate) error {
+	sections := make([]types.TaskGroup, len(queries))
+	for index, query := range queries {
+		matchingTasks, err := tasks.Filter(append([]types.TaskPredicate{query.Predicate}, predicates...)...).Limit(o.limit)
+		if err != nil {
+			return err
+		}
+		sections[index] = types.TaskGroup{Name: query.Text, Tasks: matchingTasks}
+	}
+
+	if o.format != "" {
+		var allTasks types.TaskList
+		for _, section := range sections {
+			allTasks = append(allTasks, section.Tasks...)
+		}
+		return writeTemplate(d, o, allTasks)
+	}
+
+	var records []output.Record
+	for _, section := range sections {
+		for _, record := range section.Tasks.AsRecords() {
+			records = append(records, append(record, output.Field{Name: "filter", Value: section.Name}))
+		}
+	}
+	columns := append(types.RecordColumns(), "filter")
+
+	return output.WriteList(d.outputStream, columns, records, func() {
+		writer := tabwriter.NewWriter(d.outputStream, 0, 8, 1, '\t', 0)
+		for index, section := range sections {
+			if index > 0 {
+				fmt.Fprintln(writer)
+			}
+			fmt.Fprintln(writer, color.New(color.Bold).Sprint(section.Name))
+			if len(section.Tasks) == 0 {
+				fmt.Fprintln(writer, noTasksInQueryMessage)
+			}
+			writeTasks(writer, o, section.Tasks)
+		}
+		writer.Flush()
+	})
+}
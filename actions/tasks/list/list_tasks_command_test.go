@@ -2,15 +2,25 @@ package list
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/queue"
+	"github.com/kpdowns/todoist-cli/replica"
 	"github.com/kpdowns/todoist-cli/tasks/services"
 	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
 	"github.com/kpdowns/todoist-cli/todoist/requests"
 	"github.com/kpdowns/todoist-cli/todoist/responses"
 	"github.com/stretchr/testify/assert"
-
-	"github.com/kpdowns/todoist-cli/mocks"
 )
 
 func TestNotAuthenticated(t *testing.T) {
@@ -20,9 +30,9 @@ func TestNotAuthenticated(t *testing.T) {
 	mockOutputStream := &bytes.Buffer{}
 
 	listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, nil)
-	listTaskCommand.Execute()
+	err := listTaskCommand.Execute()
 
-	assert.Equal(t, errorNotCurrentlyAuthenticated, mockOutputStream.String())
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
 }
 
 func TestWrittingToOutputStream(t *testing.T) {
@@ -44,10 +54,10 @@ func TestWrittingToOutputStream(t *testing.T) {
 		mockOutputStream := &bytes.Buffer{}
 
 		mockTaskRepository := &mocks.MockTaskRepository{
-			CreateAllFunc: func(types.TaskList) (types.TaskList, error) { return nil, nil },
+			CreateAllFunc: func(types.TaskList, map[string]int64) (types.TaskList, error) { return nil, nil },
 		}
 
-		taskService := services.NewTaskService(mockAPI, mockAuthenticationService, mockTaskRepository)
+		taskService := services.NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockTaskRepository)
 
 		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, taskService)
 		listTaskCommand.Execute()
@@ -65,7 +75,7 @@ func TestWrittingToOutputStream(t *testing.T) {
 				DateString: "",
 			},
 		}
-		taskToBeWritten := itemReturned.ToTask()
+		taskToBeWritten := itemReturned.ToTask(time.Local)
 
 		mockAuthenticationService := &mocks.MockAuthenticationService{
 			AuthenticatedStateToReturn: true,
@@ -84,12 +94,12 @@ func TestWrittingToOutputStream(t *testing.T) {
 		mockOutputStream := &bytes.Buffer{}
 
 		mockTaskRepository := &mocks.MockTaskRepository{
-			CreateAllFunc: func(types.TaskList) (types.TaskList, error) {
+			CreateAllFunc: func(types.TaskList, map[string]int64) (types.TaskList, error) {
 				return types.TaskList{taskToBeWritten}, nil
 			},
 		}
 
-		taskService := services.NewTaskService(mockAPI, mockAuthenticationService, mockTaskRepository)
+		taskService := services.NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockTaskRepository)
 
 		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, taskService)
 		listTaskCommand.Execute()
@@ -99,3 +109,507 @@ func TestWrittingToOutputStream(t *testing.T) {
 	})
 
 }
+
+func TestListingOptions(t *testing.T) {
+
+	tasksToReturn := types.TaskList{
+		types.Task{ID: 1, Priority: 1, Content: "write report", ProjectID: 100, ProjectName: "Work"},
+		types.Task{ID: 2, Priority: 1, Content: "buy milk", ProjectID: 200, ProjectName: "Personal"},
+		types.Task{ID: 3, Priority: 1, Content: "review pull request", ProjectID: 100, ProjectName: "Work"},
+	}
+
+	newMockTaskService := func() *mocks.MockTaskService {
+		return &mocks.MockTaskService{
+			GetAllTasksFunctionToExecute: func() (types.TaskList, error) {
+				return tasksToReturn, nil
+			},
+		}
+	}
+
+	t.Run("When a project is provided, then only tasks in that project are written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, newMockTaskService())
+		listTaskCommand.SetArgs([]string{"--project=work"})
+		listTaskCommand.Execute()
+
+		assert.Contains(t, mockOutputStream.String(), "write report")
+		assert.Contains(t, mockOutputStream.String(), "review pull request")
+		assert.NotContains(t, mockOutputStream.String(), "buy milk")
+
+	})
+
+	t.Run("When a project is provided and it has no tasks, then message is written to output stream", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, newMockTaskService())
+		listTaskCommand.SetArgs([]string{"--project=Groceries"})
+		listTaskCommand.Execute()
+
+		assert.Equal(t, fmt.Sprintf(noTasksInProjectMessage, "Groceries"), mockOutputStream.String())
+
+	})
+
+	t.Run("When grouping by project, then each project is written once followed by its tasks", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, newMockTaskService())
+		listTaskCommand.SetArgs([]string{"--group-by=project"})
+		listTaskCommand.Execute()
+
+		lines := strings.Split(strings.TrimSpace(mockOutputStream.String()), "\n")
+		assert.Len(t, lines, 5)
+		assert.Equal(t, "#Personal", lines[0])
+		assert.Contains(t, lines[1], "buy milk")
+		assert.Equal(t, "#Work", lines[2])
+		assert.Contains(t, lines[3], "write report")
+		assert.Contains(t, lines[4], "review pull request")
+
+	})
+
+	t.Run("When grouping by anything other than project, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, newMockTaskService())
+		listTaskCommand.SetArgs([]string{"--group-by=priority"})
+		err := listTaskCommand.Execute()
+
+		assert.Equal(t, errorInvalidGroupBy, err.Error())
+
+	})
+
+}
+
+func TestFilteringTasks(t *testing.T) {
+
+	tasksToReturn := types.TaskList{
+		types.Task{ID: 1, Priority: 4, Content: "write report", ProjectName: "Work", Labels: []string{"focus"}},
+		types.Task{ID: 2, Priority: 1, Content: "buy milk", ProjectName: "Personal", Labels: []string{"errand"}},
+		types.Task{ID: 3, Priority: 3, Content: "buy a present", ProjectName: "Personal", Labels: []string{"errand"}},
+		types.Task{ID: 4, Priority: 2, Content: "review pull request", ProjectName: "Work"},
+	}
+
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	mockTaskService := &mocks.MockTaskService{
+		GetAllTasksFunctionToExecute: func() (types.TaskList, error) {
+			return tasksToReturn, nil
+		},
+		GetCachedTasksFunc: func() (types.TaskList, error) {
+			return tasksToReturn[:1], nil
+		},
+	}
+
+	listTaskIDs := func(args ...string) []string {
+		mockOutputStream := &bytes.Buffer{}
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs(append(args, "--format={{.ID}}"))
+		listTaskCommand.Execute()
+		return strings.Fields(mockOutputStream.String())
+	}
+
+	t.Run("When filters are provided, then only tasks matching every filter are written to output stream", func(t *testing.T) {
+		assert.Equal(t, []string{"1", "3"}, listTaskIDs("--priority", ">=3"))
+		assert.Equal(t, []string{"2", "3"}, listTaskIDs("--label", "errand"))
+		assert.Equal(t, []string{"3"}, listTaskIDs("--label", "errand", "--search", "present"))
+		assert.Equal(t, []string{"2", "3"}, listTaskIDs("--search", "/^buy/", "--project", "personal"))
+	})
+
+	t.Run("When a limit is provided, then at most that many tasks are written to output stream", func(t *testing.T) {
+		assert.Equal(t, []string{"1", "2"}, listTaskIDs("--limit=2"))
+		assert.Equal(t, []string{"2"}, listTaskIDs("--priority=<3", "--limit=1"))
+	})
+
+	t.Run("When listing cached tasks, then the cached tasks are listed without syncing", func(t *testing.T) {
+		assert.Equal(t, []string{"1"}, listTaskIDs("--cached"))
+	})
+
+	t.Run("When no tasks match the filters, then message is written to output stream", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--label=someday"})
+		listTaskCommand.Execute()
+
+		assert.Equal(t, noMatchingTasksMessage, mockOutputStream.String())
+
+	})
+
+	t.Run("When a filter is not valid, then a validation error is returned before tasks are retrieved", func(t *testing.T) {
+
+		listTaskCommand := NewListTasksCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{})
+		listTaskCommand.SetArgs([]string{"--due=someday"})
+		err := listTaskCommand.Execute()
+
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+}
+
+func TestFilterQueries(t *testing.T) {
+
+	tasksToReturn := types.TaskList{
+		types.Task{ID: 1, Priority: 4, Content: "write report", ProjectName: "Work", Labels: []string{"focus"}},
+		types.Task{ID: 2, Priority: 1, Content: "buy milk", ProjectName: "Personal", Labels: []string{"errand"}},
+		types.Task{ID: 3, Priority: 3, Content: "buy a present", ProjectName: "Personal", Labels: []string{"errand"}},
+		types.Task{ID: 4, Priority: 2, Content: "review pull request", ProjectName: "Work"},
+	}
+
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	mockTaskService := &mocks.MockTaskService{
+		GetAllTasksFunctionToExecute: func() (types.TaskList, error) {
+			return tasksToReturn, nil
+		},
+	}
+
+	t.Run("When a filter is provided, then only tasks matching the query are written to output stream", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--filter", "(@errand | #Work) & !p4", "--format={{.ID}}"})
+		listTaskCommand.Execute()
+
+		assert.Equal(t, []string{"1", "3", "4"}, strings.Fields(mockOutputStream.String()))
+
+	})
+
+	t.Run("When a filter has several queries, then each query is written as a section headed by the query", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--filter", "p1, @someday, #Personal"})
+		listTaskCommand.Execute()
+
+		lines := strings.Split(strings.TrimSpace(mockOutputStream.String()), "\n")
+		if assert.Len(t, lines, 9) {
+			assert.Equal(t, "p1", lines[0])
+			assert.Contains(t, lines[1], "write report")
+			assert.Equal(t, "", lines[2])
+			assert.Equal(t, "@someday", lines[3])
+			assert.Equal(t, noTasksInQueryMessage, lines[4])
+			assert.Equal(t, "", lines[5])
+			assert.Equal(t, "#Personal", lines[6])
+			assert.Contains(t, lines[7], "buy milk")
+			assert.Contains(t, lines[8], "buy a present")
+		}
+
+	})
+
+	t.Run("When a filter is combined with other filters and a limit, then they apply to every query", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--filter", "#Work, #Personal", "--search=r", "--limit=1", "--format={{.ID}}"})
+		listTaskCommand.Execute()
+
+		assert.Equal(t, []string{"1", "3"}, strings.Fields(mockOutputStream.String()))
+
+	})
+
+	t.Run("When the output is JSON, then every record includes the query it matched", func(t *testing.T) {
+
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("json")
+
+		listTaskCommand := NewListTasksCommand(outputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--filter", "p1, @errand"})
+		listTaskCommand.Execute()
+
+		var records []map[string]interface{}
+		err := json.Unmarshal(buffer.Bytes(), &records)
+		assert.Nil(t, err)
+		if assert.Len(t, records, 3) {
+			assert.Equal(t, float64(1), records[0]["id"])
+			assert.Equal(t, "p1", records[0]["filter"])
+			assert.Equal(t, float64(2), records[1]["id"])
+			assert.Equal(t, "@errand", records[1]["filter"])
+		}
+
+	})
+
+	t.Run("When the filter is not valid, then a validation error is returned before tasks are retrieved", func(t *testing.T) {
+
+		listTaskCommand := NewListTasksCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{})
+		listTaskCommand.SetArgs([]string{"--filter", "(today | p1"})
+		err := listTaskCommand.Execute()
+
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When a filter is combined with grouping, then a validation error is returned", func(t *testing.T) {
+
+		listTaskCommand := NewListTasksCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{})
+		listTaskCommand.SetArgs([]string{"--filter", "today", "--group-by=project"})
+		err := listTaskCommand.Execute()
+
+		assert.Equal(t, errorFilterWithGroupBy, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+}
+
+func TestListingSubTasksAsATree(t *testing.T) {
+
+	tasksToReturn := types.TaskList{
+		types.Task{ID: 1, TodoistID: 100, Priority: 1, Content: "plan holiday"},
+		types.Task{ID: 2, TodoistID: 500, Priority: 4, Content: "buy milk"},
+		types.Task{ID: 3, TodoistID: 300, ParentID: 100, ChildOrder: 2, Priority: 1, Content: "book hotel"},
+		types.Task{ID: 4, TodoistID: 200, ParentID: 100, ChildOrder: 1, Priority: 1, Content: "book flights"},
+		types.Task{ID: 5, TodoistID: 400, ParentID: 200, ChildOrder: 1, Priority: 1, Content: "compare prices"},
+	}
+
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	mockTaskService := &mocks.MockTaskService{
+		GetAllTasksFunctionToExecute: func() (types.TaskList, error) {
+			return tasksToReturn, nil
+		},
+	}
+
+	t.Run("When listing tasks as a tree, then sub-tasks are written indented below their parent", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--tree"})
+		listTaskCommand.Execute()
+
+		lines := strings.Split(strings.TrimSpace(mockOutputStream.String()), "\n")
+		if assert.Len(t, lines, 5) {
+			assert.Contains(t, lines[0], "\tplan holiday")
+			assert.Contains(t, lines[1], "\t  book flights")
+			assert.Contains(t, lines[2], "\t    compare prices")
+			assert.Contains(t, lines[3], "\t  book hotel")
+			assert.Contains(t, lines[4], "\tbuy milk")
+		}
+
+	})
+
+	t.Run("When listing tasks as a tree with a depth, then deeper sub-tasks are collapsed into a count", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--tree", "--depth=1"})
+		listTaskCommand.Execute()
+
+		lines := strings.Split(strings.TrimSpace(mockOutputStream.String()), "\n")
+		if assert.Len(t, lines, 2) {
+			assert.Contains(t, lines[0], "plan holiday (+3 sub-tasks)")
+			assert.Contains(t, lines[1], "buy milk")
+			assert.NotContains(t, lines[1], "sub-task")
+		}
+
+	})
+
+	t.Run("When the parent of a sub-task is filtered out, then the sub-task is written at the top level", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--tree", "--search=book"})
+		listTaskCommand.Execute()
+
+		lines := strings.Split(strings.TrimSpace(mockOutputStream.String()), "\n")
+		if assert.Len(t, lines, 2) {
+			assert.Contains(t, lines[0], "\tbook hotel")
+			assert.Contains(t, lines[1], "\tbook flights")
+		}
+
+	})
+
+	var invalidOptionsToTest = []struct {
+		description   string
+		arguments     []string
+		expectedError string
+	}{
+		{"a depth is provided without --tree", []string{"--depth=2"}, errorDepthWithoutTree},
+		{"the depth is negative", []string{"--tree", "--depth=-1"}, errorInvalidDepth},
+	}
+
+	for _, optionsToTest := range invalidOptionsToTest {
+		optionsToTest := optionsToTest
+
+		t.Run("When "+optionsToTest.description+", then a validation error is returned", func(t *testing.T) {
+
+			listTaskCommand := NewListTasksCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+			listTaskCommand.SetArgs(optionsToTest.arguments)
+			err := listTaskCommand.Execute()
+
+			assert.Equal(t, optionsToTest.expectedError, err.Error())
+			assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+		})
+	}
+
+}
+
+func TestMachineReadableOutput(t *testing.T) {
+
+	tasksToReturn := types.TaskList{
+		types.Task{ID: 1, Priority: 1, Content: "write report", ProjectID: 100, ProjectName: "Work"},
+		types.Task{ID: 2, Priority: 4, Content: "buy milk", ProjectID: 200, ProjectName: "Personal"},
+	}
+
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	mockTaskService := &mocks.MockTaskService{
+		GetAllTasksFunctionToExecute: func() (types.TaskList, error) {
+			return tasksToReturn, nil
+		},
+	}
+
+	t.Run("When the output is JSON, then the tasks are written as a JSON array of records", func(t *testing.T) {
+
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("json")
+
+		listTaskCommand := NewListTasksCommand(outputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--project=Personal"})
+		listTaskCommand.Execute()
+
+		var records []map[string]interface{}
+		err := json.Unmarshal(buffer.Bytes(), &records)
+		assert.Nil(t, err)
+		if assert.Len(t, records, 1) {
+			assert.Equal(t, float64(2), records[0]["id"])
+			assert.Equal(t, "buy milk", records[0]["content"])
+			assert.Equal(t, "Personal", records[0]["project"])
+			assert.Equal(t, float64(4), records[0]["priority"])
+		}
+
+	})
+
+	t.Run("When the output is CSV and there are no tasks, then only the header is written", func(t *testing.T) {
+
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("csv")
+
+		listTaskCommand := NewListTasksCommand(outputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--project=Groceries"})
+		listTaskCommand.Execute()
+
+		assert.Equal(t, strings.Join(types.RecordColumns(), ",")+"\n", buffer.String())
+
+	})
+
+	t.Run("When the output is JSON and an error occurs, then the error is returned and nothing is written to the output stream", func(t *testing.T) {
+
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("json")
+
+		listTaskCommand := NewListTasksCommand(outputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--group-by=priority"})
+		err := listTaskCommand.Execute()
+
+		assert.Equal(t, errorInvalidGroupBy, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+		assert.Empty(t, buffer.String())
+
+	})
+
+}
+
+func TestTemplatedOutput(t *testing.T) {
+
+	tasksToReturn := types.TaskList{
+		types.Task{ID: 1, Priority: 1, Content: "write report", ProjectID: 100, ProjectName: "Work"},
+		types.Task{ID: 2, Priority: 4, Content: "buy milk for the week", ProjectID: 200, ProjectName: "Personal"},
+	}
+
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	mockTaskService := &mocks.MockTaskService{
+		GetAllTasksFunctionToExecute: func() (types.TaskList, error) {
+			return tasksToReturn, nil
+		},
+	}
+
+	t.Run("When a format is provided, then a line is written for every task using the template", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+
+		listTaskCommand := NewListTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--format", "{{.ID}} {{priority .Priority}} {{truncate 10 .Content}}"})
+		listTaskCommand.Execute()
+
+		assert.Equal(t, "1 Low write rep…\n2 Very Urgent buy milk …\n", mockOutputStream.String())
+
+	})
+
+	t.Run("When the format is the name of a configured template, then the configured template is used", func(t *testing.T) {
+
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetTemplates(map[string]string{"prompt": "#{{.ProjectName}}"})
+
+		listTaskCommand := NewListTasksCommand(outputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--format=prompt", "--project=Work"})
+		listTaskCommand.Execute()
+
+		assert.Equal(t, "#Work\n", buffer.String())
+
+	})
+
+	t.Run("When a format is provided with structured output, then an error is returned", func(t *testing.T) {
+
+		outputStream := output.NewWriter(&bytes.Buffer{})
+		outputStream.SetFormat("csv")
+
+		listTaskCommand := NewListTasksCommand(outputStream, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--format={{.ID}}"})
+		err := listTaskCommand.Execute()
+
+		assert.Equal(t, errorFormatRequiresText, err.Error())
+
+	})
+
+	t.Run("When the format is not a valid template, then a validation error is returned", func(t *testing.T) {
+
+		listTaskCommand := NewListTasksCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		listTaskCommand.SetArgs([]string{"--format={{.ID"})
+		err := listTaskCommand.Execute()
+
+		assert.Contains(t, err.Error(), "Error, the format is not a valid template")
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+}
+
+func newReplicaService(api todoist.API, authenticationService authentication.Service) replica.Service {
+	return replica.NewReplicaService(api, authenticationService, replica.NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+}
@@ -0,0 +1,108 @@
+package move
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successTaskMoved = "The task has successfully been moved"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNoDestination             = "Error, one of --to-project, --to-section or --to-parent must be provided"
+	errorFailedToMoveTask          = "An error occurred while moving the task"
+)
+
+type dependencies struct {
+	inputStream           io.Reader
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+}
+
+type destination struct {
+	project         string
+	section         string
+	parentReference string
+}
+
+// NewMoveTaskCommand creates an instance of the command that moves a task to another project, section or parent task
+func NewMoveTaskCommand(o io.Writer, a authentication.Service, t services.TaskService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+	}
+
+	taskReference := ""
+	destination := destination{}
+
+	var moveTaskCommand = &cobra.Command{
+		Use:   "move",
+		Short: "Move task",
+		Long: "Move a task along with its sub-tasks to another project, section or parent task. " +
+			"The task is referenced by its id, its Todoist id, e.g. t:123456, or part of its content.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			if taskReference == "" && len(args) == 1 {
+				taskReference = args[0]
+			}
+			return execute(dependencies, taskReference, destination)
+		},
+	}
+
+	moveTaskCommand.Flags().StringVarP(&taskReference, "id", "i", "", "the task to move, as its id, its Todoist id, e.g. t:123456, or part of its content")
+	moveTaskCommand.Flags().StringVar(&destination.project, "to-project", "", "the name or Todoist id of the project to move the task to")
+	moveTaskCommand.Flags().StringVar(&destination.section, "to-section", "", "the name or Todoist id of the section to move the task to, within --to-project when it is provided")
+	moveTaskCommand.Flags().StringVar(&destination.parentReference, "to-parent", "", "the task to move the task below, as its id, its Todoist id or part of its content")
+
+	return moveTaskCommand
+}
+
+func execute(d *dependencies, taskReference string, destination destination) error {
+	if destination.project == "" && destination.section == "" && destination.parentReference == "" {
+		return failures.New(failures.Validation, errorNoDestination)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	taskID, err := resolve.TaskID(d.inputStream, d.outputStream, d.taskService, taskReference)
+	if err != nil {
+		return err
+	}
+
+	options := types.MoveTaskOptions{
+		Project: destination.project,
+		Section: destination.section,
+	}
+	if destination.parentReference != "" {
+		options.ParentID, err = resolve.TaskID(d.inputStream, d.outputStream, d.taskService, destination.parentReference)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = d.taskService.MoveTask(taskID, options)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.Validation) || failures.Is(err, failures.NotFound) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToMoveTask)
+	}
+
+	output.WriteMessage(d.outputStream, successTaskMoved)
+	return warning
+}
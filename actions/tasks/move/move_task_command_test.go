@@ -0,0 +1,117 @@
+package move
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+
+	moveTaskCommand := NewMoveTaskCommand(&bytes.Buffer{}, mockAuthenticationService, nil)
+	moveTaskCommand.SetArgs([]string{"1", "--to-project=Work"})
+	err := moveTaskCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestMovingATask(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	t.Run("When no destination is provided, then a validation error is returned", func(t *testing.T) {
+
+		moveTaskCommand := NewMoveTaskCommand(&bytes.Buffer{}, authenticated, &mocks.MockTaskService{})
+		moveTaskCommand.SetArgs([]string{"1"})
+		err := moveTaskCommand.Execute()
+
+		assert.Equal(t, errorNoDestination, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	var destinationsToTest = []struct {
+		description     string
+		arguments       []string
+		expectedOptions types.MoveTaskOptions
+	}{
+		{"a project", []string{"1", "--to-project=Work"}, types.MoveTaskOptions{Project: "Work"}},
+		{"a section of a project", []string{"-i=1", "--to-project=Work", "--to-section=Meetings"}, types.MoveTaskOptions{Project: "Work", Section: "Meetings"}},
+		{"a parent task referenced by its content", []string{"1", "--to-parent=report"}, types.MoveTaskOptions{ParentID: 7}},
+	}
+
+	for _, destinationToTest := range destinationsToTest {
+		destinationToTest := destinationToTest
+
+		t.Run("When moving a task to "+destinationToTest.description+", then the destination is provided to the task service", func(t *testing.T) {
+
+			var requestedTaskID uint32
+			var requestedOptions types.MoveTaskOptions
+			mockOutputStream := &bytes.Buffer{}
+			mockTaskService := &mocks.MockTaskService{
+				ResolveTaskFunc: func(reference types.TaskReference) (*types.Task, error) {
+					return &types.Task{ID: 7, Content: "Write report"}, nil
+				},
+				MoveTaskFunc: func(taskID uint32, options types.MoveTaskOptions) error {
+					requestedTaskID = taskID
+					requestedOptions = options
+					return nil
+				},
+			}
+
+			moveTaskCommand := NewMoveTaskCommand(mockOutputStream, authenticated, mockTaskService)
+			moveTaskCommand.SetArgs(destinationToTest.arguments)
+			err := moveTaskCommand.Execute()
+
+			assert.Nil(t, err)
+			assert.Equal(t, uint32(1), requestedTaskID)
+			assert.Equal(t, destinationToTest.expectedOptions, requestedOptions)
+			assert.Equal(t, successTaskMoved, mockOutputStream.String())
+
+		})
+	}
+
+	t.Run("When the task service rejects the destination, then its validation error is returned", func(t *testing.T) {
+
+		expectedError := failures.New(failures.Validation, "A task is moved either below a parent task or to a project and section, not both.")
+		mockTaskService := &mocks.MockTaskService{
+			MoveTaskFunc: func(uint32, types.MoveTaskOptions) error {
+				return expectedError
+			},
+		}
+
+		moveTaskCommand := NewMoveTaskCommand(&bytes.Buffer{}, authenticated, mockTaskService)
+		moveTaskCommand.SetArgs([]string{"1", "--to-project=Work", "--to-parent=2"})
+		err := moveTaskCommand.Execute()
+
+		assert.Equal(t, expectedError, err)
+
+	})
+
+	t.Run("When moving a task and an error occurs, then an error is returned", func(t *testing.T) {
+
+		mockTaskService := &mocks.MockTaskService{
+			MoveTaskFunc: func(uint32, types.MoveTaskOptions) error {
+				return errors.New("test error")
+			},
+		}
+
+		moveTaskCommand := NewMoveTaskCommand(&bytes.Buffer{}, authenticated, mockTaskService)
+		moveTaskCommand.SetArgs([]string{"1", "--to-project=Work"})
+		err := moveTaskCommand.Execute()
+
+		assert.Equal(t, errorFailedToMoveTask, err.Error())
+
+	})
+
+}
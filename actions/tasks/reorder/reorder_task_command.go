@@ -0,0 +1,85 @@
+package reorder
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successTasksReordered = "The tasks have successfully been reordered"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorFailedToReorderTasks      = "An error occurred while reordering the tasks"
+)
+
+type dependencies struct {
+	inputStream           io.Reader
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+}
+
+// NewReorderTaskCommand creates an instance of the command that changes the order of tasks among their siblings or in the Today view
+func NewReorderTaskCommand(o io.Writer, a authentication.Service, t services.TaskService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+	}
+
+	taskIDs := ""
+	options := types.ReorderTaskOptions{}
+
+	var reorderTaskCommand = &cobra.Command{
+		Use:   "reorder",
+		Short: "Reorder tasks",
+		Long: "Put tasks in the order they are provided in, e.g. 'tasks reorder 3 1 2'. The tasks take the positions they held between them, " +
+			"so other tasks keep their place. Tasks are reordered among the sub-tasks of the same parent in the same project and section, " +
+			"or in the Today and Next 7 days views with --today.",
+		Args: cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			if taskIDs != "" {
+				args = append([]string{taskIDs}, args...)
+			}
+			return execute(dependencies, args, options)
+		},
+	}
+
+	reorderTaskCommand.Flags().StringVarP(&taskIDs, "id", "i", "", "the tasks to reorder in their new order, as ids, ranges of ids or Todoist ids, e.g. 3,1,2 or t:123456")
+	reorderTaskCommand.Flags().BoolVar(&options.DayOrder, "today", false, "reorder the tasks in the Today and Next 7 days views instead of among their siblings")
+
+	return reorderTaskCommand
+}
+
+func execute(d *dependencies, values []string, options types.ReorderTaskOptions) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	taskIDs, err := resolve.TaskIDs(d.inputStream, d.outputStream, d.taskService, values)
+	if err != nil {
+		return err
+	}
+
+	err = d.taskService.ReorderTasks(taskIDs, options)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.Validation) || failures.Is(err, failures.NotFound) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToReorderTasks)
+	}
+
+	output.WriteMessage(d.outputStream, successTasksReordered)
+	return warning
+}
@@ -0,0 +1,107 @@
+package reorder
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+
+	reorderTaskCommand := NewReorderTaskCommand(&bytes.Buffer{}, mockAuthenticationService, nil)
+	reorderTaskCommand.SetArgs([]string{"2", "1"})
+	err := reorderTaskCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestReorderingTasks(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	t.Run("When reordering tasks, then the tasks are provided to the task service in the requested order", func(t *testing.T) {
+
+		var requestedTaskIDs []uint32
+		var requestedOptions types.ReorderTaskOptions
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			ReorderTasksFunc: func(taskIDs []uint32, options types.ReorderTaskOptions) error {
+				requestedTaskIDs = taskIDs
+				requestedOptions = options
+				return nil
+			},
+		}
+
+		reorderTaskCommand := NewReorderTaskCommand(mockOutputStream, authenticated, mockTaskService)
+		reorderTaskCommand.SetArgs([]string{"3", "1", "2"})
+		err := reorderTaskCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{3, 1, 2}, requestedTaskIDs)
+		assert.Equal(t, types.ReorderTaskOptions{}, requestedOptions)
+		assert.Equal(t, successTasksReordered, mockOutputStream.String())
+
+	})
+
+	t.Run("When reordering tasks for today, then the day order is requested", func(t *testing.T) {
+
+		var requestedOptions types.ReorderTaskOptions
+		mockTaskService := &mocks.MockTaskService{
+			ReorderTasksFunc: func(taskIDs []uint32, options types.ReorderTaskOptions) error {
+				requestedOptions = options
+				return nil
+			},
+		}
+
+		reorderTaskCommand := NewReorderTaskCommand(&bytes.Buffer{}, authenticated, mockTaskService)
+		reorderTaskCommand.SetArgs([]string{"--id", "2,1", "--today"})
+		reorderTaskCommand.Execute()
+
+		assert.Equal(t, types.ReorderTaskOptions{DayOrder: true}, requestedOptions)
+
+	})
+
+	t.Run("When the tasks are not siblings, then the validation error of the task service is returned", func(t *testing.T) {
+
+		expectedError := failures.New(failures.Validation, "The tasks 1 and 4 do not have the same parent task in the same project")
+		mockTaskService := &mocks.MockTaskService{
+			ReorderTasksFunc: func([]uint32, types.ReorderTaskOptions) error {
+				return expectedError
+			},
+		}
+
+		reorderTaskCommand := NewReorderTaskCommand(&bytes.Buffer{}, authenticated, mockTaskService)
+		reorderTaskCommand.SetArgs([]string{"1", "4"})
+		err := reorderTaskCommand.Execute()
+
+		assert.Equal(t, expectedError, err)
+
+	})
+
+	t.Run("When reordering tasks and an error occurs, then an error is returned", func(t *testing.T) {
+
+		mockTaskService := &mocks.MockTaskService{
+			ReorderTasksFunc: func([]uint32, types.ReorderTaskOptions) error {
+				return errors.New("test error")
+			},
+		}
+
+		reorderTaskCommand := NewReorderTaskCommand(&bytes.Buffer{}, authenticated, mockTaskService)
+		reorderTaskCommand.SetArgs([]string{"2", "1"})
+		err := reorderTaskCommand.Execute()
+
+		assert.Equal(t, errorFailedToReorderTasks, err.Error())
+
+	})
+
+}
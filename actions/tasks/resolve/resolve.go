@@ -0,0 +1,90 @@
+// Package resolve finds the tasks referenced on the command line by the task commands
+package resolve
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/prompt"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+)
+
+const (
+	questionWhichTask     = "'%s' matches several tasks, which task did you mean?"
+	errorSeveralTasks     = "'%s' references several tasks, only one task can be referenced"
+	errorNoTaskReferenced = "At least one task must be referenced by its id, its Todoist id (t:123456) or part of its content"
+)
+
+// TaskIDs returns the ids of the tasks referenced by the values without duplicates. Each value is a comma separated list of task ids,
+// ranges of task ids and Todoist ids prefixed with t:, or part of the content of a task. When content matches several tasks, the
+// person running the command is asked which task they meant, when nobody can answer an error listing the matching tasks is returned.
+func TaskIDs(in io.Reader, out io.Writer, taskService services.TaskService, values []string) ([]uint32, error) {
+	var taskIDs []uint32
+	seen := make(map[uint32]bool)
+
+	for _, value := range values {
+		references, err := types.ParseTaskReferences(value)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reference := range references {
+			task, err := resolveTask(in, out, taskService, reference)
+			if err != nil {
+				return nil, err
+			}
+
+			if !seen[task.ID] {
+				seen[task.ID] = true
+				taskIDs = append(taskIDs, task.ID)
+			}
+		}
+	}
+
+	if len(taskIDs) == 0 {
+		return nil, failures.New(failures.Validation, errorNoTaskReferenced)
+	}
+
+	return taskIDs, nil
+}
+
+// TaskID returns the id of the single task referenced by the value, see TaskIDs
+func TaskID(in io.Reader, out io.Writer, taskService services.TaskService, value string) (uint32, error) {
+	taskIDs, err := TaskIDs(in, out, taskService, []string{value})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(taskIDs) > 1 {
+		return 0, failures.Newf(failures.Validation, errorSeveralTasks, value)
+	}
+
+	return taskIDs[0], nil
+}
+
+// resolveTask finds the referenced task, tasks referenced by their id are looked up by the task service when the command is executed
+func resolveTask(in io.Reader, out io.Writer, taskService services.TaskService, reference types.TaskReference) (*types.Task, error) {
+	if reference.ID != 0 {
+		return &types.Task{ID: reference.ID}, nil
+	}
+
+	task, err := taskService.ResolveTask(reference)
+	if !types.IsAmbiguousTask(err) || !prompt.IsInteractive(in, out) {
+		return task, err
+	}
+
+	matches := err.(*types.AmbiguousTaskError).Matches
+	options := make([]string, len(matches))
+	for index, match := range matches {
+		options[index] = fmt.Sprintf("[%d] %s", match.ID, match.Content)
+	}
+
+	choice, err := prompt.Choose(in, out, fmt.Sprintf(questionWhichTask, reference), options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &matches[choice], nil
+}
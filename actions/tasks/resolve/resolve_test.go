@@ -0,0 +1,125 @@
+package resolve
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolvingTaskReferences(t *testing.T) {
+
+	listedTasks := types.TaskList{
+		{ID: 1, TodoistID: 100, Content: "Buy milk"},
+		{ID: 2, TodoistID: 200, Content: "Buy oat milk"},
+		{ID: 3, TodoistID: 300, Content: "Write report"},
+	}
+
+	mockTaskService := &mocks.MockTaskService{
+		ResolveTaskFunc: func(reference types.TaskReference) (*types.Task, error) {
+			var matches types.TaskList
+			if reference.TodoistID != 0 {
+				matches = listedTasks.Filter(func(task *types.Task) bool { return task.TodoistID == reference.TodoistID })
+			} else {
+				matches = listedTasks.MatchContent(reference.Content)
+			}
+
+			if len(matches) > 1 {
+				return nil, &types.AmbiguousTaskError{Reference: reference.Content, Matches: matches}
+			}
+			if len(matches) == 0 {
+				return nil, failures.New(failures.NotFound, "test error")
+			}
+			return &matches[0], nil
+		},
+	}
+
+	t.Run("When tasks are referenced by id, Todoist id and content, then the ids of the tasks are returned without duplicates", func(t *testing.T) {
+
+		taskIDs, err := TaskIDs(strings.NewReader(""), &bytes.Buffer{}, mockTaskService, []string{"3,t:100", "report", "oat"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{3, 1, 2}, taskIDs)
+
+	})
+
+	t.Run("When tasks are referenced by id, then the ids are used without looking up the tasks", func(t *testing.T) {
+
+		taskIDs, err := TaskIDs(strings.NewReader(""), &bytes.Buffer{}, &mocks.MockTaskService{}, []string{"1-3"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{1, 2, 3}, taskIDs)
+
+	})
+
+	t.Run("When content matches several tasks, then the person running the command is asked which task they meant", func(t *testing.T) {
+
+		outputStream := &bytes.Buffer{}
+
+		taskID, err := TaskID(strings.NewReader("2\n"), outputStream, mockTaskService, "buy")
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), taskID)
+		assert.Contains(t, outputStream.String(), fmt.Sprintf(questionWhichTask, "buy"))
+		assert.Contains(t, outputStream.String(), "1) [1] Buy milk\n2) [2] Buy oat milk\n")
+
+	})
+
+	t.Run("When content matches several tasks and nobody can answer, then an error listing the matching tasks is returned", func(t *testing.T) {
+
+		buffer := &bytes.Buffer{}
+		outputStream := output.NewWriter(buffer)
+		outputStream.SetFormat("json")
+
+		_, err := TaskID(strings.NewReader("2\n"), outputStream, mockTaskService, "buy")
+
+		assert.True(t, types.IsAmbiguousTask(err))
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+		assert.Empty(t, buffer.String())
+
+	})
+
+	t.Run("When a task cannot be found, then the error of the task service is returned", func(t *testing.T) {
+
+		_, err := TaskIDs(strings.NewReader(""), &bytes.Buffer{}, mockTaskService, []string{"holiday"})
+
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	var invalidReferencesToTest = []struct {
+		description   string
+		values        []string
+		expectedError string
+	}{
+		{"no task is referenced", nil, errorNoTaskReferenced},
+		{"several tasks are referenced where one task is expected", []string{"1,2"}, fmt.Sprintf(errorSeveralTasks, "1,2")},
+	}
+
+	for _, referencesToTest := range invalidReferencesToTest {
+		referencesToTest := referencesToTest
+
+		t.Run("When "+referencesToTest.description+", then a validation error is returned", func(t *testing.T) {
+
+			var err error
+			if len(referencesToTest.values) == 1 {
+				_, err = TaskID(strings.NewReader(""), &bytes.Buffer{}, mockTaskService, referencesToTest.values[0])
+			} else {
+				_, err = TaskIDs(strings.NewReader(""), &bytes.Buffer{}, mockTaskService, referencesToTest.values)
+			}
+
+			if assert.NotNil(t, err) {
+				assert.Equal(t, referencesToTest.expectedError, err.Error())
+				assert.Equal(t, failures.Validation, failures.KindOf(err))
+			}
+
+		})
+	}
+
+}
@@ -4,15 +4,23 @@ import (
 	"io"
 
 	"github.com/kpdowns/todoist-cli/actions/tasks/add"
+	"github.com/kpdowns/todoist-cli/actions/tasks/comment"
+	"github.com/kpdowns/todoist-cli/actions/tasks/comments"
 	"github.com/kpdowns/todoist-cli/actions/tasks/complete"
+	"github.com/kpdowns/todoist-cli/actions/tasks/delete"
 	"github.com/kpdowns/todoist-cli/actions/tasks/list"
+	"github.com/kpdowns/todoist-cli/actions/tasks/move"
+	"github.com/kpdowns/todoist-cli/actions/tasks/reorder"
+	"github.com/kpdowns/todoist-cli/actions/tasks/uncomplete"
+	"github.com/kpdowns/todoist-cli/actions/tasks/update"
 	"github.com/kpdowns/todoist-cli/authentication"
+	noteServices "github.com/kpdowns/todoist-cli/notes/services"
 	"github.com/kpdowns/todoist-cli/tasks/services"
 	"github.com/spf13/cobra"
 )
 
 // NewTasksCommand creates a new instance of the authentication command
-func NewTasksCommand(o io.Writer, authenticationService authentication.Service, taskService services.TaskService) *cobra.Command {
+func NewTasksCommand(o io.Writer, authenticationService authentication.Service, taskService services.TaskService, noteService noteServices.NoteService) *cobra.Command {
 	var tasksCommand = &cobra.Command{
 		Use:   "tasks",
 		Short: "Manage tasks",
@@ -22,6 +30,13 @@ func NewTasksCommand(o io.Writer, authenticationService authentication.Service,
 	tasksCommand.AddCommand(list.NewListTasksCommand(o, authenticationService, taskService))
 	tasksCommand.AddCommand(add.NewAddTaskCommand(o, authenticationService, taskService))
 	tasksCommand.AddCommand(complete.NewCompleteTaskCommand(o, authenticationService, taskService))
+	tasksCommand.AddCommand(uncomplete.NewUncompleteTaskCommand(o, authenticationService, taskService))
+	tasksCommand.AddCommand(update.NewUpdateTaskCommand(o, authenticationService, taskService))
+	tasksCommand.AddCommand(delete.NewDeleteTaskCommand(o, authenticationService, taskService))
+	tasksCommand.AddCommand(move.NewMoveTaskCommand(o, authenticationService, taskService))
+	tasksCommand.AddCommand(reorder.NewReorderTaskCommand(o, authenticationService, taskService))
+	tasksCommand.AddCommand(comments.NewListCommentsCommand(o, authenticationService, taskService, noteService))
+	tasksCommand.AddCommand(comment.NewAddCommentCommand(o, authenticationService, taskService, noteService))
 
 	return tasksCommand
 }
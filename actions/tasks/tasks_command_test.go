@@ -16,7 +16,7 @@ func TestCommandCreation(t *testing.T) {
 		mockAuthenticationService := &mocks.MockAuthenticationService{}
 		mockTaskService := &mocks.MockTaskService{}
 
-		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
 
 		registeredCommands := taskCommand.Commands()
 
@@ -38,7 +38,7 @@ func TestCommandCreation(t *testing.T) {
 		mockAuthenticationService := &mocks.MockAuthenticationService{}
 		mockTaskService := &mocks.MockTaskService{}
 
-		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
 
 		registeredCommands := taskCommand.Commands()
 
@@ -60,7 +60,7 @@ func TestCommandCreation(t *testing.T) {
 		mockAuthenticationService := &mocks.MockAuthenticationService{}
 		mockTaskService := &mocks.MockTaskService{}
 
-		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
 
 		registeredCommands := taskCommand.Commands()
 
@@ -76,4 +76,158 @@ func TestCommandCreation(t *testing.T) {
 
 	})
 
+	t.Run("Sub command to update task is added", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{}
+		mockTaskService := &mocks.MockTaskService{}
+
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
+
+		registeredCommands := taskCommand.Commands()
+
+		found := false
+		for _, registeredCommand := range registeredCommands {
+			if registeredCommand.Use == "update" {
+				found = true
+				break
+			}
+		}
+
+		assert.True(t, found)
+
+	})
+
+	t.Run("Sub command to uncomplete tasks is added", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{}
+		mockTaskService := &mocks.MockTaskService{}
+
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
+
+		registeredCommands := taskCommand.Commands()
+
+		found := false
+		for _, registeredCommand := range registeredCommands {
+			if registeredCommand.Use == "uncomplete" {
+				found = true
+				break
+			}
+		}
+
+		assert.True(t, found)
+
+	})
+
+	t.Run("Sub command to delete tasks is added", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{}
+		mockTaskService := &mocks.MockTaskService{}
+
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
+
+		registeredCommands := taskCommand.Commands()
+
+		found := false
+		for _, registeredCommand := range registeredCommands {
+			if registeredCommand.Use == "delete" {
+				found = true
+				break
+			}
+		}
+
+		assert.True(t, found)
+
+	})
+
+	t.Run("Sub command to move a task is added", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{}
+		mockTaskService := &mocks.MockTaskService{}
+
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
+
+		registeredCommands := taskCommand.Commands()
+
+		found := false
+		for _, registeredCommand := range registeredCommands {
+			if registeredCommand.Use == "move" {
+				found = true
+				break
+			}
+		}
+
+		assert.True(t, found)
+
+	})
+
+	t.Run("Sub command to reorder tasks is added", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{}
+		mockTaskService := &mocks.MockTaskService{}
+
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
+
+		registeredCommands := taskCommand.Commands()
+
+		found := false
+		for _, registeredCommand := range registeredCommands {
+			if registeredCommand.Use == "reorder" {
+				found = true
+				break
+			}
+		}
+
+		assert.True(t, found)
+
+	})
+
+	t.Run("Sub command to list comments on a task is added", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{}
+		mockTaskService := &mocks.MockTaskService{}
+
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
+
+		registeredCommands := taskCommand.Commands()
+
+		found := false
+		for _, registeredCommand := range registeredCommands {
+			if registeredCommand.Use == "comments" {
+				found = true
+				break
+			}
+		}
+
+		assert.True(t, found)
+
+	})
+
+	t.Run("Sub command to comment on a task is added", func(t *testing.T) {
+
+		mockOutputStream := &bytes.Buffer{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{}
+		mockTaskService := &mocks.MockTaskService{}
+
+		taskCommand := NewTasksCommand(mockOutputStream, mockAuthenticationService, mockTaskService, &mocks.MockNoteService{})
+
+		registeredCommands := taskCommand.Commands()
+
+		found := false
+		for _, registeredCommand := range registeredCommands {
+			if registeredCommand.Use == "comment" {
+				found = true
+				break
+			}
+		}
+
+		assert.True(t, found)
+
+	})
+
 }
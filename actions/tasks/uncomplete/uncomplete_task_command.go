@@ -0,0 +1,89 @@
+package uncomplete
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorFailedToUncompleteTask      = "An error occurred while uncompleting the task"
+	errorNotCurrentlyAuthenticated   = "Error, you are not currently logged in"
+	successTaskFlaggedAsUncompleted  = "The task has successfully been uncompleted"
+	successTasksFlaggedAsUncompleted = "%d tasks have successfully been uncompleted"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+}
+
+// NewUncompleteTaskCommand creates an instance of the command that flags completed tasks as uncompleted
+func NewUncompleteTaskCommand(o io.Writer, a authentication.Service, t services.TaskService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+	}
+
+	taskIDs := ""
+
+	var uncompleteTaskCommand = &cobra.Command{
+		Use:   "uncomplete",
+		Short: "Uncomplete task",
+		Long: "Flag completed tasks as uncompleted given a comma separated list of the ids the tasks had when they were last listed, ranges of ids " +
+			"and Todoist ids, e.g. 1,3,7, 2-5 or t:123456.",
+		Args: cobra.OnlyValidArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			if taskIDs != "" {
+				args = append([]string{taskIDs}, args...)
+			}
+			return execute(dependencies, args)
+		},
+	}
+
+	uncompleteTaskCommand.Flags().StringVarP(&taskIDs, "id", "i", "", "the tasks to flag as uncompleted, as ids, ranges of ids or Todoist ids, e.g. 1,3,7, 2-5 or t:123456")
+
+	return uncompleteTaskCommand
+}
+
+func execute(d *dependencies, values []string) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	var references []types.TaskReference
+	for _, value := range values {
+		parsedReferences, err := types.ParseTaskReferences(value)
+		if err != nil {
+			return err
+		}
+		references = append(references, parsedReferences...)
+	}
+
+	err := d.taskService.UncompleteTasks(references)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.Validation) || failures.Is(err, failures.NotFound) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUncompleteTask)
+	}
+
+	if len(references) == 1 {
+		output.WriteMessage(d.outputStream, successTaskFlaggedAsUncompleted)
+		return warning
+	}
+
+	output.WriteMessage(d.outputStream, fmt.Sprintf(successTasksFlaggedAsUncompleted, len(references)))
+	return warning
+}
@@ -0,0 +1,96 @@
+package uncomplete
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUncompletingTasks(t *testing.T) {
+
+	t.Run("When not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		uncompleteTaskCommand := NewUncompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, nil)
+		uncompleteTaskCommand.SetArgs([]string{"1"})
+		err := uncompleteTaskCommand.Execute()
+
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When authenticated, then the referenced tasks are provided to the task service and a message is written to output stream", func(t *testing.T) {
+
+		var providedReferences []types.TaskReference
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			UncompleteTasksFunc: func(references []types.TaskReference) error {
+				providedReferences = references
+				return nil
+			},
+		}
+
+		uncompleteTaskCommand := NewUncompleteTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		uncompleteTaskCommand.SetArgs([]string{"--id", "1,2", "t:300"})
+		uncompleteTaskCommand.Execute()
+
+		assert.Equal(t, []types.TaskReference{{ID: 1}, {ID: 2}, {TodoistID: 300}}, providedReferences)
+		assert.Equal(t, "3 tasks have successfully been uncompleted", mockOutputStream.String())
+
+	})
+
+	t.Run("When authenticated and the task service rejects the reference, then its error is returned", func(t *testing.T) {
+
+		expectedError := failures.New(failures.Validation, "Completed tasks cannot be found by their content")
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockTaskService := &mocks.MockTaskService{
+			UncompleteTasksFunc: func(references []types.TaskReference) error {
+				return expectedError
+			},
+		}
+
+		uncompleteTaskCommand := NewUncompleteTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		uncompleteTaskCommand.SetArgs([]string{"buy milk"})
+		err := uncompleteTaskCommand.Execute()
+
+		assert.Equal(t, expectedError, err)
+
+	})
+
+	t.Run("When authenticated and an error occurs, then an error stating that the task was not uncompleted is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			UncompleteTasksFunc: func(references []types.TaskReference) error {
+				return errors.New("test error")
+			},
+		}
+
+		uncompleteTaskCommand := NewUncompleteTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		uncompleteTaskCommand.SetArgs([]string{"1"})
+		err := uncompleteTaskCommand.Execute()
+
+		assert.Equal(t, errorFailedToUncompleteTask, err.Error())
+		assert.Empty(t, mockOutputStream.String())
+
+	})
+
+}
@@ -0,0 +1,130 @@
+package update
+
+import (
+	"io"
+
+	"github.com/kpdowns/todoist-cli/actions/tasks/resolve"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/tasks/services"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	successTaskUpdated = "The task has successfully been updated"
+
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorNothingToUpdate           = "Error, at least one of content, due, deadline, priority, description or labels must be provided"
+	errorContentNotProvided        = "Error, the content of a task cannot be empty"
+	errorInvalidPriority           = "Error, the provided priority is not valid"
+	errorFailedToUpdateTask        = "An error occurred while updating the task"
+)
+
+type dependencies struct {
+	inputStream           io.Reader
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	taskService           services.TaskService
+}
+
+// NewUpdateTaskCommand creates an instance of the command that updates the content, due date, deadline, priority, description or labels of a task
+func NewUpdateTaskCommand(o io.Writer, a authentication.Service, t services.TaskService) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		taskService:           t,
+	}
+
+	taskReference := ""
+	content := ""
+	due := ""
+	deadline := ""
+	priority := 0
+	description := ""
+	var labels []string
+
+	var updateTaskCommand = &cobra.Command{
+		Use:   "update",
+		Short: "Update task",
+		Long: "Change the content, due date, deadline, priority, description or labels of a task, only the provided values are changed. " +
+			"The task is referenced by its id, its Todoist id, e.g. t:123456, or part of its content.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			dependencies.inputStream = command.InOrStdin()
+			if taskReference == "" && len(args) == 1 {
+				taskReference = args[0]
+			}
+
+			changes := types.TaskChanges{}
+			if command.Flags().Changed("content") {
+				changes.Content = &content
+			}
+			if command.Flags().Changed("due") {
+				changes.Due = &due
+			}
+			if command.Flags().Changed("deadline") {
+				changes.Deadline = &deadline
+			}
+			if command.Flags().Changed("priority") {
+				changes.Priority = &priority
+			}
+			if command.Flags().Changed("description") {
+				changes.Description = &description
+			}
+			if command.Flags().Changed("label") {
+				changes.Labels = &labels
+			}
+
+			return execute(dependencies, taskReference, changes)
+		},
+	}
+
+	updateTaskCommand.Flags().StringVarP(&taskReference, "id", "i", "", "the task to update, as its id, its Todoist id, e.g. t:123456, or part of its content")
+	updateTaskCommand.Flags().StringVarP(&content, "content", "c", "", "the new content of the task")
+	updateTaskCommand.Flags().StringVarP(&due, "due", "d", "", "the new due date of the task, either in plain-text 'tomorrow 5pm', 'every day', etc, or as YYYY-MM-DD or YYYY-MM-DDTHH:MM, empty to remove the due date")
+	updateTaskCommand.Flags().StringVar(&deadline, "deadline", "", "the new date the task has to be done by as YYYY-MM-DD, empty to remove the deadline")
+	updateTaskCommand.Flags().IntVarP(&priority, "priority", "p", 0, "the new priority of the task, options are 1 - 4 with 4 being the highest")
+	updateTaskCommand.Flags().StringVar(&description, "description", "", "the new description of the task")
+	updateTaskCommand.Flags().StringArrayVar(&labels, "label", nil, "the name of a label to attach to the task, can be repeated and replaces the current labels, --label '' removes all labels")
+
+	return updateTaskCommand
+}
+
+func execute(d *dependencies, taskReference string, changes types.TaskChanges) error {
+	if !changes.HasChanges() {
+		return failures.New(failures.Validation, errorNothingToUpdate)
+	}
+
+	if changes.Content != nil && *changes.Content == "" {
+		return failures.New(failures.Validation, errorContentNotProvided)
+	}
+
+	if changes.Priority != nil && !((*changes.Priority <= 4) && (*changes.Priority >= 1)) {
+		return failures.New(failures.Validation, errorInvalidPriority)
+	}
+
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	taskID, err := resolve.TaskID(d.inputStream, d.outputStream, d.taskService, taskReference)
+	if err != nil {
+		return err
+	}
+
+	err = d.taskService.UpdateTask(taskID, changes)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.NotFound) || failures.Is(err, failures.Validation) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUpdateTask)
+	}
+
+	output.WriteMessage(d.outputStream, successTaskUpdated)
+	return warning
+}
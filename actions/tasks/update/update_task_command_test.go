@@ -0,0 +1,226 @@
+package update
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+	mockOutputStream := &bytes.Buffer{}
+
+	updateTaskCommand := NewUpdateTaskCommand(mockOutputStream, mockAuthenticationService, nil)
+	updateTaskCommand.SetArgs([]string{"-i=1", "-p=4"})
+	err := updateTaskCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestInputParameters(t *testing.T) {
+
+	var parametersToTest = []struct {
+		description   string
+		arguments     []string
+		expectedError string
+	}{
+		{"no changes are provided", []string{"-i=1"}, errorNothingToUpdate},
+		{"the content is empty", []string{"-i=1", "--content="}, errorContentNotProvided},
+		{"the priority is not valid", []string{"-i=1", "-p=5"}, errorInvalidPriority},
+	}
+
+	for _, parametersToTest := range parametersToTest {
+		parametersToTest := parametersToTest
+
+		t.Run("If "+parametersToTest.description+", then an error stating so is written to the console", func(t *testing.T) {
+
+			mockAuthenticationService := &mocks.MockAuthenticationService{
+				AuthenticatedStateToReturn: true,
+			}
+			mockOutputStream := &bytes.Buffer{}
+
+			updateTaskCommand := NewUpdateTaskCommand(mockOutputStream, mockAuthenticationService, nil)
+			updateTaskCommand.SetArgs(parametersToTest.arguments)
+			err := updateTaskCommand.Execute()
+
+			assert.Equal(t, parametersToTest.expectedError, err.Error())
+
+		})
+	}
+
+}
+
+func TestUpdatingATask(t *testing.T) {
+
+	t.Run("When updating a task and an error occurs, then an error is written to the console", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			UpdateTaskFunc: func(uint32, types.TaskChanges) error {
+				return errors.New("test error")
+			},
+		}
+
+		updateTaskCommand := NewUpdateTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		updateTaskCommand.SetArgs([]string{"-i=1", "-p=4"})
+		err := updateTaskCommand.Execute()
+
+		assert.Equal(t, errorFailedToUpdateTask, err.Error())
+
+	})
+
+	t.Run("When updating a task, then only the provided values are passed to the task service", func(t *testing.T) {
+
+		var updatedTaskID uint32
+		var providedChanges types.TaskChanges
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			UpdateTaskFunc: func(taskID uint32, changes types.TaskChanges) error {
+				updatedTaskID = taskID
+				providedChanges = changes
+				return nil
+			},
+		}
+
+		updateTaskCommand := NewUpdateTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		updateTaskCommand.SetArgs([]string{"-i=2", "-p=3", "-d=tomorrow"})
+		updateTaskCommand.Execute()
+
+		assert.Equal(t, successTaskUpdated, mockOutputStream.String())
+		assert.Equal(t, uint32(2), updatedTaskID)
+		assert.Nil(t, providedChanges.Content)
+		assert.Nil(t, providedChanges.Description)
+		assert.Equal(t, 3, *providedChanges.Priority)
+		assert.Equal(t, "tomorrow", *providedChanges.Due)
+		assert.Nil(t, providedChanges.Deadline)
+		assert.Nil(t, providedChanges.Labels)
+
+	})
+
+	t.Run("When the task is referenced by content as an argument, then the matching task is updated", func(t *testing.T) {
+
+		var updatedTaskID uint32
+		var providedReference types.TaskReference
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockTaskService := &mocks.MockTaskService{
+			ResolveTaskFunc: func(reference types.TaskReference) (*types.Task, error) {
+				providedReference = reference
+				return &types.Task{ID: 7}, nil
+			},
+			UpdateTaskFunc: func(taskID uint32, changes types.TaskChanges) error {
+				updatedTaskID = taskID
+				return nil
+			},
+		}
+
+		updateTaskCommand := NewUpdateTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		updateTaskCommand.SetArgs([]string{"buy milk", "-p=4"})
+		err := updateTaskCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "buy milk", providedReference.Content)
+		assert.Equal(t, uint32(7), updatedTaskID)
+
+	})
+
+	t.Run("When several tasks are referenced, then a validation error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		updateTaskCommand := NewUpdateTaskCommand(&bytes.Buffer{}, mockAuthenticationService, &mocks.MockTaskService{})
+		updateTaskCommand.SetArgs([]string{"-i=1-2", "-p=4"})
+		err := updateTaskCommand.Execute()
+
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When updating the labels of a task, then the provided labels replace the existing labels", func(t *testing.T) {
+
+		var providedChanges types.TaskChanges
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			UpdateTaskFunc: func(taskID uint32, changes types.TaskChanges) error {
+				providedChanges = changes
+				return nil
+			},
+		}
+
+		updateTaskCommand := NewUpdateTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		updateTaskCommand.SetArgs([]string{"-i=2", "--label=errand", "--label=focus"})
+		updateTaskCommand.Execute()
+
+		assert.Equal(t, successTaskUpdated, mockOutputStream.String())
+		assert.Equal(t, []string{"errand", "focus"}, *providedChanges.Labels)
+		assert.Nil(t, providedChanges.Priority)
+
+	})
+
+	t.Run("When updating the deadline of a task, then the deadline is passed to the task service", func(t *testing.T) {
+
+		var providedChanges types.TaskChanges
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockOutputStream := &bytes.Buffer{}
+		mockTaskService := &mocks.MockTaskService{
+			UpdateTaskFunc: func(taskID uint32, changes types.TaskChanges) error {
+				providedChanges = changes
+				return nil
+			},
+		}
+
+		updateTaskCommand := NewUpdateTaskCommand(mockOutputStream, mockAuthenticationService, mockTaskService)
+		updateTaskCommand.SetArgs([]string{"-i=2", "--deadline=2020-05-15"})
+		updateTaskCommand.Execute()
+
+		assert.Equal(t, successTaskUpdated, mockOutputStream.String())
+		assert.Equal(t, "2020-05-15", *providedChanges.Deadline)
+		assert.Nil(t, providedChanges.Due)
+
+	})
+
+	t.Run("When the deadline is rejected as invalid, then the validation error is returned as is", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockTaskService := &mocks.MockTaskService{
+			UpdateTaskFunc: func(taskID uint32, changes types.TaskChanges) error {
+				return failures.New(failures.Validation, "invalid deadline")
+			},
+		}
+
+		updateTaskCommand := NewUpdateTaskCommand(&bytes.Buffer{}, mockAuthenticationService, mockTaskService)
+		updateTaskCommand.SetArgs([]string{"-i=2", "--deadline=someday"})
+		err := updateTaskCommand.Execute()
+
+		assert.Equal(t, "invalid deadline", err.Error())
+
+	})
+
+}
@@ -0,0 +1,85 @@
+package undo
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/spf13/cobra"
+)
+
+const (
+	errorNotCurrentlyAuthenticated = "Error, you are not currently logged in"
+	errorFailedToUndo              = "An error occurred while undoing the operations"
+	successOperationUndone         = "Undid: %s"
+	successOperationsUndone        = "Undid %d operations:"
+)
+
+type dependencies struct {
+	outputStream          io.Writer
+	authenticationService authentication.Service
+	replicaService        replica.Service
+}
+
+// NewUndoCommand creates an instance of the command that reverses the most recent operations sent to Todoist
+func NewUndoCommand(o io.Writer, a authentication.Service, r replica.Service) *cobra.Command {
+	var dependencies = &dependencies{
+		outputStream:          o,
+		authenticationService: a,
+		replicaService:        r,
+	}
+
+	steps := 1
+	skipIrreversible := false
+
+	var undoCommand = &cobra.Command{
+		Use:   "undo",
+		Short: "Undo the last operations",
+		Long: "Reverse the most recent operations sent to Todoist.com, such as completing, adding or updating tasks. " +
+			"Use 'todoist history' to see the operations that can be undone, deleted tasks and projects cannot be restored. " +
+			"Undoing stops at an operation that cannot be undone unless --skip-irreversible is provided, which passes over such operations.",
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return execute(dependencies, steps, skipIrreversible)
+		},
+	}
+
+	undoCommand.Flags().IntVarP(&steps, "steps", "n", 1, "the number of operations to undo")
+	undoCommand.Flags().BoolVar(&skipIrreversible, "skip-irreversible", false, "pass over operations that cannot be undone, such as deletions, to undo the operations before them")
+
+	return undoCommand
+}
+
+func execute(d *dependencies, steps int, skipIrreversible bool) error {
+	isAuthenticated, _ := d.authenticationService.IsAuthenticated()
+	if !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	undoneOperations, err := d.replicaService.Undo(steps, skipIrreversible)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) || failures.Is(err, failures.Validation) || failures.Is(err, failures.NotFound) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUndo)
+	}
+
+	descriptions := make([]string, len(undoneOperations))
+	for index, undoneOperation := range undoneOperations {
+		descriptions[index] = undoneOperation.Description
+	}
+
+	message := fmt.Sprintf(successOperationUndone, descriptions[0])
+	if len(descriptions) > 1 {
+		message = fmt.Sprintf(successOperationsUndone, len(descriptions)) + "\n- " + strings.Join(descriptions, "\n- ")
+	}
+
+	output.WriteMessage(d.outputStream, message, output.Field{Name: "undone", Value: descriptions})
+	return warning
+}
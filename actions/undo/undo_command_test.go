@@ -0,0 +1,139 @@
+package undo
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotAuthenticated(t *testing.T) {
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: false,
+	}
+
+	undoCommand := NewUndoCommand(&bytes.Buffer{}, mockAuthenticationService, nil)
+	err := undoCommand.Execute()
+
+	assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+}
+
+func TestUndoingOperations(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	t.Run("When undoing without steps, then the last operation is undone and described", func(t *testing.T) {
+
+		var requestedSteps int
+		mockOutputStream := &bytes.Buffer{}
+		mockReplicaService := &mocks.MockReplicaService{
+			UndoFunc: func(steps int, skipIrreversible bool) ([]journal.Entry, error) {
+				requestedSteps = steps
+				return []journal.Entry{{Description: "complete task 'Buy milk'"}}, nil
+			},
+		}
+
+		undoCommand := NewUndoCommand(mockOutputStream, authenticated, mockReplicaService)
+		err := undoCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, requestedSteps)
+		assert.Equal(t, "Undid: complete task 'Buy milk'", mockOutputStream.String())
+
+	})
+
+	t.Run("When undoing several steps, then every undone operation is listed", func(t *testing.T) {
+
+		var requestedSteps int
+		mockOutputStream := &bytes.Buffer{}
+		mockReplicaService := &mocks.MockReplicaService{
+			UndoFunc: func(steps int, skipIrreversible bool) ([]journal.Entry, error) {
+				requestedSteps = steps
+				return []journal.Entry{{Description: "add task 'Pay rent'"}, {Description: "complete task 'Buy milk'"}}, nil
+			},
+		}
+
+		undoCommand := NewUndoCommand(mockOutputStream, authenticated, mockReplicaService)
+		undoCommand.SetArgs([]string{"--steps", "2"})
+		undoCommand.Execute()
+
+		assert.Equal(t, 2, requestedSteps)
+		assert.Equal(t, "Undid 2 operations:\n- add task 'Pay rent'\n- complete task 'Buy milk'", mockOutputStream.String())
+
+	})
+
+	t.Run("When undoing with --skip-irreversible, then operations that cannot be undone are passed over", func(t *testing.T) {
+
+		var requestedSkipIrreversible bool
+		mockReplicaService := &mocks.MockReplicaService{
+			UndoFunc: func(steps int, skipIrreversible bool) ([]journal.Entry, error) {
+				requestedSkipIrreversible = skipIrreversible
+				return []journal.Entry{{Description: "complete task 'Buy milk'"}}, nil
+			},
+		}
+
+		undoCommand := NewUndoCommand(&bytes.Buffer{}, authenticated, mockReplicaService)
+		undoCommand.SetArgs([]string{"--skip-irreversible"})
+		err := undoCommand.Execute()
+
+		assert.Nil(t, err)
+		assert.True(t, requestedSkipIrreversible)
+
+	})
+
+	t.Run("When the operations are undone but the undo cannot be recorded, then the undone operations are written and the warning is returned", func(t *testing.T) {
+
+		expectedWarning := failures.NewWarning(errors.New("The operations were undone on Todoist but the undo could not be recorded in the history."))
+		mockReplicaService := &mocks.MockReplicaService{
+			UndoFunc: func(int, bool) ([]journal.Entry, error) {
+				return []journal.Entry{{Description: "complete task 'Buy milk'"}}, expectedWarning
+			},
+		}
+		mockOutputStream := &bytes.Buffer{}
+
+		undoCommand := NewUndoCommand(mockOutputStream, authenticated, mockReplicaService)
+		err := undoCommand.Execute()
+
+		assert.Equal(t, expectedWarning, err)
+		assert.Contains(t, mockOutputStream.String(), "complete task 'Buy milk'")
+
+	})
+
+	t.Run("When there is nothing to undo, then the not found error is returned", func(t *testing.T) {
+
+		expectedError := failures.New(failures.NotFound, "There are no operations left to undo.")
+		mockReplicaService := &mocks.MockReplicaService{
+			UndoFunc: func(int, bool) ([]journal.Entry, error) {
+				return nil, expectedError
+			},
+		}
+
+		undoCommand := NewUndoCommand(&bytes.Buffer{}, authenticated, mockReplicaService)
+		err := undoCommand.Execute()
+
+		assert.Equal(t, expectedError, err)
+
+	})
+
+	t.Run("When an error occurs while undoing, then an error is returned", func(t *testing.T) {
+
+		mockReplicaService := &mocks.MockReplicaService{
+			UndoFunc: func(int, bool) ([]journal.Entry, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		undoCommand := NewUndoCommand(&bytes.Buffer{}, authenticated, mockReplicaService)
+		err := undoCommand.Execute()
+
+		assert.Equal(t, errorFailedToUndo, err.Error())
+
+	})
+
+}
@@ -1,13 +1,13 @@
 package authentication
 
 import (
-	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"sync"
 
 	"github.com/kpdowns/todoist-cli/authentication/types"
+	"github.com/kpdowns/todoist-cli/failures"
 )
 
 // Server handles the callback from Todoist.com during the authentication flow
@@ -31,7 +31,7 @@ func (s *server) StartTemporaryServerToListenForResponse(guid string) (*types.Au
 	}
 
 	if response.Code == "" {
-		return nil, errors.New(errorNoAuthCodeReceived)
+		return nil, failures.New(failures.NotAuthenticated, errorNoAuthCodeReceived)
 	}
 
 	return response, err
@@ -69,16 +69,16 @@ func (s *server) handleOauthResponse(w http.ResponseWriter, r *http.Request, csr
 	state := queryParameters.Get("state")
 	if state == "" {
 
-		return nil, errors.New(errorAuthenticationRejected)
+		return nil, failures.New(failures.NotAuthenticated, errorAuthenticationRejected)
 	}
 
 	if csrfGUID != state {
-		return nil, errors.New(errorPotentialCsrfAttack)
+		return nil, failures.New(failures.NotAuthenticated, errorPotentialCsrfAttack)
 	}
 
 	code := queryParameters.Get("code")
 	if code == "" {
-		return nil, errors.New(errorNoAuthCodeReceived)
+		return nil, failures.New(failures.NotAuthenticated, errorNoAuthCodeReceived)
 	}
 
 	const successfulResponseHTML = `
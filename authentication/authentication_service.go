@@ -1,11 +1,11 @@
 package authentication
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/kpdowns/todoist-cli/authentication/types"
 	"github.com/kpdowns/todoist-cli/config"
+	"github.com/kpdowns/todoist-cli/failures"
 
 	"github.com/kpdowns/todoist-cli/todoist"
 )
@@ -68,12 +68,12 @@ func (s *service) GetAccessToken() (*types.AccessToken, error) {
 // SignIn signs into Todoist using the provided guid as a CSRF token
 func (s *service) SignIn(guid string) error {
 	if guid == "" {
-		return errors.New(errorNoCodeAvailableToSignInWith)
+		return failures.New(failures.Validation, errorNoCodeAvailableToSignInWith)
 	}
 
 	response, err := s.server.StartTemporaryServerToListenForResponse(guid)
 	if err != nil {
-		return errors.New(errorNoAuthCodeReceived)
+		return failures.New(failures.NotAuthenticated, errorNoAuthCodeReceived)
 	}
 
 	token, err := s.api.GetAccessToken(response.Code)
@@ -82,7 +82,7 @@ func (s *service) SignIn(guid string) error {
 	}
 
 	if token.AccessToken == "" {
-		return errors.New(errorNoAccessTokenReceived)
+		return failures.New(failures.NotAuthenticated, errorNoAccessTokenReceived)
 	}
 
 	err = s.repository.UpdateAccessToken(token.AccessToken)
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+const errorInvalidConfigurationFile = "Error, the configuration file '%s' is not valid: %s"
+
+type configurationFile struct {
+	Templates map[string]string `yaml:"templates"`
+}
+
+// LoadTemplates loads the named templates defined in the templates section of the configuration file. A missing
+// configuration file defines no templates.
+//
+//	templates:
+//	  prompt: "{{.ID}} {{truncate 30 .Content}}"
+func LoadTemplates(path string) (map[string]string, error) {
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errorInvalidConfigurationFile, path, err.Error())
+	}
+
+	file := &configurationFile{}
+	if err := yaml.Unmarshal(contents, file); err != nil {
+		return nil, fmt.Errorf(errorInvalidConfigurationFile, path, err.Error())
+	}
+
+	if file.Templates == nil {
+		return map[string]string{}, nil
+	}
+	return file.Templates, nil
+}
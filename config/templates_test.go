@@ -0,0 +1,43 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadingTemplates(t *testing.T) {
+
+	directory, _ := ioutil.TempDir("", "todoist-cli")
+	defer os.RemoveAll(directory)
+
+	t.Run("Given no configuration file, when loading templates, then there are no templates", func(t *testing.T) {
+		templates, err := LoadTemplates(filepath.Join(directory, "missing.yml"))
+
+		assert.Nil(t, err)
+		assert.Empty(t, templates)
+	})
+
+	t.Run("Given a configuration file with templates, when loading templates, then they are returned by name", func(t *testing.T) {
+		path := filepath.Join(directory, "config.yml")
+		ioutil.WriteFile(path, []byte("templates:\n  prompt: \"{{.ID}} {{.Content}}\"\n"), 0600)
+
+		templates, err := LoadTemplates(path)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{"prompt": "{{.ID}} {{.Content}}"}, templates)
+	})
+
+	t.Run("Given a configuration file that is not valid YAML, when loading templates, then an error is returned", func(t *testing.T) {
+		path := filepath.Join(directory, "invalid.yml")
+		ioutil.WriteFile(path, []byte("templates: [\n"), 0600)
+
+		_, err := LoadTemplates(path)
+
+		assert.NotNil(t, err)
+	})
+
+}
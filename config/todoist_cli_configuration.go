@@ -1,27 +1,59 @@
 package config
 
-import "github.com/kpdowns/todoist-cli/config/secrets"
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/config/secrets"
+)
+
+const (
+	requestTimeoutEnvironmentVariable         = "TODOIST_CLI_REQUEST_TIMEOUT"
+	maximumRequestAttemptsEnvironmentVariable = "TODOIST_CLI_MAX_REQUEST_ATTEMPTS"
+)
 
 // TodoistCliConfiguration contains the configuration required for the TodoistCli to function
 type TodoistCliConfiguration struct {
-	TodoistURL          string
-	ClientID            string
-	ClientSecret        string
-	RequiredPermissions string
-	AppServiceURL       string
-	OauthRedirectURL    string
+	TodoistURL             string
+	ClientID               string
+	ClientSecret           string
+	RequiredPermissions    string
+	AppServiceURL          string
+	OauthRedirectURL       string
+	RequestTimeout         time.Duration
+	MaximumRequestAttempts int
 }
 
-// LoadConfiguration loads the configuration file located in ./config.yml, emits an error if the configuration file is not valid
+// LoadConfiguration loads the configuration file located in ./config.yml, emits an error if the configuration file is not valid.
+// The request timeout and maximum number of attempts of a request can be overridden using environment variables.
 func LoadConfiguration() *TodoistCliConfiguration {
 	secrets := secrets.GetSecrets()
 
 	return &TodoistCliConfiguration{
-		TodoistURL:          "https://todoist.com",
-		ClientID:            secrets.ClientID,
-		ClientSecret:        secrets.ClientSecret,
-		RequiredPermissions: "data:read_write,data:delete,project:delete",
-		AppServiceURL:       "http://127.0.0.1:8123",
-		OauthRedirectURL:    "http://127.0.0.1:8123/oauth/access_token",
+		TodoistURL:             "https://todoist.com",
+		ClientID:               secrets.ClientID,
+		ClientSecret:           secrets.ClientSecret,
+		RequiredPermissions:    "data:read_write,data:delete,project:delete",
+		AppServiceURL:          "http://127.0.0.1:8123",
+		OauthRedirectURL:       "http://127.0.0.1:8123/oauth/access_token",
+		RequestTimeout:         durationFromEnvironment(requestTimeoutEnvironmentVariable, 10*time.Second),
+		MaximumRequestAttempts: intFromEnvironment(maximumRequestAttemptsEnvironmentVariable, 4),
+	}
+}
+
+func durationFromEnvironment(name string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func intFromEnvironment(name string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return defaultValue
 	}
+	return value
 }
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadingRequestConfiguration(t *testing.T) {
+
+	t.Run("Given no environment variables, when loading the configuration, then the default timeout and attempts are used", func(t *testing.T) {
+		os.Unsetenv(requestTimeoutEnvironmentVariable)
+		os.Unsetenv(maximumRequestAttemptsEnvironmentVariable)
+
+		configuration := LoadConfiguration()
+
+		assert.Equal(t, 10*time.Second, configuration.RequestTimeout)
+		assert.Equal(t, 4, configuration.MaximumRequestAttempts)
+	})
+
+	t.Run("Given environment variables, when loading the configuration, then they override the timeout and attempts", func(t *testing.T) {
+		os.Setenv(requestTimeoutEnvironmentVariable, "1m")
+		os.Setenv(maximumRequestAttemptsEnvironmentVariable, "8")
+		defer os.Unsetenv(requestTimeoutEnvironmentVariable)
+		defer os.Unsetenv(maximumRequestAttemptsEnvironmentVariable)
+
+		configuration := LoadConfiguration()
+
+		assert.Equal(t, time.Minute, configuration.RequestTimeout)
+		assert.Equal(t, 8, configuration.MaximumRequestAttempts)
+	})
+
+	t.Run("Given invalid environment variables, when loading the configuration, then the defaults are used", func(t *testing.T) {
+		os.Setenv(requestTimeoutEnvironmentVariable, "soon")
+		os.Setenv(maximumRequestAttemptsEnvironmentVariable, "-1")
+		defer os.Unsetenv(requestTimeoutEnvironmentVariable)
+		defer os.Unsetenv(maximumRequestAttemptsEnvironmentVariable)
+
+		configuration := LoadConfiguration()
+
+		assert.Equal(t, 10*time.Second, configuration.RequestTimeout)
+		assert.Equal(t, 4, configuration.MaximumRequestAttempts)
+	})
+
+}
@@ -0,0 +1,165 @@
+// Package failures describes the kinds of errors that can occur while running a command. The kind of an error
+// determines the exit code of todoist-cli so that scripts can react to failures:
+//
+//	0  the command succeeded
+//	1  an unexpected error occurred
+//	2  the flags or arguments of the command are not valid
+//	3  you are not logged in, or Todoist did not accept your credentials
+//	4  the task, project or section referenced does not exist
+//	5  Todoist could not be reached
+//	6  Todoist rejected the request
+//
+// A Warning is reported to the user without changing the exit code, the command succeeded despite it.
+package failures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Kind categorizes an error
+type Kind int
+
+const (
+	// Unexpected errors are not described by any other kind
+	Unexpected Kind = iota
+	// Validation errors occur when the flags or arguments of a command are not valid
+	Validation
+	// NotAuthenticated errors occur when you are not logged in or Todoist did not accept your credentials
+	NotAuthenticated
+	// NotFound errors occur when a referenced resource does not exist
+	NotFound
+	// Network errors occur when Todoist could not be reached
+	Network
+	// Rejected errors occur when Todoist rejected a request
+	Rejected
+)
+
+var exitCodes = map[Kind]int{
+	Unexpected:       1,
+	Validation:       2,
+	NotAuthenticated: 3,
+	NotFound:         4,
+	Network:          5,
+	Rejected:         6,
+}
+
+// Error is an error of a known kind
+type Error struct {
+	kind    Kind
+	message string
+	cause   error
+}
+
+// New creates an error of the provided kind
+func New(kind Kind, message string) error {
+	return &Error{
+		kind:    kind,
+		message: message,
+	}
+}
+
+// Newf creates an error of the provided kind with a formatted message
+func Newf(kind Kind, format string, arguments ...interface{}) error {
+	return New(kind, fmt.Sprintf(format, arguments...))
+}
+
+// Wrap replaces the message of an error with one that makes sense to the user, the kind of the cause is kept so that it still determines the exit code
+func Wrap(cause error, message string) error {
+	return &Error{
+		kind:    KindOf(cause),
+		message: message,
+		cause:   cause,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Kind returns the kind of the error
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+// Unwrap returns the error that caused this error, if any
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// KindOf returns the kind of the first error in the chain that has a kind, errors without a kind are unexpected
+func KindOf(err error) Kind {
+	var kindedError interface{ Kind() Kind }
+	if errors.As(err, &kindedError) {
+		return kindedError.Kind()
+	}
+	return Unexpected
+}
+
+// Is returns true if the error is of the provided kind
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
+
+// Warning is one or more errors that did not stop the command from succeeding, e.g. a command queued while offline that Todoist rejected
+// when it was sent along with the command. The result of the command is reported as usual and the warning after it.
+type Warning struct {
+	causes []error
+}
+
+// NewWarning turns the errors into a warning, the errors that are nil are left out and nil is returned when there are no errors.
+// The causes of warnings among the errors are taken over so that warnings can be combined.
+func NewWarning(causes ...error) error {
+	warning := &Warning{}
+	for _, cause := range causes {
+		if causeWarning, isWarning := cause.(*Warning); isWarning {
+			warning.causes = append(warning.causes, causeWarning.causes...)
+			continue
+		}
+		if cause != nil {
+			warning.causes = append(warning.causes, cause)
+		}
+	}
+
+	if len(warning.causes) == 0 {
+		return nil
+	}
+	return warning
+}
+
+func (w *Warning) Error() string {
+	messages := make([]string, len(w.causes))
+	for index, cause := range w.causes {
+		messages[index] = cause.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the first error that the warning reports
+func (w *Warning) Unwrap() error {
+	return w.causes[0]
+}
+
+// IsWarning returns true if the error is a warning, a warning wrapped in another error is a failure of that error's kind
+func IsWarning(err error) bool {
+	_, isWarning := err.(*Warning)
+	return isWarning
+}
+
+// SeparateWarning returns the error as a warning when it is one and as a failure otherwise, so that callers can handle failures
+// as usual and pass the warning on along with the result
+func SeparateWarning(err error) (warning error, failure error) {
+	if IsWarning(err) {
+		return err, nil
+	}
+	return nil, err
+}
+
+// ExitCode returns the exit code of the process when a command fails with the error, 0 when there is no error or only a warning
+func ExitCode(err error) int {
+	if err == nil || IsWarning(err) {
+		return 0
+	}
+	return exitCodes[KindOf(err)]
+}
@@ -0,0 +1,114 @@
+package failures
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type kindedError struct{}
+
+func (e kindedError) Error() string { return "rejected" }
+func (e kindedError) Kind() Kind    { return Rejected }
+
+func TestKindsOfErrors(t *testing.T) {
+
+	t.Run("Given an error of a kind, when checking its kind, then the kind is returned", func(t *testing.T) {
+		err := New(NotFound, "missing")
+
+		assert.Equal(t, NotFound, KindOf(err))
+		assert.True(t, Is(err, NotFound))
+		assert.Equal(t, "missing", err.Error())
+	})
+
+	t.Run("Given a formatted error, when converting it to a string, then the arguments are formatted", func(t *testing.T) {
+		err := Newf(NotFound, "The task %d does not exist", 7)
+
+		assert.Equal(t, "The task 7 does not exist", err.Error())
+		assert.Equal(t, NotFound, KindOf(err))
+	})
+
+	t.Run("Given a wrapped error, when checking its kind, then the kind of the cause is kept", func(t *testing.T) {
+		cause := New(Network, "offline")
+		err := Wrap(cause, "An error occurred")
+
+		assert.Equal(t, Network, KindOf(err))
+		assert.Equal(t, "An error occurred", err.Error())
+		assert.Equal(t, cause, errors.Unwrap(err))
+	})
+
+	t.Run("Given an error that describes its own kind, when checking its kind, then that kind is returned", func(t *testing.T) {
+		err := fmt.Errorf("context: %w", kindedError{})
+
+		assert.Equal(t, Rejected, KindOf(err))
+	})
+
+	t.Run("Given an error without a kind, when checking its kind, then it is unexpected", func(t *testing.T) {
+		assert.Equal(t, Unexpected, KindOf(errors.New("test")))
+		assert.Equal(t, Unexpected, KindOf(Wrap(errors.New("test"), "wrapped")))
+		assert.False(t, Is(nil, Unexpected))
+	})
+
+}
+
+func TestWarnings(t *testing.T) {
+
+	t.Run("Given no error, when turning it into a warning, then there is no warning", func(t *testing.T) {
+		assert.Nil(t, NewWarning(nil))
+		assert.Nil(t, NewWarning(nil, nil))
+	})
+
+	t.Run("Given several errors, when turning them into a warning, then the warning reports each of them", func(t *testing.T) {
+		warning := NewWarning(errors.New("rejected"), nil, errors.New("not journaled"))
+
+		assert.Equal(t, "rejected\nnot journaled", warning.Error())
+		assert.Equal(t, "rejected", errors.Unwrap(warning).Error())
+	})
+
+	t.Run("Given a warning and an error, when turning them into a warning, then the causes of the warning are taken over", func(t *testing.T) {
+		warning := NewWarning(NewWarning(errors.New("rejected")), errors.New("not journaled"))
+
+		assert.Equal(t, "rejected\nnot journaled", warning.Error())
+		assert.Equal(t, "rejected", errors.Unwrap(warning).Error())
+	})
+
+	t.Run("Given a warning, when separating it, then it is returned as the warning and there is no failure", func(t *testing.T) {
+		cause := New(Rejected, "test")
+
+		warning, failure := SeparateWarning(NewWarning(cause))
+		assert.Nil(t, failure)
+		assert.True(t, IsWarning(warning))
+		assert.Equal(t, "test", warning.Error())
+		assert.True(t, errors.Is(warning, cause))
+	})
+
+	t.Run("Given a failure, when separating it, then it is returned as the failure and there is no warning", func(t *testing.T) {
+		warning, failure := SeparateWarning(New(Rejected, "test"))
+		assert.Nil(t, warning)
+		assert.Equal(t, "test", failure.Error())
+	})
+
+}
+
+func TestExitCodes(t *testing.T) {
+	var errorsToTest = []struct {
+		err              error
+		expectedExitCode int
+	}{
+		{nil, 0},
+		{errors.New("test"), 1},
+		{New(Validation, "test"), 2},
+		{New(NotAuthenticated, "test"), 3},
+		{New(NotFound, "test"), 4},
+		{New(Network, "test"), 5},
+		{New(Rejected, "test"), 6},
+		{NewWarning(New(Rejected, "test")), 0},
+		{Wrap(NewWarning(New(Rejected, "test")), "wrapped"), 6},
+	}
+
+	for _, errorToTest := range errorsToTest {
+		assert.Equal(t, errorToTest.expectedExitCode, ExitCode(errorToTest.err))
+	}
+}
@@ -7,4 +7,5 @@ require (
 	github.com/fatih/color v1.9.0
 	github.com/spf13/cobra v1.0.0
 	github.com/stretchr/testify v1.5.1
+	gopkg.in/yaml.v2 v2.2.2
 )
@@ -0,0 +1,64 @@
+// Package journal keeps a record of the operations sent to Todoist along with the commands that reverse them, so that they can be undone
+package journal
+
+import (
+	"time"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+)
+
+// Entry is a single operation of the cli, the commands sent to Todoist together by one command line
+type Entry struct {
+	ID          string                   `json:"id"`
+	Time        time.Time                `json:"time"`
+	Description string                   `json:"description"`
+	Commands    []requests.CommandDetail `json:"commands"`
+
+	// Inverse are the commands that reverse the operation in the order they are to be sent, only their type and arguments are kept
+	// since they are sent as new commands
+	Inverse []requests.CommandDetail `json:"inverse"`
+
+	// Reversible is false when one of the commands cannot be reversed, such as deleting a task
+	Reversible bool `json:"reversible"`
+
+	// Undoes are the ids of the entries that were undone by the operation, undoing an operation is not itself undone
+	Undoes []string `json:"undoes,omitempty"`
+}
+
+// IsUndo returns true if the entry undid earlier entries
+func (e *Entry) IsUndo() bool {
+	return len(e.Undoes) > 0
+}
+
+// Journal is the record of operations, in the order they were sent, along with the ids Todoist assigned to temporary ids
+type Journal struct {
+	Entries       []Entry          `json:"entries"`
+	TempIDMapping map[string]int64 `json:"temp_id_mapping"`
+}
+
+// IsUndone returns true if a later entry undid the entry with the provided id
+func (j *Journal) IsUndone(entryID string) bool {
+	for _, entry := range j.Entries {
+		for _, undoneID := range entry.Undoes {
+			if undoneID == entryID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LastOperations returns up to count of the most recent entries that have not been undone, most recent first. Entries undoing
+// others are skipped, so that undoing repeatedly goes further back instead of redoing what was undone. When skipIrreversible is
+// true entries that cannot be reversed are skipped as well, so that the operations before them can be reached.
+func (j *Journal) LastOperations(count int, skipIrreversible bool) []Entry {
+	var operations []Entry
+	for index := len(j.Entries) - 1; index >= 0 && len(operations) < count; index-- {
+		entry := j.Entries[index]
+		if entry.IsUndo() || j.IsUndone(entry.ID) || (skipIrreversible && !entry.Reversible) {
+			continue
+		}
+		operations = append(operations, entry)
+	}
+	return operations
+}
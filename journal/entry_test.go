@@ -0,0 +1,47 @@
+package journal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindingTheLastOperations(t *testing.T) {
+
+	journal := &Journal{
+		Entries: []Entry{
+			{ID: "1"},
+			{ID: "2"},
+			{ID: "3"},
+			{ID: "4"},
+			{ID: "5", Undoes: []string{"4"}},
+		},
+	}
+
+	t.Run("When operations have been undone, then the undone operations and the undoing are skipped", func(t *testing.T) {
+		assert.Equal(t, []Entry{{ID: "3"}, {ID: "2"}}, journal.LastOperations(2, false))
+	})
+
+	t.Run("When more operations are requested than are left, then every operation left is returned", func(t *testing.T) {
+		assert.Equal(t, []Entry{{ID: "3"}, {ID: "2"}, {ID: "1"}}, journal.LastOperations(10, false))
+	})
+
+	t.Run("When irreversible operations are skipped, then the operations before them are returned", func(t *testing.T) {
+		journal := &Journal{
+			Entries: []Entry{
+				{ID: "1", Reversible: true},
+				{ID: "2", Reversible: false},
+				{ID: "3", Reversible: true},
+			},
+		}
+
+		assert.Equal(t, []Entry{{ID: "3", Reversible: true}, {ID: "2", Reversible: false}}, journal.LastOperations(2, false))
+		assert.Equal(t, []Entry{{ID: "3", Reversible: true}, {ID: "1", Reversible: true}}, journal.LastOperations(2, true))
+	})
+
+	t.Run("When an entry has been undone, then it is known to be undone", func(t *testing.T) {
+		assert.True(t, journal.IsUndone("4"))
+		assert.False(t, journal.IsUndone("3"))
+	})
+
+}
@@ -0,0 +1,155 @@
+package journal
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/kpdowns/todoist-cli/storage"
+)
+
+const (
+	errorRepositoryNotAbleToGetJournal    = "An error occurred while retrieving the history of operations"
+	errorRepositoryErrorPersistingJournal = "An error occurred while persisting the history of operations to disk"
+	errorRepositoryErrorDeletingJournal   = "An error occurred while deleting the history of operations"
+)
+
+// Repository persists the journal, entries are only ever appended until the whole journal is deleted, or until the commands they
+// journaled are discarded without being applied on Todoist
+type Repository interface {
+	Get() (*Journal, error)
+	Add(entry Entry) error
+	AddTemporaryIDMapping(temporaryIDMapping map[string]int64) error
+	DiscardCommands(uuids []string) error
+	DeleteAll() error
+}
+
+type repository struct {
+	file storage.File
+}
+
+// NewJournalRepository creates a new instance of the repository that writes the journal to storage
+func NewJournalRepository(file storage.File) Repository {
+	return &repository{
+		file: file,
+	}
+}
+
+// Get retrieves the journal, an empty journal is returned if nothing has been journaled yet
+func (r *repository) Get() (*Journal, error) {
+	contents, err := r.file.ReadContents()
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetJournal)
+	}
+
+	journal := &Journal{TempIDMapping: make(map[string]int64)}
+	if contents == "" {
+		return journal, nil
+	}
+
+	err = json.Unmarshal([]byte(contents), journal)
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetJournal)
+	}
+
+	if journal.TempIDMapping == nil {
+		journal.TempIDMapping = make(map[string]int64)
+	}
+	return journal, nil
+}
+
+// Add appends the entry to the end of the journal
+func (r *repository) Add(entry Entry) error {
+	journal, err := r.Get()
+	if err != nil {
+		return err
+	}
+
+	journal.Entries = append(journal.Entries, entry)
+	return r.update(journal)
+}
+
+// AddTemporaryIDMapping records the ids Todoist assigned to temporary ids, so that operations referencing resources created while
+// offline can be undone once the resources have been synced
+func (r *repository) AddTemporaryIDMapping(temporaryIDMapping map[string]int64) error {
+	if len(temporaryIDMapping) == 0 {
+		return nil
+	}
+
+	journal, err := r.Get()
+	if err != nil {
+		return err
+	}
+
+	for temporaryID, todoistID := range temporaryIDMapping {
+		journal.TempIDMapping[temporaryID] = todoistID
+	}
+	return r.update(journal)
+}
+
+// DiscardCommands updates the journal for the commands with the provided uuids that were never applied on Todoist, because they were
+// dropped from the queue or rejected. Entries whose commands were all discarded are removed. Entries with only some of their commands
+// discarded are marked irreversible, since their inverse commands would also reverse the discarded commands.
+func (r *repository) DiscardCommands(uuids []string) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	journal, err := r.Get()
+	if err != nil {
+		return err
+	}
+
+	isDiscarded := make(map[string]bool)
+	for _, uuid := range uuids {
+		isDiscarded[uuid] = true
+	}
+
+	var entries []Entry
+	isChanged := false
+	for _, entry := range journal.Entries {
+		discardedCommands := 0
+		for _, commandDetail := range entry.Commands {
+			if isDiscarded[commandDetail.UUID] {
+				discardedCommands++
+			}
+		}
+
+		if discardedCommands > 0 {
+			isChanged = true
+		}
+		if discardedCommands > 0 && discardedCommands == len(entry.Commands) {
+			continue
+		}
+		if discardedCommands > 0 {
+			entry.Reversible = false
+		}
+		entries = append(entries, entry)
+	}
+
+	if !isChanged {
+		return nil
+	}
+
+	journal.Entries = entries
+	return r.update(journal)
+}
+
+// DeleteAll removes every entry and temporary id mapping from the journal
+func (r *repository) DeleteAll() error {
+	err := r.file.OverwriteContents("")
+	if err != nil {
+		return errors.New(errorRepositoryErrorDeletingJournal)
+	}
+
+	return nil
+}
+
+func (r *repository) update(journal *Journal) error {
+	contents, _ := json.Marshal(journal)
+	err := r.file.OverwriteContents(string(contents))
+	if err != nil {
+		return errors.New(errorRepositoryErrorPersistingJournal)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package journal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/stretchr/testify/assert"
+)
+
+// file keeps the contents of storage in memory, the mocks package cannot be used since it depends on this package
+type file struct {
+	contents       string
+	readError      error
+	overwriteError error
+}
+
+func (f *file) ReadContents() (string, error) {
+	return f.contents, f.readError
+}
+
+func (f *file) OverwriteContents(contents string) error {
+	if f.overwriteError != nil {
+		return f.overwriteError
+	}
+	f.contents = contents
+	return nil
+}
+
+func TestRetrievingTheJournal(t *testing.T) {
+
+	t.Run("When nothing has been journaled, then an empty journal is returned", func(t *testing.T) {
+		repository := NewJournalRepository(&file{})
+
+		journal, err := repository.Get()
+		assert.Nil(t, err)
+		assert.Empty(t, journal.Entries)
+		assert.NotNil(t, journal.TempIDMapping)
+	})
+
+	var unreadableFilesToTest = []struct {
+		description string
+		file        *file
+	}{
+		{"the storage cannot be read", &file{readError: errors.New("test error")}},
+		{"the journal is malformed", &file{contents: "not valid json"}},
+	}
+
+	for _, fileToTest := range unreadableFilesToTest {
+		fileToTest := fileToTest
+
+		t.Run("When "+fileToTest.description+", then an error is returned", func(t *testing.T) {
+			repository := NewJournalRepository(fileToTest.file)
+
+			_, err := repository.Get()
+			if assert.NotNil(t, err) {
+				assert.Equal(t, errorRepositoryNotAbleToGetJournal, err.Error())
+			}
+		})
+	}
+
+}
+
+func TestJournalingOperations(t *testing.T) {
+
+	t.Run("When entries are added, then they are appended to the journal with their commands intact", func(t *testing.T) {
+		repository := NewJournalRepository(&file{})
+
+		first := Entry{
+			ID:          "entry-1",
+			Description: "add task 'Buy milk'",
+			Commands:    []requests.CommandDetail{{Type: commands.ItemAdd, UUID: "uuid-1", TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "Buy milk"}}},
+			Inverse:     []requests.CommandDetail{{Type: commands.ItemDelete, Arguments: map[string]interface{}{"id": "temp-1"}}},
+			Reversible:  true,
+		}
+		second := Entry{ID: "entry-2", Undoes: []string{"entry-1"}}
+
+		assert.Nil(t, repository.Add(first))
+		assert.Nil(t, repository.Add(second))
+
+		journal, err := repository.Get()
+		assert.Nil(t, err)
+		assert.Equal(t, []Entry{first, second}, journal.Entries)
+	})
+
+	t.Run("When temporary id mappings are added, then they are merged with the mappings already journaled", func(t *testing.T) {
+		repository := NewJournalRepository(&file{})
+
+		assert.Nil(t, repository.AddTemporaryIDMapping(map[string]int64{"temp-1": 100}))
+		assert.Nil(t, repository.AddTemporaryIDMapping(map[string]int64{"temp-2": 200}))
+
+		journal, err := repository.Get()
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]int64{"temp-1": 100, "temp-2": 200}, journal.TempIDMapping)
+	})
+
+	t.Run("When commands are discarded, then entries with only discarded commands are removed and entries with some discarded commands become irreversible", func(t *testing.T) {
+		repository := NewJournalRepository(&file{})
+		discarded := Entry{ID: "entry-1", Commands: []requests.CommandDetail{{UUID: "uuid-1"}}, Reversible: true}
+		partlyDiscarded := Entry{ID: "entry-2", Commands: []requests.CommandDetail{{UUID: "uuid-2"}, {UUID: "uuid-3"}}, Reversible: true}
+		kept := Entry{ID: "entry-3", Commands: []requests.CommandDetail{{UUID: "uuid-4"}}, Reversible: true}
+		repository.Add(discarded)
+		repository.Add(partlyDiscarded)
+		repository.Add(kept)
+
+		assert.Nil(t, repository.DiscardCommands([]string{"uuid-1", "uuid-2"}))
+
+		journal, err := repository.Get()
+		assert.Nil(t, err)
+		partlyDiscarded.Reversible = false
+		assert.Equal(t, []Entry{partlyDiscarded, kept}, journal.Entries)
+	})
+
+	t.Run("When an entry is added and the journal cannot be written, then an error is returned", func(t *testing.T) {
+		repository := NewJournalRepository(&file{overwriteError: errors.New("test error")})
+
+		err := repository.Add(Entry{})
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryErrorPersistingJournal, err.Error())
+		}
+	})
+
+	t.Run("When the journal is deleted, then an empty journal is returned afterwards", func(t *testing.T) {
+		file := &file{}
+		repository := NewJournalRepository(file)
+		repository.Add(Entry{ID: "1"})
+		repository.AddTemporaryIDMapping(map[string]int64{"temp-1": 100})
+
+		assert.Nil(t, repository.DeleteAll())
+
+		journal, err := repository.Get()
+		assert.Nil(t, err)
+		assert.Empty(t, journal.Entries)
+		assert.Empty(t, journal.TempIDMapping)
+	})
+
+	t.Run("When the journal is deleted and an error occurs, then an error is returned", func(t *testing.T) {
+		repository := NewJournalRepository(&file{overwriteError: errors.New("test error")})
+
+		err := repository.DeleteAll()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryErrorDeletingJournal, err.Error())
+		}
+	})
+
+}
@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/kpdowns/todoist-cli/labels/types"
+	"github.com/kpdowns/todoist-cli/storage"
+)
+
+const (
+	errorRepositoryNotAbleToGetLabel     = "An error occurred while retrieving the persisted labels"
+	errorRepositoryLabelNotFound         = "The requested label does not exist"
+	errorRepositoryErrorPersistingLabels = "An error occurred while persisting the list of labels to disk"
+	errorRepositoryErrorDeletingLabels   = "An error occurred deleting the persisted labels"
+)
+
+// LabelRepository handles persisting the label with the cli's own internal identifier
+type LabelRepository interface {
+	GetAll() (types.LabelList, error)
+	Get(uint32) (*types.Label, error)
+	CreateAll(types.LabelList) (types.LabelList, error)
+	DeleteAll() error
+}
+
+type labelRepository struct {
+	list *storage.NumberedList
+}
+
+// NewLabelRepository creates a new instance of a labelRepository that handles persistence of labels
+func NewLabelRepository(file storage.File) LabelRepository {
+	return &labelRepository{
+		list: storage.NewNumberedList(file, storage.NumberedListErrors{
+			Read:   errorRepositoryNotAbleToGetLabel,
+			Write:  errorRepositoryErrorPersistingLabels,
+			Delete: errorRepositoryErrorDeletingLabels,
+		}),
+	}
+}
+
+// GetAll retrieves all labels, error if an error occurs while retrieving the labels
+func (r *labelRepository) GetAll() (types.LabelList, error) {
+	var labels types.LabelList
+	err := r.list.Read(&labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+// Get retrieves a single label with the provided id, error if the label does not exist
+func (r *labelRepository) Get(labelID uint32) (*types.Label, error) {
+	labels, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, label := range labels {
+		if label.ID == labelID {
+			return &label, nil
+		}
+	}
+
+	return nil, errors.New(errorRepositoryLabelNotFound)
+}
+
+// CreateAll persists all labels with a generated id for later retrieval, returns a new list of labels with the generated ids populated if there is no error
+func (r *labelRepository) CreateAll(labels types.LabelList) (types.LabelList, error) {
+	labelsToPersist := append(types.LabelList(nil), labels...)
+
+	err := r.list.Write(labelsToPersist)
+	if err != nil {
+		return nil, err
+	}
+
+	return labelsToPersist, nil
+}
+
+// DeleteAll deletes all labels that have been persisted, returns error if an error occurs
+func (r *labelRepository) DeleteAll() error {
+	return r.list.Delete()
+}
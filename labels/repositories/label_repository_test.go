@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/labels/types"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGettingAllLabels(t *testing.T) {
+
+	t.Run("When getting all labels, if no error occurs, then the labels are returned", func(t *testing.T) {
+
+		expectedLabels := types.LabelList{
+			{
+				ID:        1,
+				TodoistID: 100,
+				Name:      "errand",
+				ItemOrder: 1,
+			},
+		}
+		expectedBytes, _ := json.Marshal(expectedLabels)
+
+		inMemoryFile := &mocks.MockFile{
+			Contents: string(expectedBytes),
+		}
+
+		repository := NewLabelRepository(inMemoryFile)
+
+		actualLabels, err := repository.GetAll()
+		assert.Nil(t, err)
+		assert.Equal(t, expectedLabels, actualLabels)
+
+	})
+
+	t.Run("When getting all labels, if the contents on disk cannot be deserialized, then an error is returned", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			Contents: "not valid json",
+		}
+
+		repository := NewLabelRepository(inMemoryFile)
+
+		labels, err := repository.GetAll()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryNotAbleToGetLabel, err.Error())
+		assert.Nil(t, labels)
+
+	})
+
+	t.Run("When getting all labels, if an error occurs, then the error is returned", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			ReadError: errors.New("test error"),
+		}
+
+		repository := NewLabelRepository(inMemoryFile)
+
+		labels, err := repository.GetAll()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryNotAbleToGetLabel, err.Error())
+		assert.Nil(t, labels)
+
+	})
+
+}
+
+func TestGettingAnIndividualLabel(t *testing.T) {
+
+	t.Run("When retrieving a single label, if the label exists, then the label is returned", func(t *testing.T) {
+
+		labelToBeRetrieved := &types.Label{
+			ID:   1,
+			Name: "errand",
+		}
+
+		expectedBytes, _ := json.Marshal(types.LabelList{*labelToBeRetrieved})
+		inMemoryFile := &mocks.MockFile{
+			Contents: string(expectedBytes),
+		}
+
+		repository := NewLabelRepository(inMemoryFile)
+
+		label, err := repository.Get(labelToBeRetrieved.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, labelToBeRetrieved, label)
+
+	})
+
+	t.Run("When retrieving a single label, if the label does not exist, then an error is returned", func(t *testing.T) {
+
+		expectedBytes, _ := json.Marshal(types.LabelList{types.Label{}})
+		inMemoryFile := &mocks.MockFile{
+			Contents: string(expectedBytes),
+		}
+
+		repository := NewLabelRepository(inMemoryFile)
+
+		label, err := repository.Get(1)
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryLabelNotFound, err.Error())
+		assert.Nil(t, label)
+
+	})
+
+}
+
+func TestPersistingAllLabels(t *testing.T) {
+
+	t.Run("Given a list of labels, when persisting the labels, the labels are assigned an id before being written to storage", func(t *testing.T) {
+		labelsToWrite := types.LabelList{
+			types.Label{
+				TodoistID: 100,
+				Name:      "errand",
+			},
+			types.Label{
+				TodoistID: 200,
+				Name:      "focus",
+			},
+		}
+
+		inMemoryFile := &mocks.MockFile{}
+		repository := NewLabelRepository(inMemoryFile)
+
+		returnedLabels, err := repository.CreateAll(labelsToWrite)
+		assert.Nil(t, err)
+
+		var storedLabels types.LabelList
+		json.Unmarshal([]byte(inMemoryFile.Contents), &storedLabels)
+		assert.Equal(t, storedLabels, returnedLabels)
+		assert.Equal(t, uint32(1), storedLabels[0].ID)
+		assert.Equal(t, "errand", storedLabels[0].Name)
+		assert.Equal(t, uint32(2), storedLabels[1].ID)
+		assert.Equal(t, int64(200), storedLabels[1].TodoistID)
+
+	})
+
+	t.Run("Given a list of labels, when persisting the labels and an error occurs while writing to disk, an error is returned", func(t *testing.T) {
+		inMemoryFile := &mocks.MockFile{
+			OverwriteError: errors.New("test error"),
+		}
+		repository := NewLabelRepository(inMemoryFile)
+
+		labels, err := repository.CreateAll(types.LabelList{})
+		assert.NotNil(t, err)
+		assert.Nil(t, labels)
+		assert.Equal(t, errorRepositoryErrorPersistingLabels, err.Error())
+
+	})
+
+}
+
+func TestDeletingLabels(t *testing.T) {
+
+	t.Run("When deleting all labels and an error occurs, then an error is returned", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			OverwriteError: errors.New("test error"),
+		}
+
+		repository := NewLabelRepository(inMemoryFile)
+
+		err := repository.DeleteAll()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryErrorDeletingLabels, err.Error())
+
+	})
+
+	t.Run("When deleting all labels and no error occurs, the labels are deleted from the disk", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			Contents: "test contents of file",
+		}
+
+		repository := NewLabelRepository(inMemoryFile)
+
+		err := repository.DeleteAll()
+		assert.Nil(t, err)
+		assert.Equal(t, "", inMemoryFile.Contents)
+
+	})
+
+}
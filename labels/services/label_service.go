@@ -0,0 +1,160 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/labels/repositories"
+	"github.com/kpdowns/todoist-cli/labels/types"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+)
+
+const (
+	errorNotCurrentlyAuthenticated   = "Error, you are not currently logged in."
+	errorOccurredDuringSyncOperation = "Error occurred while syncing with Todoist."
+	errorNoName                      = "A label name must be provided."
+	errorNameContainsSpaces          = "Label names cannot contain spaces."
+	errorNoLabelFound                = "The requested label does not exist."
+	errorFailedToUpdateLabel         = "An error occurred while updating the label on Todoist, please try again."
+)
+
+// LabelService provides functionality to retrieve and update labels on Todoist
+type LabelService interface {
+	GetAllLabels() (types.LabelList, error)
+	AddLabel(name string) error
+	RenameLabel(labelID uint32, name string) error
+	DeleteLabel(labelID uint32) error
+}
+
+type labelService struct {
+	api                   todoist.API
+	authenticationService authentication.Service
+	replicaService        replica.Service
+	labelRepository       repositories.LabelRepository
+}
+
+// NewLabelService creates a new instance of the label service
+func NewLabelService(api todoist.API, authenticationService authentication.Service, replicaService replica.Service, labelRepository repositories.LabelRepository) LabelService {
+	return &labelService{
+		api:                   api,
+		authenticationService: authenticationService,
+		replicaService:        replicaService,
+		labelRepository:       labelRepository,
+	}
+}
+
+// GetAllLabels returns a list of labels, sorted in the order they appear on Todoist
+func (s *labelService) GetAllLabels() (types.LabelList, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	replica, err := s.replicaService.Sync()
+	if err != nil {
+		return nil, failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	var labels types.LabelList
+	for _, todoistLabel := range replica.Labels {
+		labels = append(labels, todoistLabel.ToLabel())
+	}
+
+	persistedLabels, err := s.labelRepository.CreateAll(labels.SortByItemOrder())
+	if err != nil {
+		return nil, err
+	}
+
+	return persistedLabels, nil
+}
+
+// AddLabel creates a new label on Todoist with the provided name, a leading @ is not part of the name
+func (s *labelService) AddLabel(name string) error {
+	name, err := validateName(name)
+	if err != nil {
+		return err
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+
+	arguments := make(map[string]interface{})
+	arguments["name"] = name
+
+	command := requests.NewCommand(accessToken.AccessToken, commands.LabelAdd, arguments)
+	_, err = s.replicaService.ExecuteCommand(command)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	return warning
+}
+
+// RenameLabel changes the name of the label with the provided id, tasks keep the renamed label
+func (s *labelService) RenameLabel(labelID uint32, name string) error {
+	name, err := validateName(name)
+	if err != nil {
+		return err
+	}
+
+	arguments := make(map[string]interface{})
+	arguments["name"] = name
+
+	return s.executeCommandAgainstLabel(labelID, commands.LabelUpdate, arguments)
+}
+
+// DeleteLabel deletes the label with the provided id and removes it from all tasks
+func (s *labelService) DeleteLabel(labelID uint32) error {
+	return s.executeCommandAgainstLabel(labelID, commands.LabelDelete, make(map[string]interface{}))
+}
+
+func (s *labelService) executeCommandAgainstLabel(labelID uint32, commandType commands.CommandType, arguments map[string]interface{}) error {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+
+	label, err := s.labelRepository.Get(labelID)
+	if err != nil {
+		return failures.New(failures.NotFound, errorNoLabelFound)
+	}
+
+	arguments["id"] = label.TodoistReference()
+	command := requests.NewCommand(accessToken.AccessToken, commandType, arguments)
+	_, err = s.replicaService.ExecuteCommand(command)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUpdateLabel)
+	}
+
+	return warning
+}
+
+// validateName removes a leading @ from the name, Todoist does not allow label names to be empty or to contain spaces
+func validateName(name string) (string, error) {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "@")
+	if name == "" {
+		return "", failures.New(failures.Validation, errorNoName)
+	}
+	if strings.ContainsAny(name, " \t") {
+		return "", failures.New(failures.Validation, errorNameContainsSpaces)
+	}
+	return name, nil
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/labels/repositories"
+	"github.com/kpdowns/todoist-cli/labels/types"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/queue"
+	"github.com/kpdowns/todoist-cli/replica"
+	replicaTypes "github.com/kpdowns/todoist-cli/replica/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGettingAllLabels(t *testing.T) {
+
+	t.Run("When getting all labels and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		labelService := NewLabelService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), nil)
+
+		_, err := labelService.GetAllLabels()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When getting all labels and an error is returned from the API, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		labelService := NewLabelService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
+
+		_, err := labelService.GetAllLabels()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorOccurredDuringSyncOperation, err.Error())
+
+	})
+
+	t.Run("When getting all labels, then the labels are read from the synced replica and sorted before being saved in the repository", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return &replicaTypes.Replica{
+					Labels: []responses.Label{
+						{TodoistID: 200, Name: "focus", ItemOrder: 2},
+						{TodoistID: 100, Name: "errand", ItemOrder: 1},
+					},
+				}, nil
+			},
+		}
+		repository := repositories.NewLabelRepository(&mocks.MockFile{})
+
+		labelService := NewLabelService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, repository)
+
+		returnedLabels, err := labelService.GetAllLabels()
+		assert.Nil(t, err)
+
+		repositoryLabels, _ := repository.GetAll()
+		assert.Equal(t, repositoryLabels, returnedLabels)
+		assert.Equal(t, "errand", repositoryLabels[0].Name)
+		assert.Equal(t, uint32(1), repositoryLabels[0].ID)
+		assert.Equal(t, "focus", repositoryLabels[1].Name)
+
+	})
+
+	t.Run("When getting all labels and an error occurs while persisting the labels, then an error is returned", func(t *testing.T) {
+
+		expectedError := errors.New("test error")
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return &replicaTypes.Replica{}, nil
+			},
+		}
+		mockRepository := &mocks.MockLabelRepository{
+			CreateAllFunc: func(types.LabelList) (types.LabelList, error) {
+				return nil, expectedError
+			},
+		}
+
+		labelService := NewLabelService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, mockRepository)
+
+		_, err := labelService.GetAllLabels()
+		assert.Equal(t, expectedError, err)
+
+	})
+
+}
+
+func TestAddingALabel(t *testing.T) {
+
+	t.Run("When adding a label and the name is not valid, then a validation error is returned", func(t *testing.T) {
+
+		labelService := NewLabelService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}, nil, nil)
+
+		for name, expectedMessage := range map[string]string{"": errorNoName, "@": errorNoName, "deep work": errorNameContainsSpaces} {
+			err := labelService.AddLabel(name)
+			assert.Equal(t, expectedMessage, err.Error())
+			assert.Equal(t, failures.Validation, failures.KindOf(err))
+		}
+
+	})
+
+	t.Run("When adding a label and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		labelService := NewLabelService(&mocks.MockAPI{}, mockAuthenticationService, nil, nil)
+
+		err := labelService.AddLabel("errand")
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When adding a label and no error occurs, then a label_add command containing the name without the @ is executed", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		labelService := NewLabelService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
+
+		err := labelService.AddLabel("@errand")
+		assert.Nil(t, err)
+		assert.Equal(t, commands.LabelAdd, executedCommand.Commands[0].Type)
+		assert.Equal(t, "errand", executedCommand.Commands[0].Arguments["name"])
+
+	})
+
+}
+
+func TestUpdatingALabel(t *testing.T) {
+
+	existingLabel := func(uint32) (*types.Label, error) {
+		return &types.Label{
+			ID:        1,
+			TodoistID: 100,
+			Name:      "errand",
+		}, nil
+	}
+
+	t.Run("When updating a label and the label does not exist, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockLabelRepository{
+			GetFunc: func(uint32) (*types.Label, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		labelService := NewLabelService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), mockRepository)
+
+		err := labelService.DeleteLabel(1)
+		assert.Equal(t, errorNoLabelFound, err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When updating a label and the api returns an error, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockLabelRepository{
+			GetFunc: existingLabel,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		labelService := NewLabelService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := labelService.RenameLabel(1, "chores")
+		assert.Equal(t, errorFailedToUpdateLabel, err.Error())
+
+	})
+
+	var commandsToTest = []struct {
+		description         string
+		execute             func(LabelService) error
+		expectedCommandType commands.CommandType
+	}{
+		{"renaming", func(s LabelService) error { return s.RenameLabel(1, "chores") }, commands.LabelUpdate},
+		{"deleting", func(s LabelService) error { return s.DeleteLabel(1) }, commands.LabelDelete},
+	}
+
+	for _, commandToTest := range commandsToTest {
+		commandToTest := commandToTest
+
+		t.Run("When "+commandToTest.description+" a label and no error occurs, then the command is executed against the Todoist id of the label", func(t *testing.T) {
+
+			var executedCommand requests.Command
+
+			mockAuthenticationService := &mocks.MockAuthenticationService{
+				AuthenticatedStateToReturn: true,
+			}
+			mockRepository := &mocks.MockLabelRepository{
+				GetFunc: existingLabel,
+			}
+			mockAPI := &mocks.MockAPI{
+				ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+					executedCommand = command
+					return &responses.Command{}, nil
+				},
+			}
+
+			labelService := NewLabelService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+			err := commandToTest.execute(labelService)
+			assert.Nil(t, err)
+			assert.Equal(t, commandToTest.expectedCommandType, executedCommand.Commands[0].Type)
+			assert.Equal(t, int64(100), executedCommand.Commands[0].Arguments["id"])
+
+		})
+	}
+
+	t.Run("When renaming a label, then the new name is provided to Todoist", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockLabelRepository{
+			GetFunc: existingLabel,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		labelService := NewLabelService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := labelService.RenameLabel(1, "@chores")
+		assert.Nil(t, err)
+		assert.Equal(t, "chores", executedCommand.Commands[0].Arguments["name"])
+
+	})
+
+}
+
+func newReplicaService(api todoist.API, authenticationService authentication.Service) replica.Service {
+	return replica.NewReplicaService(api, authenticationService, replica.NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+// Label is a tag that can be attached to tasks across projects
+type Label struct {
+	ID          uint32
+	TodoistID   int64
+	TemporaryID string
+	Name        string
+	Color       int32
+	ItemOrder   int32
+	IsFavorite  int16
+}
+
+// AsString returns a tab delimited string representing the label
+func (l *Label) AsString() string {
+	return fmt.Sprintf("[%d]\t%s",
+		l.ID,
+		color.MagentaString("@%s", l.Name),
+	)
+}
+
+// TodoistReference returns the id to use for the label in commands, the temporary id is used for labels that have not been synced yet
+func (l *Label) TodoistReference() interface{} {
+	if l.TodoistID == 0 && l.TemporaryID != "" {
+		return l.TemporaryID
+	}
+	return l.TodoistID
+}
+
+// AsRecord returns the fields of the label written in machine readable output
+func (l *Label) AsRecord() output.Record {
+	return output.Record{
+		{Name: "id", Value: l.ID},
+		{Name: "todoist_id", Value: l.TodoistID},
+		{Name: "name", Value: l.Name},
+		{Name: "color", Value: l.Color},
+		{Name: "item_order", Value: l.ItemOrder},
+		{Name: "favorite", Value: l.IsFavorite == 1},
+	}
+}
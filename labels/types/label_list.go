@@ -0,0 +1,55 @@
+package types
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+// LabelList is a list of unordered labels
+type LabelList []Label
+
+// SortByItemOrder sorts the labels in the order they are displayed on Todoist. Returns a new slice of labels.
+func (l LabelList) SortByItemOrder() LabelList {
+	sortedLabels := make(LabelList, len(l))
+	copy(sortedLabels, l)
+	sort.Stable(sortedLabels)
+	return sortedLabels
+}
+
+// Len returns the length of the LabelList
+func (l LabelList) Len() int { return len(l) }
+
+// Less returns true if the label is displayed before the one being compared
+func (l LabelList) Less(i, j int) bool {
+	return l[i].ItemOrder < l[j].ItemOrder
+}
+
+// Swap swaps two different labels in the slice
+func (l LabelList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+
+// FindByName returns the label with the provided name, nil if no label matches. Names are not case sensitive and may start with @.
+func (l LabelList) FindByName(name string) *Label {
+	name = strings.TrimPrefix(name, "@")
+	for index, label := range l {
+		if strings.EqualFold(label.Name, name) {
+			return &l[index]
+		}
+	}
+	return nil
+}
+
+// AsRecords returns the labels as records written in machine readable output
+func (l LabelList) AsRecords() []output.Record {
+	records := make([]output.Record, len(l))
+	for index := range l {
+		records[index] = l[index].AsRecord()
+	}
+	return records
+}
+
+// RecordColumns returns the names of the fields of a label in machine readable output
+func RecordColumns() []string {
+	return (&Label{}).AsRecord().Names()
+}
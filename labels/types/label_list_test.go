@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGivenListOfLabelsWhenSortingLabelsThenListOfLabelsIsOrderedByItemOrder(t *testing.T) {
+	labels := LabelList{
+		Label{TodoistID: 3, ItemOrder: 3},
+		Label{TodoistID: 1, ItemOrder: 1},
+		Label{TodoistID: 2, ItemOrder: 2},
+	}
+
+	sortedLabels := labels.SortByItemOrder()
+	assert.Equal(t, int64(1), sortedLabels[0].TodoistID)
+	assert.Equal(t, int64(2), sortedLabels[1].TodoistID)
+	assert.Equal(t, int64(3), sortedLabels[2].TodoistID)
+
+	assert.Equal(t, int64(3), labels[0].TodoistID)
+}
+
+func TestGivenListOfLabelsWhenFindingALabelThenTheNameIsMatched(t *testing.T) {
+	labels := LabelList{
+		Label{TodoistID: 100, Name: "errand"},
+		Label{TodoistID: 200, Name: "Focus"},
+	}
+
+	assert.Equal(t, int64(200), labels.FindByName("focus").TodoistID)
+	assert.Equal(t, int64(100), labels.FindByName("@Errand").TodoistID)
+	assert.Nil(t, labels.FindByName("someday"))
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+)
+
+// labelTokenPattern matches @label tokens at the start of the content or after whitespace, so that email addresses are not treated as labels
+var labelTokenPattern = regexp.MustCompile(`(^|\s)@([^\s@]+)`)
+
+// ExtractLabelNames removes the @label tokens from the content of a task, returning the remaining content and the names of the labels in the order they appear
+func ExtractLabelNames(content string) (string, []string) {
+	var names []string
+	for _, match := range labelTokenPattern.FindAllStringSubmatch(content, -1) {
+		names = append(names, match[2])
+	}
+	if len(names) == 0 {
+		return content, nil
+	}
+
+	remainingContent := labelTokenPattern.ReplaceAllString(content, "$1")
+	return strings.Join(strings.Fields(remainingContent), " "), names
+}
+
+// UniqueLabelNames removes empty and repeated names, names are not case sensitive and may start with @. The first spelling of a name is kept.
+func UniqueLabelNames(names []string) []string {
+	var uniqueNames []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "@")
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		uniqueNames = append(uniqueNames, name)
+	}
+	return uniqueNames
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGivenContentWithLabelTokensWhenExtractingThenTheTokensAreRemovedAndReturned(t *testing.T) {
+	var contentsToTest = []struct {
+		content         string
+		expectedContent string
+		expectedNames   []string
+	}{
+		{"buy milk", "buy milk", nil},
+		{"buy  milk @errand", "buy milk", []string{"errand"}},
+		{"@home clean the @kitchen_sink tonight", "clean the tonight", []string{"home", "kitchen_sink"}},
+		{"email someone@example.com", "email someone@example.com", nil},
+		{"@errand", "", []string{"errand"}},
+	}
+
+	for _, contentToTest := range contentsToTest {
+		content, names := ExtractLabelNames(contentToTest.content)
+		if content != contentToTest.expectedContent {
+			t.Errorf("Expected '%s' for '%s', got '%s'", contentToTest.expectedContent, contentToTest.content, content)
+		}
+		if !reflect.DeepEqual(contentToTest.expectedNames, names) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", contentToTest.expectedNames, contentToTest.content, names)
+		}
+	}
+}
+
+func TestGivenLabelNamesWhenRemovingDuplicatesThenTheFirstSpellingOfEachNameIsKept(t *testing.T) {
+	names := UniqueLabelNames([]string{"Errand", "@focus", "", "errand", " @FOCUS ", "home"})
+
+	expectedNames := []string{"Errand", "focus", "home"}
+	if !reflect.DeepEqual(expectedNames, names) {
+		t.Errorf("Expected '%v', got '%v'", expectedNames, names)
+	}
+}
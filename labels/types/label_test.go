@@ -0,0 +1,58 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+func TestGivenALabelWhenConvertingToStringThenTheNameIsPrefixedWithAnAt(t *testing.T) {
+	label := Label{ID: 1, Name: "errand"}
+
+	stringRepresentation := label.AsString()
+	if stringRepresentation != "[1]\t@errand" {
+		t.Errorf("Expected '[1]\t@errand', got '%s'", stringRepresentation)
+	}
+}
+
+func TestGivenALabelWhenReferencingItInACommandThenTheTemporaryIDIsUsedUntilItHasBeenSynced(t *testing.T) {
+	syncedLabel := Label{TodoistID: 10, TemporaryID: "temp-1"}
+	if syncedLabel.TodoistReference() != int64(10) {
+		t.Errorf("Expected '10', got '%v'", syncedLabel.TodoistReference())
+	}
+
+	unsyncedLabel := Label{TemporaryID: "temp-1"}
+	if unsyncedLabel.TodoistReference() != "temp-1" {
+		t.Errorf("Expected 'temp-1', got '%v'", unsyncedLabel.TodoistReference())
+	}
+}
+
+func TestGivenALabelWhenConvertingToARecordThenTheFieldsHaveStableNames(t *testing.T) {
+	label := Label{
+		ID:         1,
+		TodoistID:  10,
+		Name:       "errand",
+		Color:      30,
+		ItemOrder:  2,
+		IsFavorite: 1,
+	}
+
+	expectedRecord := output.Record{
+		{Name: "id", Value: uint32(1)},
+		{Name: "todoist_id", Value: int64(10)},
+		{Name: "name", Value: "errand"},
+		{Name: "color", Value: int32(30)},
+		{Name: "item_order", Value: int32(2)},
+		{Name: "favorite", Value: true},
+	}
+
+	record := label.AsRecord()
+	if !reflect.DeepEqual(expectedRecord, record) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord, record)
+	}
+
+	if !reflect.DeepEqual(expectedRecord.Names(), RecordColumns()) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord.Names(), RecordColumns())
+	}
+}
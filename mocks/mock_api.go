@@ -10,7 +10,9 @@ type MockAPI struct {
 	RevokeAccessTokenFunction  func(accessToken string) error
 	GetAccessTokenFunction     func(code string) (*responses.AccessToken, error)
 	ExecuteSyncQueryFunction   func(query requests.Query) (*responses.Query, error)
-	ExecuteSyncCommandFunction func(command requests.Command) error
+	ExecuteSyncCommandFunction func(command requests.Command) (*responses.Command, error)
+	QuickAddFunction           func(quickAdd requests.QuickAdd) (*responses.Item, error)
+	UploadFileFunction         func(upload requests.Upload) (*responses.FileAttachment, error)
 }
 
 // RevokeAccessToken executes the function configured for revoking the TodoistAPI access token
@@ -38,9 +40,25 @@ func (a *MockAPI) ExecuteSyncQuery(query requests.Query) (*responses.Query, erro
 }
 
 // ExecuteSyncCommand executes the function configured for executing sync commands against Todoist
-func (a *MockAPI) ExecuteSyncCommand(command requests.Command) error {
+func (a *MockAPI) ExecuteSyncCommand(command requests.Command) (*responses.Command, error) {
 	if a.ExecuteSyncCommandFunction != nil {
 		return a.ExecuteSyncCommandFunction(command)
 	}
 	panic("Method call ExecuteSyncCommand used but not configured")
 }
+
+// QuickAdd executes the function configured for quick adding tasks on Todoist
+func (a *MockAPI) QuickAdd(quickAdd requests.QuickAdd) (*responses.Item, error) {
+	if a.QuickAddFunction != nil {
+		return a.QuickAddFunction(quickAdd)
+	}
+	panic("Method call QuickAdd used but not configured")
+}
+
+// UploadFile executes the function configured for uploading files to Todoist
+func (a *MockAPI) UploadFile(upload requests.Upload) (*responses.FileAttachment, error) {
+	if a.UploadFileFunction != nil {
+		return a.UploadFileFunction(upload)
+	}
+	panic("Method call UploadFile used but not configured")
+}
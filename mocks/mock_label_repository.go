@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"github.com/kpdowns/todoist-cli/labels/types"
+)
+
+// MockLabelRepository provides overrides for the functions of the label repository for testing purposes
+type MockLabelRepository struct {
+	GetAllFunc    func() (types.LabelList, error)
+	GetFunc       func(uint32) (*types.Label, error)
+	CreateAllFunc func(types.LabelList) (types.LabelList, error)
+	DeleteAllFunc func() error
+}
+
+// GetAll retrieves all labels, error if an error occurs while retrieving the labels
+func (r *MockLabelRepository) GetAll() (types.LabelList, error) {
+	return r.GetAllFunc()
+}
+
+// Get retrieves a single label with the provided id, error if the label does not exist
+func (r *MockLabelRepository) Get(labelID uint32) (*types.Label, error) {
+	return r.GetFunc(labelID)
+}
+
+// CreateAll persists all labels with a generated id for later retrieval
+func (r *MockLabelRepository) CreateAll(labels types.LabelList) (types.LabelList, error) {
+	return r.CreateAllFunc(labels)
+}
+
+// DeleteAll deletes all labels that have been persisted, returns error if an error occurs
+func (r *MockLabelRepository) DeleteAll() error {
+	return r.DeleteAllFunc()
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import "github.com/kpdowns/todoist-cli/labels/types"
+
+// MockLabelService implements the LabelService interface and allows functions to be mocked
+type MockLabelService struct {
+	GetAllLabelsFunc func() (types.LabelList, error)
+	AddLabelFunc     func(name string) error
+	RenameLabelFunc  func(labelID uint32, name string) error
+	DeleteLabelFunc  func(labelID uint32) error
+}
+
+// GetAllLabels executes the function configured in GetAllLabelsFunc
+func (s *MockLabelService) GetAllLabels() (types.LabelList, error) {
+	if s.GetAllLabelsFunc != nil {
+		return s.GetAllLabelsFunc()
+	}
+	panic("Method call GetAllLabels used but not configured")
+}
+
+// AddLabel executes the function configured in AddLabelFunc
+func (s *MockLabelService) AddLabel(name string) error {
+	if s.AddLabelFunc != nil {
+		return s.AddLabelFunc(name)
+	}
+	panic("Method call AddLabel used but not configured")
+}
+
+// RenameLabel executes the function configured in RenameLabelFunc
+func (s *MockLabelService) RenameLabel(labelID uint32, name string) error {
+	if s.RenameLabelFunc != nil {
+		return s.RenameLabelFunc(labelID, name)
+	}
+	panic("Method call RenameLabel used but not configured")
+}
+
+// DeleteLabel executes the function configured in DeleteLabelFunc
+func (s *MockLabelService) DeleteLabel(labelID uint32) error {
+	if s.DeleteLabelFunc != nil {
+		return s.DeleteLabelFunc(labelID)
+	}
+	panic("Method call DeleteLabel used but not configured")
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import "github.com/kpdowns/todoist-cli/notes/types"
+
+// MockNoteService implements the NoteService interface and allows functions to be mocked
+type MockNoteService struct {
+	GetTaskNotesFunc    func(taskID uint32) (types.NoteList, error)
+	GetProjectNotesFunc func(projectID uint32) (types.NoteList, error)
+	AddTaskNoteFunc     func(taskID uint32, content string, attachment *types.Attachment) error
+	AddProjectNoteFunc  func(projectID uint32, content string, attachment *types.Attachment) error
+}
+
+// GetTaskNotes executes the function configured in GetTaskNotesFunc
+func (s *MockNoteService) GetTaskNotes(taskID uint32) (types.NoteList, error) {
+	if s.GetTaskNotesFunc != nil {
+		return s.GetTaskNotesFunc(taskID)
+	}
+	panic("Method call GetTaskNotes used but not configured")
+}
+
+// GetProjectNotes executes the function configured in GetProjectNotesFunc
+func (s *MockNoteService) GetProjectNotes(projectID uint32) (types.NoteList, error) {
+	if s.GetProjectNotesFunc != nil {
+		return s.GetProjectNotesFunc(projectID)
+	}
+	panic("Method call GetProjectNotes used but not configured")
+}
+
+// AddTaskNote executes the function configured in AddTaskNoteFunc
+func (s *MockNoteService) AddTaskNote(taskID uint32, content string, attachment *types.Attachment) error {
+	if s.AddTaskNoteFunc != nil {
+		return s.AddTaskNoteFunc(taskID, content, attachment)
+	}
+	panic("Method call AddTaskNote used but not configured")
+}
+
+// AddProjectNote executes the function configured in AddProjectNoteFunc
+func (s *MockNoteService) AddProjectNote(projectID uint32, content string, attachment *types.Attachment) error {
+	if s.AddProjectNoteFunc != nil {
+		return s.AddProjectNoteFunc(projectID, content, attachment)
+	}
+	panic("Method call AddProjectNote used but not configured")
+}
@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"github.com/kpdowns/todoist-cli/projects/types"
+)
+
+// MockProjectRepository provides overrides for the functions of the project repository for testing purposes
+type MockProjectRepository struct {
+	GetAllFunc    func() (types.ProjectList, error)
+	GetFunc       func(uint32) (*types.Project, error)
+	CreateAllFunc func(types.ProjectList) (types.ProjectList, error)
+	DeleteAllFunc func() error
+}
+
+// GetAll retrieves all projects, error if an error occurs while retrieving the projects
+func (r *MockProjectRepository) GetAll() (types.ProjectList, error) {
+	return r.GetAllFunc()
+}
+
+// Get retrieves a single project with the provided id, error if the project does not exist
+func (r *MockProjectRepository) Get(projectID uint32) (*types.Project, error) {
+	return r.GetFunc(projectID)
+}
+
+// CreateAll persists all projects with a generated id for later retrieval
+func (r *MockProjectRepository) CreateAll(projects types.ProjectList) (types.ProjectList, error) {
+	return r.CreateAllFunc(projects)
+}
+
+// DeleteAll deletes all projects that have been persisted, returns error if an error occurs
+func (r *MockProjectRepository) DeleteAll() error {
+	return r.DeleteAllFunc()
+}
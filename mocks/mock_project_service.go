@@ -0,0 +1,61 @@
+package mocks
+
+import "github.com/kpdowns/todoist-cli/projects/types"
+
+// MockProjectService implements the ProjectService interface and allows functions to be mocked
+type MockProjectService struct {
+	GetAllProjectsFunc   func() (types.ProjectList, error)
+	AddProjectFunc       func(name string) error
+	RenameProjectFunc    func(projectID uint32, name string) error
+	ArchiveProjectFunc   func(projectID uint32) error
+	UnarchiveProjectFunc func(projectID uint32) error
+	DeleteProjectFunc    func(projectID uint32) error
+}
+
+// GetAllProjects executes the function configured in GetAllProjectsFunc
+func (s *MockProjectService) GetAllProjects() (types.ProjectList, error) {
+	if s.GetAllProjectsFunc != nil {
+		return s.GetAllProjectsFunc()
+	}
+	panic("Method call GetAllProjects used but not configured")
+}
+
+// AddProject executes the function configured in AddProjectFunc
+func (s *MockProjectService) AddProject(name string) error {
+	if s.AddProjectFunc != nil {
+		return s.AddProjectFunc(name)
+	}
+	panic("Method call AddProject used but not configured")
+}
+
+// RenameProject executes the function configured in RenameProjectFunc
+func (s *MockProjectService) RenameProject(projectID uint32, name string) error {
+	if s.RenameProjectFunc != nil {
+		return s.RenameProjectFunc(projectID, name)
+	}
+	panic("Method call RenameProject used but not configured")
+}
+
+// ArchiveProject executes the function configured in ArchiveProjectFunc
+func (s *MockProjectService) ArchiveProject(projectID uint32) error {
+	if s.ArchiveProjectFunc != nil {
+		return s.ArchiveProjectFunc(projectID)
+	}
+	panic("Method call ArchiveProject used but not configured")
+}
+
+// UnarchiveProject executes the function configured in UnarchiveProjectFunc
+func (s *MockProjectService) UnarchiveProject(projectID uint32) error {
+	if s.UnarchiveProjectFunc != nil {
+		return s.UnarchiveProjectFunc(projectID)
+	}
+	panic("Method call UnarchiveProject used but not configured")
+}
+
+// DeleteProject executes the function configured in DeleteProjectFunc
+func (s *MockProjectService) DeleteProject(projectID uint32) error {
+	if s.DeleteProjectFunc != nil {
+		return s.DeleteProjectFunc(projectID)
+	}
+	panic("Method call DeleteProject used but not configured")
+}
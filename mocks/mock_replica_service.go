@@ -0,0 +1,102 @@
+package mocks
+
+import (
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/replica/types"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+)
+
+// MockReplicaService implements the replica Service interface and allows functions to be mocked
+type MockReplicaService struct {
+	SyncFunc                func() (*types.Replica, error)
+	CachedFunc              func() (*types.Replica, error)
+	ClearFunc               func() error
+	ExecuteCommandFunc      func(command requests.Command) (map[string]int64, error)
+	GetQueuedCommandsFunc   func() ([]requests.CommandDetail, error)
+	FlushQueuedCommandsFunc func() error
+	DropQueuedCommandsFunc  func() error
+	HistoryFunc             func() (*journal.Journal, error)
+	JournalQuickAddFunc     func(item *responses.Item) error
+	UndoFunc                func(steps int, skipIrreversible bool) ([]journal.Entry, error)
+}
+
+// Sync executes the function configured in SyncFunc
+func (s *MockReplicaService) Sync() (*types.Replica, error) {
+	if s.SyncFunc != nil {
+		return s.SyncFunc()
+	}
+	panic("Method call Sync used but not configured")
+}
+
+// Cached executes the function configured in CachedFunc
+func (s *MockReplicaService) Cached() (*types.Replica, error) {
+	if s.CachedFunc != nil {
+		return s.CachedFunc()
+	}
+	panic("Method call Cached used but not configured")
+}
+
+// Clear executes the function configured in ClearFunc
+func (s *MockReplicaService) Clear() error {
+	if s.ClearFunc != nil {
+		return s.ClearFunc()
+	}
+	panic("Method call Clear used but not configured")
+}
+
+// ExecuteCommand executes the function configured in ExecuteCommandFunc
+func (s *MockReplicaService) ExecuteCommand(command requests.Command) (map[string]int64, error) {
+	if s.ExecuteCommandFunc != nil {
+		return s.ExecuteCommandFunc(command)
+	}
+	panic("Method call ExecuteCommand used but not configured")
+}
+
+// GetQueuedCommands executes the function configured in GetQueuedCommandsFunc
+func (s *MockReplicaService) GetQueuedCommands() ([]requests.CommandDetail, error) {
+	if s.GetQueuedCommandsFunc != nil {
+		return s.GetQueuedCommandsFunc()
+	}
+	panic("Method call GetQueuedCommands used but not configured")
+}
+
+// FlushQueuedCommands executes the function configured in FlushQueuedCommandsFunc
+func (s *MockReplicaService) FlushQueuedCommands() error {
+	if s.FlushQueuedCommandsFunc != nil {
+		return s.FlushQueuedCommandsFunc()
+	}
+	panic("Method call FlushQueuedCommands used but not configured")
+}
+
+// DropQueuedCommands executes the function configured in DropQueuedCommandsFunc
+func (s *MockReplicaService) DropQueuedCommands() error {
+	if s.DropQueuedCommandsFunc != nil {
+		return s.DropQueuedCommandsFunc()
+	}
+	panic("Method call DropQueuedCommands used but not configured")
+}
+
+// History executes the function configured in HistoryFunc
+func (s *MockReplicaService) History() (*journal.Journal, error) {
+	if s.HistoryFunc != nil {
+		return s.HistoryFunc()
+	}
+	panic("Method call History used but not configured")
+}
+
+// JournalQuickAdd executes the function configured in JournalQuickAddFunc
+func (s *MockReplicaService) JournalQuickAdd(item *responses.Item) error {
+	if s.JournalQuickAddFunc != nil {
+		return s.JournalQuickAddFunc(item)
+	}
+	panic("Method call JournalQuickAdd used but not configured")
+}
+
+// Undo executes the function configured in UndoFunc
+func (s *MockReplicaService) Undo(steps int, skipIrreversible bool) ([]journal.Entry, error) {
+	if s.UndoFunc != nil {
+		return s.UndoFunc(steps, skipIrreversible)
+	}
+	panic("Method call Undo used but not configured")
+}
@@ -8,7 +8,7 @@ import (
 type MockTaskRepository struct {
 	GetAllFunc    func() (types.TaskList, error)
 	GetFunc       func(uint32) (*types.Task, error)
-	CreateAllFunc func(types.TaskList) (types.TaskList, error)
+	CreateAllFunc func(types.TaskList, map[string]int64) (types.TaskList, error)
 	DeleteAllFunc func() error
 }
 
@@ -23,8 +23,8 @@ func (r *MockTaskRepository) Get(taskID uint32) (*types.Task, error) {
 }
 
 // CreateAll persists all tasks with a generated id for later retrieval, returns a new list of tasks with the generated ids populated if there is no error
-func (r *MockTaskRepository) CreateAll(tasks types.TaskList) (types.TaskList, error) {
-	return r.CreateAllFunc(tasks)
+func (r *MockTaskRepository) CreateAll(tasks types.TaskList, temporaryIDMapping map[string]int64) (types.TaskList, error) {
+	return r.CreateAllFunc(tasks, temporaryIDMapping)
 }
 
 // DeleteAll deletes all tasks that have been persisted, returns error if an error occurs
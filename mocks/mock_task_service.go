@@ -5,18 +5,34 @@ import "github.com/kpdowns/todoist-cli/tasks/types"
 // MockTaskService implements the TaskService interface and allows functions to be mocked
 type MockTaskService struct {
 	GetAllTasksFunctionToExecute func() (types.TaskList, error)
-	AddTaskFunctionToExecute     func(content string, due string, priority int) error
-	CompleteTaskFunc             func(uint32) error
+	GetCachedTasksFunc           func() (types.TaskList, error)
+	AddTaskFunctionToExecute     func(options types.AddTaskOptions) (int64, error)
+	QuickAddTaskFunc             func(string) (int64, error)
+	ResolveTaskFunc              func(types.TaskReference) (*types.Task, error)
+	CompleteTasksFunc            func([]uint32, types.CompleteTaskOptions) (types.TaskList, error)
+	UncompleteTasksFunc          func([]types.TaskReference) error
+	UpdateTaskFunc               func(uint32, types.TaskChanges) error
+	DeleteTasksFunc              func([]uint32) error
+	MoveTaskFunc                 func(uint32, types.MoveTaskOptions) error
+	ReorderTasksFunc             func([]uint32, types.ReorderTaskOptions) error
 }
 
 // AddTask executes the function configured in GetAllTasksFunctionToExecute
-func (s *MockTaskService) AddTask(content string, due string, priority int) error {
+func (s *MockTaskService) AddTask(options types.AddTaskOptions) (int64, error) {
 	if s.AddTaskFunctionToExecute != nil {
-		return s.AddTaskFunctionToExecute(content, due, priority)
+		return s.AddTaskFunctionToExecute(options)
 	}
 	panic("Method call AddTaskFunctionToExecute used but not configured")
 }
 
+// QuickAddTask executes the function configured in QuickAddTaskFunc
+func (s *MockTaskService) QuickAddTask(text string) (int64, error) {
+	if s.QuickAddTaskFunc != nil {
+		return s.QuickAddTaskFunc(text)
+	}
+	panic("Method call QuickAddTask used but not configured")
+}
+
 // GetAllTasks executes the function configured in AddTaskFunctionToExecute
 func (s *MockTaskService) GetAllTasks() (types.TaskList, error) {
 	if s.GetAllTasksFunctionToExecute != nil {
@@ -25,10 +41,66 @@ func (s *MockTaskService) GetAllTasks() (types.TaskList, error) {
 	panic("Method call GetAllTasksFunctionToExecute used but not configured")
 }
 
-// CompleteTask executes the function configured in CompleteTaskFunc
-func (s *MockTaskService) CompleteTask(taskID uint32) error {
-	if s.CompleteTaskFunc != nil {
-		return s.CompleteTaskFunc(taskID)
+// GetCachedTasks executes the function configured in GetCachedTasksFunc
+func (s *MockTaskService) GetCachedTasks() (types.TaskList, error) {
+	if s.GetCachedTasksFunc != nil {
+		return s.GetCachedTasksFunc()
+	}
+	panic("Method call GetCachedTasks used but not configured")
+}
+
+// CompleteTasks executes the function configured in CompleteTasksFunc
+func (s *MockTaskService) CompleteTasks(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error) {
+	if s.CompleteTasksFunc != nil {
+		return s.CompleteTasksFunc(taskIDs, options)
+	}
+	panic("Method call CompleteTasks used but not configured")
+}
+
+// UncompleteTasks executes the function configured in UncompleteTasksFunc
+func (s *MockTaskService) UncompleteTasks(references []types.TaskReference) error {
+	if s.UncompleteTasksFunc != nil {
+		return s.UncompleteTasksFunc(references)
+	}
+	panic("Method call UncompleteTasks used but not configured")
+}
+
+// UpdateTask executes the function configured in UpdateTaskFunc
+func (s *MockTaskService) UpdateTask(taskID uint32, changes types.TaskChanges) error {
+	if s.UpdateTaskFunc != nil {
+		return s.UpdateTaskFunc(taskID, changes)
+	}
+	panic("Method call UpdateTask used but not configured")
+}
+
+// DeleteTasks executes the function configured in DeleteTasksFunc
+func (s *MockTaskService) DeleteTasks(taskIDs []uint32) error {
+	if s.DeleteTasksFunc != nil {
+		return s.DeleteTasksFunc(taskIDs)
+	}
+	panic("Method call DeleteTasks used but not configured")
+}
+
+// MoveTask executes the function configured in MoveTaskFunc
+func (s *MockTaskService) MoveTask(taskID uint32, options types.MoveTaskOptions) error {
+	if s.MoveTaskFunc != nil {
+		return s.MoveTaskFunc(taskID, options)
+	}
+	panic("Method call MoveTask used but not configured")
+}
+
+// ReorderTasks executes the function configured in ReorderTasksFunc
+func (s *MockTaskService) ReorderTasks(taskIDs []uint32, options types.ReorderTaskOptions) error {
+	if s.ReorderTasksFunc != nil {
+		return s.ReorderTasksFunc(taskIDs, options)
+	}
+	panic("Method call ReorderTasks used but not configured")
+}
+
+// ResolveTask executes the function configured in ResolveTaskFunc
+func (s *MockTaskService) ResolveTask(reference types.TaskReference) (*types.Task, error) {
+	if s.ResolveTaskFunc != nil {
+		return s.ResolveTaskFunc(reference)
 	}
-	panic("Method call CompleteTask used but not configured")
+	panic("Method call ResolveTask used but not configured")
 }
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	projectRepositories "github.com/kpdowns/todoist-cli/projects/repositories"
+	"github.com/kpdowns/todoist-cli/replica"
+	replicaTypes "github.com/kpdowns/todoist-cli/replica/types"
+	taskRepositories "github.com/kpdowns/todoist-cli/tasks/repositories"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+)
+
+const (
+	errorNotCurrentlyAuthenticated   = "Error, you are not currently logged in."
+	errorOccurredDuringSyncOperation = "Error occurred while syncing with Todoist."
+	errorNoContent                   = "The content of the comment must be provided."
+	errorNoTaskFound                 = "The requested task %d does not exist."
+	errorNoProjectFound              = "The requested project does not exist."
+	errorAttachmentWhileOffline      = "Todoist could not be reached, attachments can only be uploaded while online."
+	errorFailedToUploadAttachment    = "An error occurred while uploading the attachment to Todoist, please try again."
+	errorFailedToAddNote             = "An error occurred while adding the comment on Todoist, please try again."
+)
+
+// NoteService provides functionality to retrieve and post comments on tasks and projects on Todoist
+type NoteService interface {
+	GetTaskNotes(taskID uint32) (types.NoteList, error)
+	GetProjectNotes(projectID uint32) (types.NoteList, error)
+	AddTaskNote(taskID uint32, content string, attachment *types.Attachment) error
+	AddProjectNote(projectID uint32, content string, attachment *types.Attachment) error
+}
+
+type noteService struct {
+	api                   todoist.API
+	authenticationService authentication.Service
+	replicaService        replica.Service
+	taskRepository        taskRepositories.TaskRepository
+	projectRepository     projectRepositories.ProjectRepository
+}
+
+// NewNoteService creates a new instance of the note service
+func NewNoteService(api todoist.API, authenticationService authentication.Service, replicaService replica.Service, taskRepository taskRepositories.TaskRepository,
+	projectRepository projectRepositories.ProjectRepository) NoteService {
+	return &noteService{
+		api:                   api,
+		authenticationService: authenticationService,
+		replicaService:        replicaService,
+		taskRepository:        taskRepository,
+		projectRepository:     projectRepository,
+	}
+}
+
+// GetTaskNotes returns the comments on the task with the provided id, from the oldest to the most recently posted. Comments posted
+// on the task before it was synced refer to it by its temporary id.
+func (s *noteService) GetTaskNotes(taskID uint32) (types.NoteList, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	task, err := s.taskRepository.Get(taskID)
+	if taskRepositories.IsRetiredTask(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, failures.Newf(failures.NotFound, errorNoTaskFound, taskID)
+	}
+
+	replica, err := s.replicaService.Sync()
+	if err != nil {
+		return nil, failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	var notes []responses.Note
+	for _, note := range replica.Notes {
+		isPostedOnSyncedTask := task.TodoistID != 0 && note.ItemID == task.TodoistID
+		isPostedOnUnsyncedTask := task.TemporaryID != "" && note.ItemTemporaryID == task.TemporaryID
+		if isPostedOnSyncedTask || isPostedOnUnsyncedTask {
+			notes = append(notes, note)
+		}
+	}
+
+	return asNotes(replica, notes), nil
+}
+
+// GetProjectNotes returns the comments on the project with the provided id, from the oldest to the most recently posted. Comments posted
+// on the project before it was synced refer to it by its temporary id.
+func (s *noteService) GetProjectNotes(projectID uint32) (types.NoteList, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	project, err := s.projectRepository.Get(projectID)
+	if err != nil {
+		return nil, failures.New(failures.NotFound, errorNoProjectFound)
+	}
+
+	replica, err := s.replicaService.Sync()
+	if err != nil {
+		return nil, failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	var notes []responses.Note
+	for _, note := range replica.ProjectNotes {
+		isPostedOnSyncedProject := project.TodoistID != 0 && note.ProjectID == project.TodoistID
+		isPostedOnUnsyncedProject := project.TemporaryID != "" && note.ProjectTemporaryID == project.TemporaryID
+		if isPostedOnSyncedProject || isPostedOnUnsyncedProject {
+			notes = append(notes, note)
+		}
+	}
+
+	return asNotes(replica, notes), nil
+}
+
+// asNotes converts the notes into domain notes sorted by the time they were posted
+func asNotes(replica *replicaTypes.Replica, todoistNotes []responses.Note) types.NoteList {
+	location := replica.UserLocation()
+	collaboratorNames := replica.CollaboratorNames()
+
+	var notes types.NoteList
+	for _, todoistNote := range todoistNotes {
+		notes = append(notes, todoistNote.ToNote(location, collaboratorNames))
+	}
+
+	return notes.SortByPosted()
+}
+
+// AddTaskNote posts a comment on the task with the provided id. The attachment, when provided, is uploaded to Todoist before the comment
+// is posted, so comments with an attachment cannot be posted while offline.
+func (s *noteService) AddTaskNote(taskID uint32, content string, attachment *types.Attachment) error {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return failures.New(failures.Validation, errorNoContent)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	task, err := s.taskRepository.Get(taskID)
+	if taskRepositories.IsRetiredTask(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Newf(failures.NotFound, errorNoTaskFound, taskID)
+	}
+
+	arguments := make(map[string]interface{})
+	arguments["item_id"] = task.TodoistReference()
+	arguments["content"] = content
+
+	return s.addNote(arguments, attachment)
+}
+
+// AddProjectNote posts a comment on the project with the provided id. The attachment, when provided, is uploaded to Todoist before the
+// comment is posted, so comments with an attachment cannot be posted while offline.
+func (s *noteService) AddProjectNote(projectID uint32, content string, attachment *types.Attachment) error {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return failures.New(failures.Validation, errorNoContent)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	project, err := s.projectRepository.Get(projectID)
+	if err != nil {
+		return failures.New(failures.NotFound, errorNoProjectFound)
+	}
+
+	arguments := make(map[string]interface{})
+	arguments["project_id"] = project.TodoistReference()
+	arguments["content"] = content
+
+	return s.addNote(arguments, attachment)
+}
+
+// addNote uploads the attachment, if any, and sends the note_add command with the provided arguments
+func (s *noteService) addNote(arguments map[string]interface{}, attachment *types.Attachment) error {
+	accessToken, _ := s.authenticationService.GetAccessToken()
+
+	if attachment != nil {
+		upload := requests.NewUpload(accessToken.AccessToken, attachment.FileName, attachment.Contents)
+		fileAttachment, err := s.api.UploadFile(upload)
+		if err == todoist.ErrUnreachable {
+			return failures.Wrap(err, errorAttachmentWhileOffline)
+		}
+		if err != nil {
+			return failures.Wrap(err, errorFailedToUploadAttachment)
+		}
+		arguments["file_attachment"] = fileAttachment
+	}
+
+	command := requests.NewCommand(accessToken.AccessToken, commands.NoteAdd, arguments)
+	_, err := s.replicaService.ExecuteCommand(command)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToAddNote)
+	}
+
+	return warning
+}
@@ -0,0 +1,379 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/notes/types"
+	projectTypes "github.com/kpdowns/todoist-cli/projects/types"
+	replicaTypes "github.com/kpdowns/todoist-cli/replica/types"
+	taskTypes "github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTaskRepository(tasks ...taskTypes.Task) *mocks.MockTaskRepository {
+	return &mocks.MockTaskRepository{
+		GetFunc: func(taskID uint32) (*taskTypes.Task, error) {
+			for _, task := range tasks {
+				if task.ID == taskID {
+					return &task, nil
+				}
+			}
+			return nil, errors.New("not found")
+		},
+	}
+}
+
+func newProjectRepository(projects ...projectTypes.Project) *mocks.MockProjectRepository {
+	return &mocks.MockProjectRepository{
+		GetFunc: func(projectID uint32) (*projectTypes.Project, error) {
+			for _, project := range projects {
+				if project.ID == projectID {
+					return &project, nil
+				}
+			}
+			return nil, errors.New("not found")
+		},
+	}
+}
+
+func TestGettingNotes(t *testing.T) {
+
+	syncedReplica := &replicaTypes.Replica{
+		User:          &responses.User{TodoistID: 1, FullName: "Sam"},
+		Collaborators: []responses.Collaborator{{TodoistID: 2, FullName: "Alex"}},
+		Notes: []responses.Note{
+			{TodoistID: 101, ItemID: 10, PostedUID: 1, Content: "Quote received", Posted: "2020-04-11T09:00:00Z"},
+			{TodoistID: 100, ItemID: 10, PostedUID: 2, Content: "Called the plumber", Posted: "2020-04-10T09:00:00Z"},
+			{TodoistID: 102, ItemID: 11, PostedUID: 1, Content: "Another task", Posted: "2020-04-10T09:00:00Z"},
+		},
+		ProjectNotes: []responses.Note{
+			{TodoistID: 200, ProjectID: 20, PostedUID: 2, Content: "Kick-off on Monday", Posted: "2020-04-09T09:00:00Z"},
+			{TodoistID: 201, ProjectID: 21, PostedUID: 2, Content: "Another project", Posted: "2020-04-09T09:00:00Z"},
+		},
+	}
+
+	t.Run("When getting the notes of a task and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, nil, nil)
+
+		_, err := noteService.GetTaskNotes(1)
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+		assert.Equal(t, failures.NotAuthenticated, failures.KindOf(err))
+
+	})
+
+	t.Run("When getting the notes of a task that does not exist, then a not found error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, newTaskRepository(), nil)
+
+		_, err := noteService.GetTaskNotes(1)
+		assert.Equal(t, "The requested task 1 does not exist.", err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When getting the notes of a task and an error occurs while syncing, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, newTaskRepository(taskTypes.Task{ID: 1, TodoistID: 10}), nil)
+
+		_, err := noteService.GetTaskNotes(1)
+		assert.Equal(t, errorOccurredDuringSyncOperation, err.Error())
+
+	})
+
+	t.Run("When getting the notes of a task, then the notes on the task are sorted by the time they were posted", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return syncedReplica, nil
+			},
+		}
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, newTaskRepository(taskTypes.Task{ID: 1, TodoistID: 10}), nil)
+
+		returnedNotes, err := noteService.GetTaskNotes(1)
+		assert.Nil(t, err)
+
+		location := syncedReplica.UserLocation()
+		assert.Equal(t, types.NoteList{
+			{TodoistID: 100, Content: "Called the plumber", PostedBy: "Alex", Posted: time.Date(2020, 4, 10, 9, 0, 0, 0, time.UTC).In(location)},
+			{TodoistID: 101, Content: "Quote received", PostedBy: "Sam", Posted: time.Date(2020, 4, 11, 9, 0, 0, 0, time.UTC).In(location)},
+		}, returnedNotes)
+
+	})
+
+	t.Run("When getting the notes of a task that has not been synced yet, then the notes posted on it while offline are returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return &replicaTypes.Replica{Notes: []responses.Note{
+					{TemporaryID: "temp-2", ItemTemporaryID: "temp-1", Content: "Called the plumber"},
+					{TemporaryID: "temp-4", ItemTemporaryID: "temp-3", Content: "Quote received"},
+					{TodoistID: 100, Content: "Bought milk"},
+				}}, nil
+			},
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, newTaskRepository(taskTypes.Task{ID: 1, TemporaryID: "temp-1"}), nil)
+
+		returnedNotes, err := noteService.GetTaskNotes(1)
+		assert.Nil(t, err)
+		assert.Len(t, returnedNotes, 1)
+		assert.Equal(t, "Called the plumber", returnedNotes[0].Content)
+
+	})
+
+	t.Run("When getting the notes of a project that does not exist, then a not found error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, nil, newProjectRepository())
+
+		_, err := noteService.GetProjectNotes(1)
+		assert.Equal(t, errorNoProjectFound, err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When getting the notes of a project, then only the project notes on the project are returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return syncedReplica, nil
+			},
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, nil, newProjectRepository(projectTypes.Project{ID: 1, TodoistID: 20}))
+
+		returnedNotes, err := noteService.GetProjectNotes(1)
+		assert.Nil(t, err)
+		if assert.Len(t, returnedNotes, 1) {
+			assert.Equal(t, int64(200), returnedNotes[0].TodoistID)
+			assert.Equal(t, "Kick-off on Monday", returnedNotes[0].Content)
+		}
+
+	})
+
+	t.Run("When getting the notes of a project that has not been synced yet, then the notes posted on it while offline are returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return &replicaTypes.Replica{ProjectNotes: []responses.Note{
+					{TemporaryID: "temp-2", ProjectTemporaryID: "temp-1", Content: "Kick-off on Monday"},
+					{TemporaryID: "temp-4", ProjectTemporaryID: "temp-3", Content: "Another project"},
+				}}, nil
+			},
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, nil, newProjectRepository(projectTypes.Project{ID: 1, TemporaryID: "temp-1"}))
+
+		returnedNotes, err := noteService.GetProjectNotes(1)
+		assert.Nil(t, err)
+		if assert.Len(t, returnedNotes, 1) {
+			assert.Equal(t, "Kick-off on Monday", returnedNotes[0].Content)
+		}
+
+	})
+
+}
+
+func TestAddingNotes(t *testing.T) {
+
+	t.Run("When adding a note without content, then a validation error is returned", func(t *testing.T) {
+
+		noteService := NewNoteService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}, &mocks.MockReplicaService{}, nil, nil)
+
+		err := noteService.AddTaskNote(1, "  ", nil)
+		assert.Equal(t, errorNoContent, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When adding a note on a task that does not exist, then a not found error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, newTaskRepository(), nil)
+
+		err := noteService.AddTaskNote(1, "Called the plumber", nil)
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When adding a note on a task, then a note add command referencing the task is executed", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			ExecuteCommandFunc: func(command requests.Command) (map[string]int64, error) {
+				executedCommand = command
+				return nil, nil
+			},
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, newTaskRepository(taskTypes.Task{ID: 1, TemporaryID: "temp-1"}), nil)
+
+		err := noteService.AddTaskNote(1, "Called the plumber", nil)
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, commands.NoteAdd, executedCommand.Commands[0].Type)
+			assert.Equal(t, map[string]interface{}{"item_id": "temp-1", "content": "Called the plumber"}, executedCommand.Commands[0].Arguments)
+			assert.NotEmpty(t, executedCommand.Commands[0].TemporaryID)
+		}
+
+	})
+
+	t.Run("When adding a note on a project that does not exist, then a not found error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, nil, newProjectRepository())
+
+		err := noteService.AddProjectNote(1, "Kick-off on Monday", nil)
+		assert.Equal(t, errorNoProjectFound, err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When adding a note on a project, then a note add command referencing the project is executed", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			ExecuteCommandFunc: func(command requests.Command) (map[string]int64, error) {
+				executedCommand = command
+				return nil, nil
+			},
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, nil, newProjectRepository(projectTypes.Project{ID: 1, TodoistID: 20}))
+
+		err := noteService.AddProjectNote(1, " Kick-off on Monday ", nil)
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, commands.NoteAdd, executedCommand.Commands[0].Type)
+			assert.Equal(t, map[string]interface{}{"project_id": int64(20), "content": "Kick-off on Monday"}, executedCommand.Commands[0].Arguments)
+		}
+
+	})
+
+	t.Run("When adding a note with an attachment, then the attachment is uploaded and attached to the note", func(t *testing.T) {
+
+		var uploaded requests.Upload
+		var executedCommand requests.Command
+		fileAttachment := &responses.FileAttachment{FileName: "quote.pdf", FileURL: "https://example.com/quote.pdf", UploadState: "completed"}
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+			AccessTokenToReturn:        "token",
+		}
+		mockAPI := &mocks.MockAPI{
+			UploadFileFunction: func(upload requests.Upload) (*responses.FileAttachment, error) {
+				uploaded = upload
+				return fileAttachment, nil
+			},
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			ExecuteCommandFunc: func(command requests.Command) (map[string]int64, error) {
+				executedCommand = command
+				return nil, nil
+			},
+		}
+
+		noteService := NewNoteService(mockAPI, mockAuthenticationService, mockReplicaService, newTaskRepository(taskTypes.Task{ID: 1, TodoistID: 10}), nil)
+
+		err := noteService.AddTaskNote(1, "Quote", &types.Attachment{FileName: "quote.pdf", Contents: []byte("contents")})
+		assert.Nil(t, err)
+		assert.Equal(t, requests.NewUpload("token", "quote.pdf", []byte("contents")), uploaded)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, fileAttachment, executedCommand.Commands[0].Arguments["file_attachment"])
+			assert.Equal(t, int64(10), executedCommand.Commands[0].Arguments["item_id"])
+		}
+
+	})
+
+	t.Run("When adding a note with an attachment while Todoist cannot be reached, then a network error is returned and no note is added", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			UploadFileFunction: func(upload requests.Upload) (*responses.FileAttachment, error) {
+				return nil, todoist.ErrUnreachable
+			},
+		}
+
+		noteService := NewNoteService(mockAPI, mockAuthenticationService, &mocks.MockReplicaService{}, newTaskRepository(taskTypes.Task{ID: 1, TodoistID: 10}), nil)
+
+		err := noteService.AddTaskNote(1, "Quote", &types.Attachment{FileName: "quote.pdf"})
+		assert.Equal(t, errorAttachmentWhileOffline, err.Error())
+		assert.Equal(t, failures.Network, failures.KindOf(err))
+
+	})
+
+	t.Run("When adding a note and Todoist rejects the command, then the rejection is returned", func(t *testing.T) {
+
+		rejection := todoist.CommandErrors{&todoist.CommandError{Code: 22, Message: "Item not found"}}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			ExecuteCommandFunc: func(command requests.Command) (map[string]int64, error) {
+				return nil, rejection
+			},
+		}
+
+		noteService := NewNoteService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, newTaskRepository(taskTypes.Task{ID: 1, TodoistID: 10}), nil)
+
+		err := noteService.AddTaskNote(1, "Called the plumber", nil)
+		assert.Equal(t, rejection, err)
+
+	})
+
+}
@@ -0,0 +1,7 @@
+package types
+
+// Attachment is a file to upload and attach to a note
+type Attachment struct {
+	FileName string
+	Contents []byte
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+// Note is a comment posted on a task or a project
+type Note struct {
+	TodoistID      int64
+	TemporaryID    string
+	Content        string
+	Posted         time.Time
+	PostedBy       string
+	AttachmentName string
+	AttachmentURL  string
+}
+
+// AsString returns a tab delimited string representing the note, notes that have not been synced yet have no posted time
+func (n *Note) AsString() string {
+	contentString := n.Content
+	if n.HasAttachment() {
+		contentString += " " + color.BlueString("(%s: %s)", n.AttachmentName, n.AttachmentURL)
+	}
+
+	return fmt.Sprintf("%s\t%s\t%s",
+		color.HiBlackString(n.displayedPosted()),
+		color.CyanString(n.PostedBy),
+		contentString,
+	)
+}
+
+// HasAttachment returns true if a file is attached to the note
+func (n *Note) HasAttachment() bool {
+	return n.AttachmentName != "" || n.AttachmentURL != ""
+}
+
+// AsRecord returns the fields of the note written in machine readable output
+func (n *Note) AsRecord() output.Record {
+	return output.Record{
+		{Name: "todoist_id", Value: n.TodoistID},
+		{Name: "posted", Value: n.postedString()},
+		{Name: "posted_by", Value: n.PostedBy},
+		{Name: "content", Value: n.Content},
+		{Name: "attachment_name", Value: n.AttachmentName},
+		{Name: "attachment_url", Value: n.AttachmentURL},
+	}
+}
+
+// displayedPosted returns the time the note was posted as it is shown to people
+func (n *Note) displayedPosted() string {
+	if n.Posted.IsZero() {
+		return ""
+	}
+	return n.Posted.Format("2006-01-02 15:04")
+}
+
+// postedString returns the time the note was posted in machine readable output
+func (n *Note) postedString() string {
+	if n.Posted.IsZero() {
+		return ""
+	}
+	return n.Posted.Format(time.RFC3339)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+// NoteList is a list of unordered notes
+type NoteList []Note
+
+// SortByPosted sorts the notes from the oldest to the most recently posted, notes that have not been synced yet are last. Returns a new slice of notes.
+func (l NoteList) SortByPosted() NoteList {
+	sortedNotes := make(NoteList, len(l))
+	copy(sortedNotes, l)
+	sort.Stable(sortedNotes)
+	return sortedNotes
+}
+
+// Len returns the length of the NoteList
+func (l NoteList) Len() int { return len(l) }
+
+// Less returns true if the note was posted before the one being compared
+func (l NoteList) Less(i, j int) bool {
+	if l[i].Posted.IsZero() || l[j].Posted.IsZero() {
+		return !l[i].Posted.IsZero() && l[j].Posted.IsZero()
+	}
+	return l[i].Posted.Before(l[j].Posted)
+}
+
+// Swap swaps two different notes in the slice
+func (l NoteList) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
+
+// AsRecords returns the notes as records written in machine readable output
+func (l NoteList) AsRecords() []output.Record {
+	records := make([]output.Record, len(l))
+	for index := range l {
+		records[index] = l[index].AsRecord()
+	}
+	return records
+}
+
+// RecordColumns returns the names of the fields of a note in machine readable output
+func RecordColumns() []string {
+	return (&Note{}).AsRecord().Names()
+}
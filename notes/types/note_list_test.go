@@ -0,0 +1,23 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGivenListOfNotesWhenSortingNotesThenListOfNotesIsOrderedByPostedWithUnsyncedNotesLast(t *testing.T) {
+	notes := NoteList{
+		Note{TemporaryID: "temp-1"},
+		Note{TodoistID: 2, Posted: time.Date(2020, 4, 11, 0, 0, 0, 0, time.UTC)},
+		Note{TodoistID: 1, Posted: time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	sortedNotes := notes.SortByPosted()
+	assert.Equal(t, int64(1), sortedNotes[0].TodoistID)
+	assert.Equal(t, int64(2), sortedNotes[1].TodoistID)
+	assert.Equal(t, "temp-1", sortedNotes[2].TemporaryID)
+
+	assert.Equal(t, "temp-1", notes[0].TemporaryID)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+func TestGivenANoteWhenConvertingToStringThenThePostedTimeAuthorAndContentAreShown(t *testing.T) {
+	note := Note{Content: "Called the plumber", Posted: time.Date(2020, 4, 10, 9, 30, 0, 0, time.UTC), PostedBy: "Alice"}
+
+	stringRepresentation := note.AsString()
+	if stringRepresentation != "2020-04-10 09:30\tAlice\tCalled the plumber" {
+		t.Errorf("Expected '2020-04-10 09:30\tAlice\tCalled the plumber', got '%s'", stringRepresentation)
+	}
+}
+
+func TestGivenANoteWithAnAttachmentWhenConvertingToStringThenTheAttachmentIsShownAfterTheContent(t *testing.T) {
+	note := Note{Content: "Quote", AttachmentName: "quote.pdf", AttachmentURL: "https://example.com/quote.pdf"}
+
+	stringRepresentation := note.AsString()
+	if !strings.HasSuffix(stringRepresentation, "Quote (quote.pdf: https://example.com/quote.pdf)") {
+		t.Errorf("Expected the attachment to be shown, got '%s'", stringRepresentation)
+	}
+}
+
+func TestGivenANoteWhenConvertingToARecordThenTheFieldsHaveStableNames(t *testing.T) {
+	note := Note{
+		TodoistID:      10,
+		Content:        "Quote",
+		Posted:         time.Date(2020, 4, 10, 9, 30, 0, 0, time.UTC),
+		PostedBy:       "Alice",
+		AttachmentName: "quote.pdf",
+		AttachmentURL:  "https://example.com/quote.pdf",
+	}
+
+	expectedRecord := output.Record{
+		{Name: "todoist_id", Value: int64(10)},
+		{Name: "posted", Value: "2020-04-10T09:30:00Z"},
+		{Name: "posted_by", Value: "Alice"},
+		{Name: "content", Value: "Quote"},
+		{Name: "attachment_name", Value: "quote.pdf"},
+		{Name: "attachment_url", Value: "https://example.com/quote.pdf"},
+	}
+
+	record := note.AsRecord()
+	if !reflect.DeepEqual(expectedRecord, record) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord, record)
+	}
+
+	if !reflect.DeepEqual(expectedRecord.Names(), RecordColumns()) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord.Names(), RecordColumns())
+	}
+}
@@ -0,0 +1,119 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Formatter renders records in a machine readable format
+type Formatter interface {
+	// FormatList renders a list of records, the columns are provided so that an empty list can still be described
+	FormatList(w io.Writer, columns []string, records []Record) error
+
+	// FormatRecord renders a single record, such as the result of a command
+	FormatRecord(w io.Writer, record Record) error
+}
+
+var formatters = map[string]Formatter{
+	"json": &jsonFormatter{},
+	"yaml": &yamlFormatter{},
+	"csv":  &delimitedFormatter{separator: ','},
+	"tsv":  &delimitedFormatter{separator: '\t'},
+}
+
+// Formats returns the names of all available formats, including the default text format
+func Formats() []string {
+	formats := []string{Text}
+	for format := range formatters {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats[1:])
+	return formats
+}
+
+type jsonFormatter struct{}
+
+func (f *jsonFormatter) FormatList(w io.Writer, columns []string, records []Record) error {
+	if records == nil {
+		records = []Record{}
+	}
+	return f.encode(w, records)
+}
+
+func (f *jsonFormatter) FormatRecord(w io.Writer, record Record) error {
+	return f.encode(w, record)
+}
+
+func (f *jsonFormatter) encode(w io.Writer, value interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+type yamlFormatter struct{}
+
+func (f *yamlFormatter) FormatList(w io.Writer, columns []string, records []Record) error {
+	if records == nil {
+		records = []Record{}
+	}
+	return f.encode(w, records)
+}
+
+func (f *yamlFormatter) FormatRecord(w io.Writer, record Record) error {
+	return f.encode(w, record)
+}
+
+func (f *yamlFormatter) encode(w io.Writer, value interface{}) error {
+	contents, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(contents)
+	return err
+}
+
+type delimitedFormatter struct {
+	separator rune
+}
+
+func (f *delimitedFormatter) FormatList(w io.Writer, columns []string, records []Record) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = f.separator
+
+	writer.Write(columns)
+	for _, record := range records {
+		row := make([]string, len(record))
+		for index, field := range record {
+			row[index] = formatValue(field.Value)
+		}
+		writer.Write(row)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (f *delimitedFormatter) FormatRecord(w io.Writer, record Record) error {
+	return f.FormatList(w, record.Names(), []Record{record})
+}
+
+// formatValue converts a value to a single cell, values that are not scalars are written as JSON
+func formatValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array, reflect.Struct:
+		contents, _ := json.Marshal(value)
+		return string(contents)
+	}
+
+	return fmt.Sprint(value)
+}
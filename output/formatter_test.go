@@ -0,0 +1,58 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormattingRecords(t *testing.T) {
+
+	columns := []string{"id", "content", "args"}
+	records := []Record{
+		{{Name: "id", Value: 1}, {Name: "content", Value: "first, with comma"}, {Name: "args", Value: map[string]interface{}{"id": 1}}},
+		{{Name: "id", Value: 2}, {Name: "content", Value: "second"}, {Name: "args", Value: nil}},
+	}
+
+	var listsToTest = []struct {
+		format         string
+		records        []Record
+		expectedOutput string
+	}{
+		{"json", records, "[\n  {\n    \"id\": 1,\n    \"content\": \"first, with comma\",\n    \"args\": {\n      \"id\": 1\n    }\n  },\n  {\n    \"id\": 2,\n    \"content\": \"second\",\n    \"args\": null\n  }\n]\n"},
+		{"json", nil, "[]\n"},
+		{"yaml", records, "- id: 1\n  content: first, with comma\n  args:\n    id: 1\n- id: 2\n  content: second\n  args: null\n"},
+		{"yaml", nil, "[]\n"},
+		{"csv", records, "id,content,args\n1,\"first, with comma\",\"{\"\"id\"\":1}\"\n2,second,\n"},
+		{"csv", nil, "id,content,args\n"},
+		{"tsv", records, "id\tcontent\targs\n1\tfirst, with comma\t\"{\"\"id\"\":1}\"\n2\tsecond\t\n"},
+	}
+
+	for _, listToTest := range listsToTest {
+		listToTest := listToTest
+
+		t.Run("Given a list of records, when formatting it as "+listToTest.format+", then every record is written", func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+
+			err := formatters[listToTest.format].FormatList(buffer, columns, listToTest.records)
+
+			assert.Nil(t, err)
+			assert.Equal(t, listToTest.expectedOutput, buffer.String())
+		})
+	}
+
+	t.Run("Given a single record, when formatting it as CSV, then a header and a single row are written", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+
+		err := formatters["csv"].FormatRecord(buffer, Record{{Name: "status", Value: "ok"}, {Name: "message", Value: "done"}})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "status,message\nok,done\n", buffer.String())
+	})
+
+}
+
+func TestListingFormats(t *testing.T) {
+	assert.Equal(t, []string{Text, "csv", "json", "tsv", "yaml"}, Formats())
+}
@@ -0,0 +1,61 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Field is a named value of a record, names are part of the output contract and must not change
+type Field struct {
+	Name  string
+	Value interface{}
+}
+
+// Record is an ordered set of fields describing a single resource or command result
+type Record []Field
+
+// Names returns the names of the fields in order
+func (r Record) Names() []string {
+	names := make([]string, len(r))
+	for index, field := range r {
+		names[index] = field.Name
+	}
+	return names
+}
+
+// MarshalJSON encodes the record as a JSON object keeping the order of the fields
+func (r Record) MarshalJSON() ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	buffer.WriteString("{")
+	for index, field := range r {
+		if index > 0 {
+			buffer.WriteString(",")
+		}
+
+		name, err := json.Marshal(field.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(name)
+		buffer.WriteString(":")
+		buffer.Write(value)
+	}
+	buffer.WriteString("}")
+	return buffer.Bytes(), nil
+}
+
+// MarshalYAML encodes the record as a YAML mapping keeping the order of the fields
+func (r Record) MarshalYAML() (interface{}, error) {
+	mapping := make(yaml.MapSlice, len(r))
+	for index, field := range r {
+		mapping[index] = yaml.MapItem{Key: field.Name, Value: field.Value}
+	}
+	return mapping, nil
+}
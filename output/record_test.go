@@ -0,0 +1,37 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestEncodingRecords(t *testing.T) {
+
+	record := Record{
+		{Name: "id", Value: 2},
+		{Name: "content", Value: "test"},
+		{Name: "completed", Value: false},
+	}
+
+	t.Run("Given a record, when encoding it as JSON, then the fields keep their order", func(t *testing.T) {
+		contents, err := json.Marshal(record)
+
+		assert.Nil(t, err)
+		assert.Equal(t, `{"id":2,"content":"test","completed":false}`, string(contents))
+	})
+
+	t.Run("Given a record, when encoding it as YAML, then the fields keep their order", func(t *testing.T) {
+		contents, err := yaml.Marshal(record)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id: 2\ncontent: test\ncompleted: false\n", string(contents))
+	})
+
+	t.Run("Given a record, when retrieving the names of the fields, then they are returned in order", func(t *testing.T) {
+		assert.Equal(t, []string{"id", "content", "completed"}, record.Names())
+	})
+
+}
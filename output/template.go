@@ -0,0 +1,103 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+const (
+	errorInvalidTemplate = "Error, the format is not a valid template: %s"
+	errorUnknownColor    = "'%s' is not a supported color, the supported colors are black, red, green, yellow, blue, magenta, cyan, white and bold"
+)
+
+var colors = map[string]color.Attribute{
+	"black":   color.FgBlack,
+	"red":     color.FgRed,
+	"green":   color.FgGreen,
+	"yellow":  color.FgYellow,
+	"blue":    color.FgBlue,
+	"magenta": color.FgMagenta,
+	"cyan":    color.FgCyan,
+	"white":   color.FgWhite,
+	"bold":    color.Bold,
+}
+
+// now is replaced in tests so that relative dates are predictable
+var now = time.Now
+
+// NewTemplate parses the template provided using the --format flag. When the format is the name of a template defined
+// in the configuration file, that template is used instead. The funcs are made available in addition to the helpers
+// relative, color and truncate.
+func NewTemplate(w io.Writer, format string, funcs template.FuncMap) (*template.Template, error) {
+	if writer, ok := w.(*Writer); ok {
+		if namedTemplate, ok := writer.templates[format]; ok {
+			format = namedTemplate
+		}
+	}
+
+	parsedTemplate, err := template.New("format").
+		Funcs(template.FuncMap{
+			"relative": relative,
+			"color":    colorize,
+			"truncate": truncate,
+		}).
+		Funcs(funcs).
+		Parse(format)
+	if err != nil {
+		return nil, failures.Newf(failures.Validation, errorInvalidTemplate, err.Error())
+	}
+
+	return parsedTemplate, nil
+}
+
+// relative describes a date relative to today, such as "tomorrow" or "3 days ago". Dates that are not set are empty.
+func relative(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+
+	today := now()
+	startOfToday := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	startOfDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(startOfDate.Sub(startOfToday).Hours() / 24)
+
+	switch {
+	case days == 0:
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	case days == -1:
+		return "yesterday"
+	case days > 1:
+		return fmt.Sprintf("in %d days", days)
+	default:
+		return fmt.Sprintf("%d days ago", -days)
+	}
+}
+
+// colorize writes the text in the named color, nothing is added when color is disabled
+func colorize(name string, text interface{}) (string, error) {
+	attribute, ok := colors[strings.ToLower(name)]
+	if !ok {
+		return "", failures.Newf(failures.Validation, errorUnknownColor, name)
+	}
+	return color.New(attribute).Sprint(text), nil
+}
+
+// truncate shortens the text to at most length characters, an ellipsis marks text that has been shortened
+func truncate(length int, text string) string {
+	characters := []rune(text)
+	if length <= 0 {
+		return ""
+	}
+	if len(characters) <= length {
+		return text
+	}
+	return string(characters[:length-1]) + "…"
+}
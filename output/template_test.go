@@ -0,0 +1,85 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingTemplates(t *testing.T) {
+
+	t.Run("Given a template, when executing it, then the fields of the data are written", func(t *testing.T) {
+		parsedTemplate, err := NewTemplate(&bytes.Buffer{}, "{{.ID}} {{.Content}}", nil)
+
+		buffer := &bytes.Buffer{}
+		parsedTemplate.Execute(buffer, struct {
+			ID      int
+			Content string
+		}{1, "test"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "1 test", buffer.String())
+	})
+
+	t.Run("Given the name of a template defined in the configuration, when parsing it, then the named template is used", func(t *testing.T) {
+		writer := NewWriter(&bytes.Buffer{})
+		writer.SetTemplates(map[string]string{"short": "{{.}}!"})
+
+		parsedTemplate, _ := NewTemplate(writer, "short", nil)
+
+		buffer := &bytes.Buffer{}
+		parsedTemplate.Execute(buffer, "test")
+		assert.Equal(t, "test!", buffer.String())
+	})
+
+	t.Run("Given additional functions, when executing the template, then the functions are available", func(t *testing.T) {
+		parsedTemplate, _ := NewTemplate(&bytes.Buffer{}, "{{shout .}}", template.FuncMap{"shout": strings.ToUpper})
+
+		buffer := &bytes.Buffer{}
+		parsedTemplate.Execute(buffer, "test")
+		assert.Equal(t, "TEST", buffer.String())
+	})
+
+	t.Run("Given a template that is not valid, when parsing it, then an error is returned", func(t *testing.T) {
+		_, err := NewTemplate(&bytes.Buffer{}, "{{.ID", nil)
+
+		if assert.NotNil(t, err) {
+			assert.True(t, strings.HasPrefix(err.Error(), "Error, the format is not a valid template"))
+		}
+	})
+
+}
+
+func TestTemplateHelpers(t *testing.T) {
+	now = func() time.Time { return time.Date(2020, 5, 10, 18, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	t.Run("Given dates, when describing them relative to today, then the number of days is described", func(t *testing.T) {
+		assert.Equal(t, "", relative(time.Time{}))
+		assert.Equal(t, "today", relative(time.Date(2020, 5, 10, 9, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "tomorrow", relative(time.Date(2020, 5, 11, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "yesterday", relative(time.Date(2020, 5, 9, 23, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "in 22 days", relative(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)))
+		assert.Equal(t, "3 days ago", relative(time.Date(2020, 5, 7, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("Given text, when truncating it, then text longer than the length ends with an ellipsis", func(t *testing.T) {
+		assert.Equal(t, "short", truncate(10, "short"))
+		assert.Equal(t, "a lo…", truncate(5, "a longer text"))
+		assert.Equal(t, "", truncate(0, "text"))
+	})
+
+	t.Run("Given a color that is not supported, when coloring text, then an error is returned", func(t *testing.T) {
+		_, err := colorize("plaid", "text")
+		assert.NotNil(t, err)
+
+		colored, err := colorize("Red", "text")
+		assert.Nil(t, err)
+		assert.Contains(t, colored, "text")
+	})
+
+}
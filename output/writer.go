@@ -0,0 +1,118 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+// Text is the default format, intended to be read by people rather than scripts
+const Text = "text"
+
+const (
+	errorUnsupportedFormat = "Error, '%s' is not a supported output format, the supported formats are %s"
+
+	statusSucceeded = "ok"
+	statusFailed    = "error"
+	statusWarning   = "warning"
+)
+
+// Writer is the output stream of the commands, it remembers the format selected using the --output flag
+type Writer struct {
+	io.Writer
+	format    string
+	templates map[string]string
+}
+
+// NewWriter creates a writer that writes text to the output stream until another format is selected
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		Writer: w,
+		format: Text,
+	}
+}
+
+// SetFormat selects the format that output is written in
+func (w *Writer) SetFormat(format string) error {
+	if _, ok := formatters[format]; !ok && format != Text {
+		return failures.Newf(failures.Validation, errorUnsupportedFormat, format, strings.Join(Formats(), ", "))
+	}
+
+	w.format = format
+	return nil
+}
+
+// SetTemplates provides the named templates that can be used in place of a template passed to --format
+func (w *Writer) SetTemplates(templates map[string]string) {
+	w.templates = templates
+}
+
+// IsText returns true if the output is text, either because no other format was selected or because the output stream is not a writer
+func IsText(w io.Writer) bool {
+	return formatterFor(w) == nil
+}
+
+// WriteList writes records using the selected format, writeText is used instead when the output is text
+func WriteList(w io.Writer, columns []string, records []Record, writeText func()) error {
+	formatter := formatterFor(w)
+	if formatter == nil {
+		writeText()
+		return nil
+	}
+
+	return formatter.FormatList(w, columns, records)
+}
+
+// WriteRows writes count rows of tab separated columns aligned with each other as the text of a list, row returns the row at the index.
+// The message is written instead when there are no rows.
+func WriteRows(w io.Writer, emptyMessage string, count int, row func(index int) string) {
+	if count == 0 {
+		fmt.Fprint(w, emptyMessage)
+		return
+	}
+
+	writer := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+	for index := 0; index < count; index++ {
+		fmt.Fprintln(writer, row(index))
+	}
+	writer.Flush()
+}
+
+// WriteMessage writes the message describing the result of a successful command, the fields provide details for scripts
+func WriteMessage(w io.Writer, message string, fields ...Field) {
+	formatter := formatterFor(w)
+	if formatter == nil {
+		fmt.Fprint(w, message)
+		return
+	}
+
+	record := Record{{"status", statusSucceeded}, {"message", message}}
+	formatter.FormatRecord(w, append(record, fields...))
+}
+
+// WriteError writes the error that caused a command to fail, or the warning reported by a command that succeeded
+func WriteError(w io.Writer, err error) {
+	formatter := formatterFor(w)
+	if formatter == nil {
+		fmt.Fprint(w, err.Error())
+		return
+	}
+
+	status := statusFailed
+	if failures.IsWarning(err) {
+		status = statusWarning
+	}
+	formatter.FormatRecord(w, Record{{"status", status}, {"message", err.Error()}})
+}
+
+// formatterFor returns the formatter of the selected format, or nil if text should be written
+func formatterFor(w io.Writer) Formatter {
+	writer, ok := w.(*Writer)
+	if !ok || writer.format == Text {
+		return nil
+	}
+	return formatters[writer.format]
+}
@@ -0,0 +1,130 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectingFormats(t *testing.T) {
+
+	t.Run("Given a supported format, when selecting it, then no error is returned", func(t *testing.T) {
+		writer := NewWriter(&bytes.Buffer{})
+
+		for _, format := range Formats() {
+			assert.Nil(t, writer.SetFormat(format))
+		}
+	})
+
+	t.Run("Given an unsupported format, when selecting it, then an error listing the supported formats is returned", func(t *testing.T) {
+		writer := NewWriter(&bytes.Buffer{})
+
+		err := writer.SetFormat("xml")
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, fmt.Sprintf(errorUnsupportedFormat, "xml", strings.Join(Formats(), ", ")), err.Error())
+		}
+	})
+
+}
+
+func TestWritingOutput(t *testing.T) {
+
+	t.Run("Given text output, when writing messages and errors, then they are written as is", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+
+		WriteMessage(writer, "done", Field{Name: "todoist_id", Value: 1})
+		WriteError(writer, errors.New(" failed"))
+
+		assert.Equal(t, "done failed", buffer.String())
+	})
+
+	t.Run("Given an output stream that is not a writer, when writing, then text is written", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+
+		WriteMessage(buffer, "done")
+
+		assert.Equal(t, "done", buffer.String())
+	})
+
+	t.Run("Given JSON output, when writing a message, then the status, message and fields are written", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+		writer.SetFormat("json")
+
+		WriteMessage(writer, "done", Field{Name: "todoist_id", Value: 1})
+
+		assert.Equal(t, "{\n  \"status\": \"ok\",\n  \"message\": \"done\",\n  \"todoist_id\": 1\n}\n", buffer.String())
+	})
+
+	t.Run("Given JSON output, when writing an error, then the error status and message are written", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+		writer.SetFormat("json")
+
+		WriteError(writer, errors.New("failed"))
+
+		assert.Equal(t, "{\n  \"status\": \"error\",\n  \"message\": \"failed\"\n}\n", buffer.String())
+	})
+
+	t.Run("Given JSON output, when writing a warning, then it is written as a record with the warning status", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+		writer.SetFormat("json")
+
+		WriteError(writer, failures.NewWarning(errors.New("rejected")))
+
+		assert.Equal(t, "{\n  \"status\": \"warning\",\n  \"message\": \"rejected\"\n}\n", buffer.String())
+	})
+
+	t.Run("Given text output, when writing a list, then the text is written instead of the records", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+
+		err := WriteList(writer, []string{"id"}, []Record{{{Name: "id", Value: 1}}}, func() {
+			buffer.WriteString("[1]")
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "[1]", buffer.String())
+	})
+
+	t.Run("Given CSV output, when writing a list, then the records are written", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		writer := NewWriter(buffer)
+		writer.SetFormat("csv")
+
+		err := WriteList(writer, []string{"id"}, []Record{{{Name: "id", Value: 1}}}, func() {
+			buffer.WriteString("[1]")
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id\n1\n", buffer.String())
+	})
+
+	t.Run("Given rows of columns, when writing them, then each row is written on its own line", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		rows := []string{"[1]\tInbox", "[10]\tWork"}
+
+		WriteRows(buffer, "empty", len(rows), func(index int) string {
+			return rows[index]
+		})
+
+		assert.Equal(t, "[1]\tInbox\n[10]\tWork\n", buffer.String())
+	})
+
+	t.Run("Given no rows, when writing them, then only the message is written", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+
+		WriteRows(buffer, "empty", 0, nil)
+
+		assert.Equal(t, "empty", buffer.String())
+	})
+
+}
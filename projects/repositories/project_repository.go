@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/kpdowns/todoist-cli/projects/types"
+	"github.com/kpdowns/todoist-cli/storage"
+)
+
+const (
+	errorRepositoryNotAbleToGetProject     = "An error occurred while retrieving the persisted projects"
+	errorRepositoryProjectNotFound         = "The requested project does not exist"
+	errorRepositoryErrorPersistingProjects = "An error occurred while persisting the list of projects to disk"
+	errorRepositoryErrorDeletingProjects   = "An error occurred deleting the persisted projects"
+)
+
+// ProjectRepository handles persisting the project with the cli's own internal identifier
+type ProjectRepository interface {
+	GetAll() (types.ProjectList, error)
+	Get(uint32) (*types.Project, error)
+	CreateAll(types.ProjectList) (types.ProjectList, error)
+	DeleteAll() error
+}
+
+type projectRepository struct {
+	list *storage.NumberedList
+}
+
+// NewProjectRepository creates a new instance of a projectRepository that handles persistence of projects
+func NewProjectRepository(file storage.File) ProjectRepository {
+	return &projectRepository{
+		list: storage.NewNumberedList(file, storage.NumberedListErrors{
+			Read:   errorRepositoryNotAbleToGetProject,
+			Write:  errorRepositoryErrorPersistingProjects,
+			Delete: errorRepositoryErrorDeletingProjects,
+		}),
+	}
+}
+
+// GetAll retrieves all projects, error if an error occurs while retrieving the projects
+func (r *projectRepository) GetAll() (types.ProjectList, error) {
+	var projects types.ProjectList
+	err := r.list.Read(&projects)
+	if err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// Get retrieves a single project with the provided id, error if the project does not exist
+func (r *projectRepository) Get(projectID uint32) (*types.Project, error) {
+	projects, err := r.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range projects {
+		if project.ID == projectID {
+			return &project, nil
+		}
+	}
+
+	return nil, errors.New(errorRepositoryProjectNotFound)
+}
+
+// CreateAll persists all projects with a generated id for later retrieval, returns a new list of projects with the generated ids populated if there is no error
+func (r *projectRepository) CreateAll(projects types.ProjectList) (types.ProjectList, error) {
+	projectsToPersist := append(types.ProjectList(nil), projects...)
+
+	err := r.list.Write(projectsToPersist)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectsToPersist, nil
+}
+
+// DeleteAll deletes all projects that have been persisted, returns error if an error occurs
+func (r *projectRepository) DeleteAll() error {
+	return r.list.Delete()
+}
@@ -0,0 +1,186 @@
+package repositories
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/projects/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGettingAllProjects(t *testing.T) {
+
+	t.Run("When getting all projects, if no error occurs, then the projects are returned", func(t *testing.T) {
+
+		expectedProjects := types.ProjectList{
+			{
+				ID:         1,
+				TodoistID:  100,
+				Name:       "Inbox",
+				ChildOrder: 1,
+			},
+		}
+		expectedBytes, _ := json.Marshal(expectedProjects)
+
+		inMemoryFile := &mocks.MockFile{
+			Contents: string(expectedBytes),
+		}
+
+		repository := NewProjectRepository(inMemoryFile)
+
+		actualProjects, err := repository.GetAll()
+		assert.Nil(t, err)
+		assert.Equal(t, expectedProjects, actualProjects)
+
+	})
+
+	t.Run("When getting all projects, if the contents on disk cannot be deserialized, then an error is returned", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			Contents: "not valid json",
+		}
+
+		repository := NewProjectRepository(inMemoryFile)
+
+		projects, err := repository.GetAll()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryNotAbleToGetProject, err.Error())
+		assert.Nil(t, projects)
+
+	})
+
+	t.Run("When getting all projects, if an error occurs, then the error is returned", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			ReadError: errors.New("test error"),
+		}
+
+		repository := NewProjectRepository(inMemoryFile)
+
+		projects, err := repository.GetAll()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryNotAbleToGetProject, err.Error())
+		assert.Nil(t, projects)
+
+	})
+
+}
+
+func TestGettingAnIndividualProject(t *testing.T) {
+
+	t.Run("When retrieving a single project, if the project exists, then the project is returned", func(t *testing.T) {
+
+		projectToBeRetrieved := &types.Project{
+			ID:   1,
+			Name: "Inbox",
+		}
+
+		expectedBytes, _ := json.Marshal(types.ProjectList{*projectToBeRetrieved})
+		inMemoryFile := &mocks.MockFile{
+			Contents: string(expectedBytes),
+		}
+
+		repository := NewProjectRepository(inMemoryFile)
+
+		project, err := repository.Get(projectToBeRetrieved.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, projectToBeRetrieved, project)
+
+	})
+
+	t.Run("When retrieving a single project, if the project does not exist, then an error is returned", func(t *testing.T) {
+
+		expectedBytes, _ := json.Marshal(types.ProjectList{types.Project{}})
+		inMemoryFile := &mocks.MockFile{
+			Contents: string(expectedBytes),
+		}
+
+		repository := NewProjectRepository(inMemoryFile)
+
+		project, err := repository.Get(1)
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryProjectNotFound, err.Error())
+		assert.Nil(t, project)
+
+	})
+
+}
+
+func TestPersistingAllProjects(t *testing.T) {
+
+	t.Run("Given a list of projects, when persisting the projects, the projects are assigned an id before being written to storage", func(t *testing.T) {
+		projectsToWrite := types.ProjectList{
+			types.Project{
+				TodoistID: 100,
+				Name:      "Inbox",
+			},
+			types.Project{
+				TodoistID: 200,
+				Name:      "Work",
+			},
+		}
+
+		inMemoryFile := &mocks.MockFile{}
+		repository := NewProjectRepository(inMemoryFile)
+
+		returnedProjects, err := repository.CreateAll(projectsToWrite)
+		assert.Nil(t, err)
+
+		var storedProjects types.ProjectList
+		json.Unmarshal([]byte(inMemoryFile.Contents), &storedProjects)
+		assert.Equal(t, storedProjects, returnedProjects)
+		assert.Equal(t, uint32(1), storedProjects[0].ID)
+		assert.Equal(t, "Inbox", storedProjects[0].Name)
+		assert.Equal(t, uint32(2), storedProjects[1].ID)
+		assert.Equal(t, int64(200), storedProjects[1].TodoistID)
+
+	})
+
+	t.Run("Given a list of projects, when persisting the projects and an error occurs while writing to disk, an error is returned", func(t *testing.T) {
+		inMemoryFile := &mocks.MockFile{
+			OverwriteError: errors.New("test error"),
+		}
+		repository := NewProjectRepository(inMemoryFile)
+
+		projects, err := repository.CreateAll(types.ProjectList{})
+		assert.NotNil(t, err)
+		assert.Nil(t, projects)
+		assert.Equal(t, errorRepositoryErrorPersistingProjects, err.Error())
+
+	})
+
+}
+
+func TestDeletingProjects(t *testing.T) {
+
+	t.Run("When deleting all projects and an error occurs, then an error is returned", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			OverwriteError: errors.New("test error"),
+		}
+
+		repository := NewProjectRepository(inMemoryFile)
+
+		err := repository.DeleteAll()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorRepositoryErrorDeletingProjects, err.Error())
+
+	})
+
+	t.Run("When deleting all projects and no error occurs, the projects are deleted from the disk", func(t *testing.T) {
+
+		inMemoryFile := &mocks.MockFile{
+			Contents: "test contents of file",
+		}
+
+		repository := NewProjectRepository(inMemoryFile)
+
+		err := repository.DeleteAll()
+		assert.Nil(t, err)
+		assert.Equal(t, "", inMemoryFile.Contents)
+
+	})
+
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/projects/repositories"
+	"github.com/kpdowns/todoist-cli/projects/types"
+	"github.com/kpdowns/todoist-cli/replica"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+)
+
+const (
+	errorNotCurrentlyAuthenticated   = "Error, you are not currently logged in."
+	errorOccurredDuringSyncOperation = "Error occurred while syncing with Todoist."
+	errorNoName                      = "A project name must be provided."
+	errorNoProjectFound              = "The requested project does not exist."
+	errorFailedToUpdateProject       = "An error occurred while updating the project on Todoist, please try again."
+)
+
+// ProjectService provides functionality to retrieve and update projects on Todoist
+type ProjectService interface {
+	GetAllProjects() (types.ProjectList, error)
+	AddProject(name string) error
+	RenameProject(projectID uint32, name string) error
+	ArchiveProject(projectID uint32) error
+	UnarchiveProject(projectID uint32) error
+	DeleteProject(projectID uint32) error
+}
+
+type projectService struct {
+	api                   todoist.API
+	authenticationService authentication.Service
+	replicaService        replica.Service
+	projectRepository     repositories.ProjectRepository
+}
+
+// NewProjectService creates a new instance of the project service
+func NewProjectService(api todoist.API, authenticationService authentication.Service, replicaService replica.Service, projectRepository repositories.ProjectRepository) ProjectService {
+	return &projectService{
+		api:                   api,
+		authenticationService: authenticationService,
+		replicaService:        replicaService,
+		projectRepository:     projectRepository,
+	}
+}
+
+// GetAllProjects returns a list of projects, sorted in the order they appear on Todoist
+func (s *projectService) GetAllProjects() (types.ProjectList, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	replica, err := s.replicaService.Sync()
+	if err != nil {
+		return nil, failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	var projects types.ProjectList
+	for _, todoistProject := range replica.Projects {
+		projects = append(projects, todoistProject.ToProject())
+	}
+
+	persistedProjects, err := s.projectRepository.CreateAll(projects.SortByChildOrder())
+	if err != nil {
+		return nil, err
+	}
+
+	return persistedProjects, nil
+}
+
+// AddProject creates a new project on Todoist with the provided name
+func (s *projectService) AddProject(name string) error {
+	if name == "" {
+		return failures.New(failures.Validation, errorNoName)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+
+	arguments := make(map[string]interface{})
+	arguments["name"] = name
+
+	command := requests.NewCommand(accessToken.AccessToken, commands.ProjectAdd, arguments)
+	_, err = s.replicaService.ExecuteCommand(command)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	return warning
+}
+
+// RenameProject changes the name of the project with the provided id
+func (s *projectService) RenameProject(projectID uint32, name string) error {
+	if name == "" {
+		return failures.New(failures.Validation, errorNoName)
+	}
+
+	arguments := make(map[string]interface{})
+	arguments["name"] = name
+
+	return s.executeCommandAgainstProject(projectID, commands.ProjectUpdate, arguments)
+}
+
+// ArchiveProject archives the project with the provided id
+func (s *projectService) ArchiveProject(projectID uint32) error {
+	return s.executeCommandAgainstProject(projectID, commands.ProjectArchive, make(map[string]interface{}))
+}
+
+// UnarchiveProject restores the archived project with the provided id
+func (s *projectService) UnarchiveProject(projectID uint32) error {
+	return s.executeCommandAgainstProject(projectID, commands.ProjectUnarchive, make(map[string]interface{}))
+}
+
+// DeleteProject deletes the project with the provided id along with all of its tasks
+func (s *projectService) DeleteProject(projectID uint32) error {
+	return s.executeCommandAgainstProject(projectID, commands.ProjectDelete, make(map[string]interface{}))
+}
+
+func (s *projectService) executeCommandAgainstProject(projectID uint32, commandType commands.CommandType, arguments map[string]interface{}) error {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+
+	project, err := s.projectRepository.Get(projectID)
+	if err != nil {
+		return failures.New(failures.NotFound, errorNoProjectFound)
+	}
+
+	arguments["id"] = project.TodoistReference()
+	command := requests.NewCommand(accessToken.AccessToken, commandType, arguments)
+	_, err = s.replicaService.ExecuteCommand(command)
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUpdateProject)
+	}
+
+	return warning
+}
@@ -0,0 +1,355 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/projects/repositories"
+	"github.com/kpdowns/todoist-cli/projects/types"
+	"github.com/kpdowns/todoist-cli/queue"
+	"github.com/kpdowns/todoist-cli/replica"
+	replicaTypes "github.com/kpdowns/todoist-cli/replica/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGettingAllProjects(t *testing.T) {
+
+	t.Run("When getting all projects and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		projectService := NewProjectService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), nil)
+
+		_, err := projectService.GetAllProjects()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When getting all projects and an error is returned from the API, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
+
+		_, err := projectService.GetAllProjects()
+		assert.NotNil(t, err)
+		assert.Equal(t, errorOccurredDuringSyncOperation, err.Error())
+
+	})
+
+	t.Run("When getting all projects, then the projects are read from the synced replica", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			SyncFunc: func() (*replicaTypes.Replica, error) {
+				return &replicaTypes.Replica{
+					Projects: []responses.Project{
+						{TodoistID: 100, Name: "Inbox"},
+					},
+				}, nil
+			},
+		}
+		repository := repositories.NewProjectRepository(&mocks.MockFile{})
+
+		projectService := NewProjectService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, repository)
+
+		projects, err := projectService.GetAllProjects()
+		assert.Nil(t, err)
+		assert.Len(t, projects, 1)
+		assert.Equal(t, "Inbox", projects[0].Name)
+
+	})
+
+	t.Run("When getting all projects and no error occurs, then the projects are sorted before being saved in the repository", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					Projects: []responses.Project{
+						{TodoistID: 200, Name: "Work", ChildOrder: 2},
+						{TodoistID: 100, Name: "Inbox", ChildOrder: 1},
+					},
+				}, nil
+			},
+		}
+		repository := repositories.NewProjectRepository(&mocks.MockFile{})
+
+		projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), repository)
+
+		returnedProjects, err := projectService.GetAllProjects()
+		assert.Nil(t, err)
+
+		repositoryProjects, _ := repository.GetAll()
+		assert.Equal(t, repositoryProjects, returnedProjects)
+		assert.Equal(t, "Inbox", repositoryProjects[0].Name)
+		assert.Equal(t, uint32(1), repositoryProjects[0].ID)
+		assert.Equal(t, "Work", repositoryProjects[1].Name)
+
+	})
+
+	t.Run("When getting all projects and an error occurs while persisting the projects, then an error is returned", func(t *testing.T) {
+
+		expectedError := errors.New("test error")
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{}, nil
+			},
+		}
+		mockRepository := &mocks.MockProjectRepository{
+			CreateAllFunc: func(types.ProjectList) (types.ProjectList, error) {
+				return nil, expectedError
+			},
+		}
+
+		projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := projectService.GetAllProjects()
+		assert.NotNil(t, err)
+		assert.Equal(t, expectedError.Error(), err.Error())
+
+	})
+
+}
+
+func TestAddingAProject(t *testing.T) {
+
+	t.Run("When adding a project and no name is provided, then an error is returned", func(t *testing.T) {
+
+		projectService := NewProjectService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}, newReplicaService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}), nil)
+
+		err := projectService.AddProject("")
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNoName, err.Error())
+
+	})
+
+	t.Run("When adding a project and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		projectService := NewProjectService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), nil)
+
+		err := projectService.AddProject("Work")
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When adding a project and the api returns an error, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
+
+		err := projectService.AddProject("Work")
+		assert.NotNil(t, err)
+		assert.Equal(t, errorOccurredDuringSyncOperation, err.Error())
+
+	})
+
+	t.Run("When adding a project and no error occurs, then a project_add command containing the name is executed", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
+
+		err := projectService.AddProject("Work")
+		assert.Nil(t, err)
+		assert.Equal(t, commands.ProjectAdd, executedCommand.Commands[0].Type)
+		assert.Equal(t, "Work", executedCommand.Commands[0].Arguments["name"])
+
+	})
+
+}
+
+func TestUpdatingAProject(t *testing.T) {
+
+	existingProject := func(uint32) (*types.Project, error) {
+		return &types.Project{
+			ID:        1,
+			TodoistID: 100,
+			Name:      "Work",
+		}, nil
+	}
+
+	t.Run("When renaming a project and no name is provided, then an error is returned", func(t *testing.T) {
+
+		projectService := NewProjectService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}, newReplicaService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}), nil)
+
+		err := projectService.RenameProject(1, "")
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNoName, err.Error())
+
+	})
+
+	t.Run("When updating a project and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		projectService := NewProjectService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), &mocks.MockProjectRepository{})
+
+		err := projectService.ArchiveProject(1)
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When updating a project and the project does not exist, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockProjectRepository{
+			GetFunc: func(uint32) (*types.Project, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		projectService := NewProjectService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), mockRepository)
+
+		err := projectService.DeleteProject(1)
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNoProjectFound, err.Error())
+
+	})
+
+	t.Run("When updating a project and the api returns an error, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockProjectRepository{
+			GetFunc: existingProject,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := projectService.UnarchiveProject(1)
+		assert.NotNil(t, err)
+		assert.Equal(t, errorFailedToUpdateProject, err.Error())
+
+	})
+
+	var commandsToTest = []struct {
+		description         string
+		execute             func(ProjectService) error
+		expectedCommandType commands.CommandType
+	}{
+		{"renaming", func(s ProjectService) error { return s.RenameProject(1, "Personal") }, commands.ProjectUpdate},
+		{"archiving", func(s ProjectService) error { return s.ArchiveProject(1) }, commands.ProjectArchive},
+		{"unarchiving", func(s ProjectService) error { return s.UnarchiveProject(1) }, commands.ProjectUnarchive},
+		{"deleting", func(s ProjectService) error { return s.DeleteProject(1) }, commands.ProjectDelete},
+	}
+
+	for _, commandToTest := range commandsToTest {
+		commandToTest := commandToTest
+
+		t.Run("When "+commandToTest.description+" a project and no error occurs, then the command is executed against the Todoist id of the project", func(t *testing.T) {
+
+			var executedCommand requests.Command
+
+			mockAuthenticationService := &mocks.MockAuthenticationService{
+				AuthenticatedStateToReturn: true,
+			}
+			mockRepository := &mocks.MockProjectRepository{
+				GetFunc: existingProject,
+			}
+			mockAPI := &mocks.MockAPI{
+				ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+					executedCommand = command
+					return &responses.Command{}, nil
+				},
+			}
+
+			projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+			err := commandToTest.execute(projectService)
+			assert.Nil(t, err)
+			assert.Equal(t, commandToTest.expectedCommandType, executedCommand.Commands[0].Type)
+			assert.Equal(t, int64(100), executedCommand.Commands[0].Arguments["id"])
+
+		})
+	}
+
+	t.Run("When renaming a project, then the new name is provided to Todoist", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockProjectRepository{
+			GetFunc: existingProject,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		projectService := NewProjectService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := projectService.RenameProject(1, "Personal")
+		assert.Nil(t, err)
+		assert.Equal(t, "Personal", executedCommand.Commands[0].Arguments["name"])
+
+	})
+
+}
+
+func newReplicaService(api todoist.API, authenticationService authentication.Service) replica.Service {
+	return replica.NewReplicaService(api, authenticationService, replica.NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+// Project is a collection of tasks
+type Project struct {
+	ID          uint32
+	TodoistID   int64
+	TemporaryID string
+	Name        string
+	Color       int32
+	ChildOrder  int32
+	IsArchived  int16
+	IsFavorite  int16
+}
+
+// AsString returns a tab delimited string representing the project
+func (p *Project) AsString() string {
+	status := ""
+	if p.IsArchived == 1 {
+		status = color.HiBlackString("Archived")
+	}
+
+	return fmt.Sprintf("[%d]\t%s\t%s",
+		p.ID,
+		p.Name,
+		status,
+	)
+}
+
+// TodoistReference returns the id to use for the project in commands, the temporary id is used for projects that have not been synced yet
+func (p *Project) TodoistReference() interface{} {
+	if p.TodoistID == 0 && p.TemporaryID != "" {
+		return p.TemporaryID
+	}
+	return p.TodoistID
+}
+
+// AsRecord returns the fields of the project written in machine readable output
+func (p *Project) AsRecord() output.Record {
+	return output.Record{
+		{Name: "id", Value: p.ID},
+		{Name: "todoist_id", Value: p.TodoistID},
+		{Name: "name", Value: p.Name},
+		{Name: "color", Value: p.Color},
+		{Name: "child_order", Value: p.ChildOrder},
+		{Name: "archived", Value: p.IsArchived == 1},
+		{Name: "favorite", Value: p.IsFavorite == 1},
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+// ProjectList is a list of unordered projects
+type ProjectList []Project
+
+// SortByChildOrder sorts the projects in the order they are displayed on Todoist. Returns a new slice of projects.
+func (p ProjectList) SortByChildOrder() ProjectList {
+	sortedProjects := make(ProjectList, len(p))
+	copy(sortedProjects, p)
+	sort.Stable(sortedProjects)
+	return sortedProjects
+}
+
+// Len returns the length of the ProjectList
+func (p ProjectList) Len() int { return len(p) }
+
+// Less returns true if the project is displayed before the one being compared
+func (p ProjectList) Less(i, j int) bool {
+	return p[i].ChildOrder < p[j].ChildOrder
+}
+
+// Swap swaps two different projects in the slice
+func (p ProjectList) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+
+// FindByNameOrID returns the project with the provided name or Todoist id, nil if no project matches. Names are not case sensitive.
+func (p ProjectList) FindByNameOrID(nameOrID string) *Project {
+	projectID, err := strconv.ParseInt(nameOrID, 10, 64)
+	isID := err == nil
+
+	for index, project := range p {
+		if strings.EqualFold(project.Name, nameOrID) || (isID && project.TodoistID == projectID) {
+			return &p[index]
+		}
+	}
+
+	return nil
+}
+
+// AsRecords returns the projects as records written in machine readable output
+func (p ProjectList) AsRecords() []output.Record {
+	records := make([]output.Record, len(p))
+	for index := range p {
+		records[index] = p[index].AsRecord()
+	}
+	return records
+}
+
+// RecordColumns returns the names of the fields of a project in machine readable output
+func RecordColumns() []string {
+	return (&Project{}).AsRecord().Names()
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGivenListOfProjectsWhenSortingProjectsThenListOfProjectsIsOrderedByChildOrder(t *testing.T) {
+	projects := ProjectList{
+		Project{
+			TodoistID:  3,
+			ChildOrder: 3,
+		},
+		Project{
+			TodoistID:  1,
+			ChildOrder: 1,
+		},
+		Project{
+			TodoistID:  2,
+			ChildOrder: 2,
+		},
+	}
+
+	sortedProjects := projects.SortByChildOrder()
+	assert.Equal(t, int64(1), sortedProjects[0].TodoistID)
+	assert.Equal(t, int64(2), sortedProjects[1].TodoistID)
+	assert.Equal(t, int64(3), sortedProjects[2].TodoistID)
+
+	assert.Equal(t, int64(3), projects[0].TodoistID)
+}
+
+func TestGivenListOfProjectsWhenFindingAProjectThenTheNameOrTodoistIDIsMatched(t *testing.T) {
+	projects := ProjectList{
+		Project{TodoistID: 100, Name: "Inbox"},
+		Project{TodoistID: 200, Name: "Work"},
+	}
+
+	assert.Equal(t, int64(200), projects.FindByNameOrID("work").TodoistID)
+	assert.Equal(t, "Inbox", projects.FindByNameOrID("100").Name)
+	assert.Nil(t, projects.FindByNameOrID("Personal"))
+}
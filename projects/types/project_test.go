@@ -0,0 +1,80 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+func TestGivenAProjectWhenConvertingToStringThenTheArchivedStatusIsIncluded(t *testing.T) {
+	var projectsToTest = []struct {
+		project        Project
+		expectedString string
+	}{
+		{
+			Project{
+				ID:   1,
+				Name: "Inbox",
+			},
+			"[1]\tInbox\t",
+		},
+		{
+			Project{
+				ID:         2,
+				Name:       "Work",
+				IsArchived: 1,
+			},
+			"[2]\tWork\tArchived",
+		},
+	}
+
+	for _, projectToTest := range projectsToTest {
+		stringRepresentation := projectToTest.project.AsString()
+		if stringRepresentation != projectToTest.expectedString {
+			t.Errorf("Expected '%s', got '%s'", projectToTest.expectedString, stringRepresentation)
+		}
+	}
+}
+
+func TestGivenAProjectWhenReferencingItInACommandThenTheTemporaryIDIsUsedUntilItHasBeenSynced(t *testing.T) {
+	syncedProject := Project{TodoistID: 10, TemporaryID: "temp-1"}
+	if syncedProject.TodoistReference() != int64(10) {
+		t.Errorf("Expected '10', got '%v'", syncedProject.TodoistReference())
+	}
+
+	unsyncedProject := Project{TemporaryID: "temp-1"}
+	if unsyncedProject.TodoistReference() != "temp-1" {
+		t.Errorf("Expected 'temp-1', got '%v'", unsyncedProject.TodoistReference())
+	}
+}
+
+func TestGivenAProjectWhenConvertingToARecordThenTheFieldsHaveStableNames(t *testing.T) {
+	project := Project{
+		ID:         1,
+		TodoistID:  10,
+		Name:       "Work",
+		Color:      30,
+		ChildOrder: 2,
+		IsArchived: 1,
+	}
+
+	expectedRecord := output.Record{
+		{Name: "id", Value: uint32(1)},
+		{Name: "todoist_id", Value: int64(10)},
+		{Name: "name", Value: "Work"},
+		{Name: "color", Value: int32(30)},
+		{Name: "child_order", Value: int32(2)},
+		{Name: "archived", Value: true},
+		{Name: "favorite", Value: false},
+	}
+
+	record := project.AsRecord()
+	if !reflect.DeepEqual(expectedRecord, record) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord, record)
+	}
+
+	if !reflect.DeepEqual(expectedRecord.Names(), RecordColumns()) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord.Names(), RecordColumns())
+	}
+}
@@ -0,0 +1,81 @@
+// Package prompt asks the person running a command to choose between options or confirm an action when a command cannot decide on its own
+package prompt
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+)
+
+const (
+	errorNoChoice      = "No option was chosen"
+	errorInvalidChoice = "'%s' is not one of the options, choose a number from 1 to %d"
+)
+
+// IsInteractive returns true if a person can answer a prompt, the output must be text and the input a terminal.
+// Input that is not a file, such as a buffer provided in tests, is treated as the answers of a person.
+func IsInteractive(in io.Reader, out io.Writer) bool {
+	if !output.IsText(out) {
+		return false
+	}
+
+	file, isFile := in.(*os.File)
+	if !isFile {
+		return true
+	}
+
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Choose writes the question followed by the options numbered from 1 and reads the number of the chosen option,
+// the index of the chosen option is returned
+func Choose(in io.Reader, out io.Writer, question string, options []string) (int, error) {
+	fmt.Fprintln(out, question)
+	for index, option := range options {
+		fmt.Fprintf(out, "%d) %s\n", index+1, option)
+	}
+	fmt.Fprintf(out, "Choose 1-%d: ", len(options))
+
+	answer, err := readLine(in)
+	if err != nil && answer == "" {
+		return 0, failures.New(failures.Validation, errorNoChoice)
+	}
+
+	choice, err := strconv.Atoi(answer)
+	if err != nil || choice < 1 || choice > len(options) {
+		return 0, failures.Newf(failures.Validation, errorInvalidChoice, answer, len(options))
+	}
+
+	return choice - 1, nil
+}
+
+// Confirm writes the question and reads whether the person agrees, only y or yes agree and anything else, including no answer at all, declines
+func Confirm(in io.Reader, out io.Writer, question string) bool {
+	fmt.Fprintf(out, "%s [y/N]: ", question)
+
+	answer, _ := readLine(in)
+	answer = strings.ToLower(answer)
+	return answer == "y" || answer == "yes"
+}
+
+// readLine reads a single line without reading ahead, so that the answers to several prompts can be read from the same input
+func readLine(in io.Reader) (string, error) {
+	var line []byte
+	character := make([]byte, 1)
+	for {
+		_, err := in.Read(character)
+		if err != nil {
+			return strings.TrimSpace(string(line)), err
+		}
+		if character[0] == '\n' {
+			return strings.TrimSpace(string(line)), nil
+		}
+		line = append(line, character[0])
+	}
+}
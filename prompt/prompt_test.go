@@ -0,0 +1,121 @@
+package prompt
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/output"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChoosingAnOption(t *testing.T) {
+
+	options := []string{"Buy milk", "Buy oat milk"}
+
+	t.Run("When a number of an option is answered, then the index of the option is returned", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		choice, err := Choose(strings.NewReader(" 2 \n"), out, "Which task?", options)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, choice)
+		assert.Equal(t, "Which task?\n1) Buy milk\n2) Buy oat milk\nChoose 1-2: ", out.String())
+	})
+
+	t.Run("When several prompts read from the same input, then each prompt reads its own line", func(t *testing.T) {
+		in := strings.NewReader("2\n1")
+
+		first, firstErr := Choose(in, &bytes.Buffer{}, "Which task?", options)
+		second, secondErr := Choose(in, &bytes.Buffer{}, "Which task?", options)
+
+		assert.Nil(t, firstErr)
+		assert.Nil(t, secondErr)
+		assert.Equal(t, 1, first)
+		assert.Equal(t, 0, second)
+	})
+
+	var invalidAnswersToTest = []struct {
+		answer        string
+		expectedError string
+	}{
+		{"", errorNoChoice},
+		{"3\n", fmt.Sprintf(errorInvalidChoice, "3", 2)},
+		{"milk\n", fmt.Sprintf(errorInvalidChoice, "milk", 2)},
+	}
+
+	for _, answerToTest := range invalidAnswersToTest {
+		answerToTest := answerToTest
+
+		t.Run(fmt.Sprintf("When '%s' is answered, then a validation error is returned", strings.TrimSpace(answerToTest.answer)), func(t *testing.T) {
+			_, err := Choose(strings.NewReader(answerToTest.answer), &bytes.Buffer{}, "Which task?", options)
+
+			if assert.NotNil(t, err) {
+				assert.Equal(t, answerToTest.expectedError, err.Error())
+				assert.Equal(t, failures.Validation, failures.KindOf(err))
+			}
+		})
+	}
+
+}
+
+func TestConfirmingAnAction(t *testing.T) {
+
+	t.Run("When the question is asked, then it is followed by the possible answers", func(t *testing.T) {
+		out := &bytes.Buffer{}
+
+		Confirm(strings.NewReader("y\n"), out, "Delete the task?")
+
+		assert.Equal(t, "Delete the task? [y/N]: ", out.String())
+	})
+
+	var answersToTest = []struct {
+		answer        string
+		expectedAgree bool
+	}{
+		{"y\n", true},
+		{" Yes \n", true},
+		{"n\n", false},
+		{"sure\n", false},
+		{"\n", false},
+		{"", false},
+	}
+
+	for _, answerToTest := range answersToTest {
+		answerToTest := answerToTest
+
+		t.Run(fmt.Sprintf("When '%s' is answered, then agreeing is %t", strings.TrimSpace(answerToTest.answer), answerToTest.expectedAgree), func(t *testing.T) {
+			assert.Equal(t, answerToTest.expectedAgree, Confirm(strings.NewReader(answerToTest.answer), &bytes.Buffer{}, "Delete the task?"))
+		})
+	}
+
+}
+
+func TestDetectingWhetherAPersonCanAnswer(t *testing.T) {
+
+	t.Run("When the output is text and the input is not a file, then a person can answer", func(t *testing.T) {
+		assert.True(t, IsInteractive(strings.NewReader(""), &bytes.Buffer{}))
+	})
+
+	t.Run("When the output is not text, then a person cannot answer", func(t *testing.T) {
+		outputStream := output.NewWriter(&bytes.Buffer{})
+		outputStream.SetFormat("json")
+
+		assert.False(t, IsInteractive(strings.NewReader(""), outputStream))
+	})
+
+	t.Run("When the input is a file that is not a terminal, then a person cannot answer", func(t *testing.T) {
+		reader, writer, err := os.Pipe()
+		if err != nil {
+			t.Skip("pipes are not available")
+		}
+		defer reader.Close()
+		defer writer.Close()
+
+		assert.False(t, IsInteractive(reader, &bytes.Buffer{}))
+	})
+
+}
@@ -0,0 +1,81 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/kpdowns/todoist-cli/storage"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+)
+
+const (
+	errorRepositoryNotAbleToGetQueuedCommands  = "An error occurred while retrieving the commands queued while offline"
+	errorRepositoryErrorPersistingQueue        = "An error occurred while persisting the queued commands to disk"
+	errorRepositoryErrorDeletingQueuedCommands = "An error occurred while deleting the queued commands"
+)
+
+// Repository persists commands that could not be sent to Todoist so that they can be sent on the next sync
+type Repository interface {
+	GetAll() ([]requests.CommandDetail, error)
+	Add(commandDetails ...requests.CommandDetail) error
+	DeleteAll() error
+}
+
+type repository struct {
+	file storage.File
+}
+
+// NewQueueRepository creates a new instance of the repository that writes queued commands to storage
+func NewQueueRepository(file storage.File) Repository {
+	return &repository{
+		file: file,
+	}
+}
+
+// GetAll retrieves all queued commands in the order they were queued
+func (r *repository) GetAll() ([]requests.CommandDetail, error) {
+	contents, err := r.file.ReadContents()
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetQueuedCommands)
+	}
+
+	if contents == "" {
+		return nil, nil
+	}
+
+	var commandDetails []requests.CommandDetail
+	err = json.Unmarshal([]byte(contents), &commandDetails)
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetQueuedCommands)
+	}
+
+	return commandDetails, nil
+}
+
+// Add appends commands to the end of the queue
+func (r *repository) Add(commandDetails ...requests.CommandDetail) error {
+	queuedCommandDetails, err := r.GetAll()
+	if err != nil {
+		return err
+	}
+
+	queuedCommandDetails = append(queuedCommandDetails, commandDetails...)
+
+	contents, _ := json.Marshal(queuedCommandDetails)
+	err = r.file.OverwriteContents(string(contents))
+	if err != nil {
+		return errors.New(errorRepositoryErrorPersistingQueue)
+	}
+
+	return nil
+}
+
+// DeleteAll removes all commands from the queue
+func (r *repository) DeleteAll() error {
+	err := r.file.OverwriteContents("")
+	if err != nil {
+		return errors.New(errorRepositoryErrorDeletingQueuedCommands)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetrievingQueuedCommands(t *testing.T) {
+
+	t.Run("When no commands have been queued, then no commands are returned", func(t *testing.T) {
+		repository := NewQueueRepository(&mocks.MockFile{})
+
+		commandDetails, err := repository.GetAll()
+		assert.Nil(t, err)
+		assert.Empty(t, commandDetails)
+	})
+
+	t.Run("When the storage cannot be read, then an error is returned", func(t *testing.T) {
+		repository := NewQueueRepository(&mocks.MockFile{ReadError: errors.New("test error")})
+
+		_, err := repository.GetAll()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryNotAbleToGetQueuedCommands, err.Error())
+		}
+	})
+
+	t.Run("When the queue is malformed, then an error is returned", func(t *testing.T) {
+		repository := NewQueueRepository(&mocks.MockFile{Contents: "not valid json"})
+
+		_, err := repository.GetAll()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryNotAbleToGetQueuedCommands, err.Error())
+		}
+	})
+
+}
+
+func TestQueueingCommands(t *testing.T) {
+
+	t.Run("When commands are added, then they are appended to the queue with their uuid and temporary id intact", func(t *testing.T) {
+		repository := NewQueueRepository(&mocks.MockFile{})
+
+		first := requests.CommandDetail{Type: commands.ItemAdd, UUID: "uuid-1", TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "first"}}
+		second := requests.CommandDetail{Type: commands.ItemClose, UUID: "uuid-2", TemporaryID: "temp-2", Arguments: map[string]interface{}{"id": "temp-1"}}
+
+		assert.Nil(t, repository.Add(first))
+		assert.Nil(t, repository.Add(second))
+
+		commandDetails, err := repository.GetAll()
+		assert.Nil(t, err)
+		assert.Equal(t, []requests.CommandDetail{first, second}, commandDetails)
+	})
+
+	t.Run("When commands are added and the queue cannot be written, then an error is returned", func(t *testing.T) {
+		repository := NewQueueRepository(&mocks.MockFile{OverwriteError: errors.New("test error")})
+
+		err := repository.Add(requests.CommandDetail{})
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryErrorPersistingQueue, err.Error())
+		}
+	})
+
+	t.Run("When the queue is cleared, then the storage is emptied", func(t *testing.T) {
+		file := &mocks.MockFile{Contents: "[]"}
+		repository := NewQueueRepository(file)
+
+		assert.Nil(t, repository.DeleteAll())
+		assert.Equal(t, "", file.Contents)
+	})
+
+	t.Run("When the queue is cleared and an error occurs, then an error is returned", func(t *testing.T) {
+		repository := NewQueueRepository(&mocks.MockFile{OverwriteError: errors.New("test error")})
+
+		err := repository.DeleteAll()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryErrorDeletingQueuedCommands, err.Error())
+		}
+	})
+
+}
@@ -0,0 +1,74 @@
+package replica
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/kpdowns/todoist-cli/replica/types"
+	"github.com/kpdowns/todoist-cli/storage"
+)
+
+const (
+	errorRepositoryNotAbleToGetReplica     = "An error occurred while retrieving the local copy of your Todoist data"
+	errorRepositoryErrorPersistingReplica  = "An error occurred while persisting the local copy of your Todoist data to disk"
+	errorRepositoryErrorDeletingTheReplica = "An error occurred while deleting the local copy of your Todoist data"
+)
+
+// Repository handles persistence of the local copy of the resources on Todoist
+type Repository interface {
+	Get() (*types.Replica, error)
+	Update(replica *types.Replica) error
+	Delete() error
+}
+
+type repository struct {
+	file storage.File
+}
+
+// NewReplicaRepository creates a new instance of the repository that writes the replica to storage
+func NewReplicaRepository(file storage.File) Repository {
+	return &repository{
+		file: file,
+	}
+}
+
+// Get retrieves the persisted replica, an empty replica is returned if nothing has been persisted yet
+func (r *repository) Get() (*types.Replica, error) {
+	contents, err := r.file.ReadContents()
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetReplica)
+	}
+
+	replica := &types.Replica{}
+	if contents == "" {
+		return replica, nil
+	}
+
+	err = json.Unmarshal([]byte(contents), replica)
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetReplica)
+	}
+
+	return replica, nil
+}
+
+// Update overwrites the persisted replica
+func (r *repository) Update(replica *types.Replica) error {
+	contents, _ := json.Marshal(replica)
+	err := r.file.OverwriteContents(string(contents))
+	if err != nil {
+		return errors.New(errorRepositoryErrorPersistingReplica)
+	}
+
+	return nil
+}
+
+// Delete removes the persisted replica, forcing a full sync the next time the replica is synced
+func (r *repository) Delete() error {
+	err := r.file.OverwriteContents("")
+	if err != nil {
+		return errors.New(errorRepositoryErrorDeletingTheReplica)
+	}
+
+	return nil
+}
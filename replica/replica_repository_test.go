@@ -0,0 +1,101 @@
+package replica
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/replica/types"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetrievingTheReplica(t *testing.T) {
+
+	t.Run("When nothing has been persisted yet, then an empty replica is returned", func(t *testing.T) {
+		repository := NewReplicaRepository(&mocks.MockFile{})
+
+		replica, err := repository.Get()
+		assert.Nil(t, err)
+		assert.Equal(t, &types.Replica{}, replica)
+	})
+
+	t.Run("When the replica has been persisted, then the persisted replica is returned", func(t *testing.T) {
+		expectedReplica := &types.Replica{
+			SyncToken: "token",
+			Items:     []responses.Item{{TodoistID: 1, Content: "test"}},
+		}
+		contents, _ := json.Marshal(expectedReplica)
+
+		repository := NewReplicaRepository(&mocks.MockFile{Contents: string(contents)})
+
+		replica, err := repository.Get()
+		assert.Nil(t, err)
+		assert.Equal(t, expectedReplica, replica)
+	})
+
+	t.Run("When the persisted replica is malformed, then an error is returned", func(t *testing.T) {
+		repository := NewReplicaRepository(&mocks.MockFile{Contents: "not valid json"})
+
+		replica, err := repository.Get()
+		assert.Nil(t, replica)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryNotAbleToGetReplica, err.Error())
+		}
+	})
+
+	t.Run("When the storage cannot be read, then an error is returned", func(t *testing.T) {
+		repository := NewReplicaRepository(&mocks.MockFile{ReadError: errors.New("test error")})
+
+		replica, err := repository.Get()
+		assert.Nil(t, replica)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryNotAbleToGetReplica, err.Error())
+		}
+	})
+
+}
+
+func TestPersistingTheReplica(t *testing.T) {
+
+	t.Run("When updating the replica, then it can be read back from storage", func(t *testing.T) {
+		file := &mocks.MockFile{}
+		repository := NewReplicaRepository(file)
+
+		expectedReplica := &types.Replica{SyncToken: "token"}
+		err := repository.Update(expectedReplica)
+		assert.Nil(t, err)
+
+		replica, _ := repository.Get()
+		assert.Equal(t, expectedReplica, replica)
+	})
+
+	t.Run("When updating the replica and an error occurs while writing to storage, then an error is returned", func(t *testing.T) {
+		repository := NewReplicaRepository(&mocks.MockFile{OverwriteError: errors.New("test error")})
+
+		err := repository.Update(&types.Replica{})
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryErrorPersistingReplica, err.Error())
+		}
+	})
+
+	t.Run("When deleting the replica, then the storage is cleared", func(t *testing.T) {
+		file := &mocks.MockFile{Contents: "test contents"}
+		repository := NewReplicaRepository(file)
+
+		err := repository.Delete()
+		assert.Nil(t, err)
+		assert.Equal(t, "", file.Contents)
+	})
+
+	t.Run("When deleting the replica and an error occurs, then an error is returned", func(t *testing.T) {
+		repository := NewReplicaRepository(&mocks.MockFile{OverwriteError: errors.New("test error")})
+
+		err := repository.Delete()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRepositoryErrorDeletingTheReplica, err.Error())
+		}
+	})
+
+}
@@ -0,0 +1,501 @@
+package replica
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/beevik/guid"
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/queue"
+	"github.com/kpdowns/todoist-cli/replica/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+)
+
+const (
+	fullSyncToken = "*"
+
+	errorNotCurrentlyAuthenticated   = "Error, you are not currently logged in."
+	errorOccurredDuringSyncOperation = "Error occurred while syncing with Todoist."
+	errorOfflineWithoutReplica       = "Todoist could not be reached and there is no local copy of your Todoist data yet, please try again when online."
+	errorFailedToFlushQueue          = "The commands queued while offline were rejected by Todoist, use 'todoist queue drop' to discard them."
+	errorNoCachedReplica             = "There is no local copy of your Todoist data yet, list your tasks without --cached first."
+	errorFailedToQueueCommand        = "Todoist could not be reached and the command could not be queued to be sent later."
+	errorFailedToApplyQueuedCommand  = "Todoist could not be reached, the command was queued to be sent later but could not be applied to the local copy of your Todoist data."
+	errorInvalidUndoSteps            = "The number of operations to undo must be at least 1."
+	errorNothingToUndo               = "There are no operations left to undo."
+	errorIrreversibleOperation       = "The operation to %s cannot be undone, so no operations were undone. Use --skip-irreversible to undo the operations before it."
+	errorFailedToUndo                = "An error occurred while undoing the operations on Todoist, please try again."
+	errorFailedToJournalOperation    = "The operation was sent to Todoist but could not be recorded in the history, so it cannot be undone."
+	errorFailedToJournalUndo         = "The operations were undone on Todoist but the undo could not be recorded in the history."
+	errorFailedToJournalDrop         = "The queued commands were discarded but could not be removed from the history, undoing them may fail."
+)
+
+// resourceTypes are the resources kept in the replica, they are always synced together so that a single sync token applies to all of them
+var resourceTypes = requests.ResourceTypes{"items", "projects", "sections", "labels", "user", "collaborators", "notes", "project_notes"}
+
+// Service keeps the local replica of the resources on Todoist up to date, and sends commands to Todoist queueing them while offline
+type Service interface {
+	Sync() (*types.Replica, error)
+	Cached() (*types.Replica, error)
+	Clear() error
+	ExecuteCommand(command requests.Command) (map[string]int64, error)
+	GetQueuedCommands() ([]requests.CommandDetail, error)
+	FlushQueuedCommands() error
+	DropQueuedCommands() error
+	History() (*journal.Journal, error)
+	JournalQuickAdd(item *responses.Item) error
+	Undo(steps int, skipIrreversible bool) ([]journal.Entry, error)
+}
+
+type service struct {
+	api                   todoist.API
+	authenticationService authentication.Service
+	repository            Repository
+	queueRepository       queue.Repository
+	journalRepository     journal.Repository
+}
+
+// NewReplicaService creates a new instance of the replica service
+func NewReplicaService(api todoist.API, authenticationService authentication.Service, repository Repository, queueRepository queue.Repository, journalRepository journal.Repository) Service {
+	return &service{
+		api:                   api,
+		authenticationService: authenticationService,
+		repository:            repository,
+		queueRepository:       queueRepository,
+		journalRepository:     journalRepository,
+	}
+}
+
+// Sync sends any queued commands, then retrieves the changes made on Todoist since the last sync and applies them to the replica.
+// A full sync is performed if the replica has never been synced. When Todoist cannot be reached, the replica is returned as is.
+func (s *service) Sync() (*types.Replica, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	replica, err := s.repository.Get()
+	if err != nil {
+		replica = &types.Replica{}
+	}
+
+	err = s.FlushQueuedCommands()
+	if err == todoist.ErrUnreachable {
+		return s.offlineReplica(replica)
+	}
+	// the queued commands were sent even when their temporary ids could not be journaled, which only affects undoing them
+	if err != nil && !failures.IsWarning(err) {
+		return nil, err
+	}
+
+	syncToken := replica.SyncToken
+	if replica.RequiresFullSync() || !replica.Covers(resourceTypes) {
+		syncToken = fullSyncToken
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	syncQuery := requests.NewQuery(accessToken.AccessToken, syncToken, resourceTypes)
+
+	syncResponse, err := s.api.ExecuteSyncQuery(syncQuery)
+	if err == todoist.ErrUnreachable {
+		return s.offlineReplica(replica)
+	}
+	if err != nil {
+		return nil, failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	replica.RemoveTemporaryResources()
+	replica.Apply(syncResponse)
+	replica.ResourceTypes = resourceTypes
+
+	err = s.repository.Update(replica)
+	if err != nil {
+		return nil, err
+	}
+
+	return replica, nil
+}
+
+// Cached returns the replica as it was after the last sync without contacting Todoist, including changes queued while offline
+func (s *service) Cached() (*types.Replica, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	replica, err := s.repository.Get()
+	if err != nil || replica.RequiresFullSync() {
+		return nil, failures.New(failures.NotFound, errorNoCachedReplica)
+	}
+
+	return replica, nil
+}
+
+// Clear deletes the replica, any queued commands and the journal, so that nothing is carried over to the next account that logs in.
+// The next sync will be a full sync.
+func (s *service) Clear() error {
+	err := s.queueRepository.DeleteAll()
+	if err != nil {
+		return err
+	}
+
+	err = s.journalRepository.DeleteAll()
+	if err != nil {
+		return err
+	}
+
+	return s.repository.Delete()
+}
+
+// ExecuteCommand sends the command to Todoist along with any commands queued while offline, and returns the ids Todoist assigned to temporary ids.
+// If Todoist cannot be reached the command is queued and optimistically applied to the replica instead. Commands that are sent or queued are
+// journaled along with the commands that reverse them, so that they can be undone. When the command was sent but could not be journaled, or
+// only queued commands sent along with it were rejected, the ids are returned along with a failures.Warning since the command itself succeeded.
+func (s *service) ExecuteCommand(command requests.Command) (map[string]int64, error) {
+	entry := s.journalEntry(command)
+
+	temporaryIDMapping, queuedRejections, err := s.execute(command)
+	journalErr := s.journalRepository.AddTemporaryIDMapping(temporaryIDMapping)
+	if journalErr == nil {
+		journalErr = s.discardRejectedCommands(queuedRejections, err)
+	}
+	if err != nil {
+		return temporaryIDMapping, err
+	}
+
+	if journalErr == nil {
+		journalErr = s.journalRepository.Add(entry)
+	}
+	if journalErr != nil {
+		journalErr = failures.Wrap(journalErr, errorFailedToJournalOperation)
+	}
+
+	return temporaryIDMapping, failures.NewWarning(queuedRejections, journalErr)
+}
+
+// execute sends the command in a batch after the queued commands and returns the ids Todoist assigned to temporary ids, which are left
+// to the caller to journal. The command is queued when Todoist cannot be reached. Only the rejections of the command itself are returned as
+// err, rejections of queued commands are returned separately unless the command was rejected as well.
+func (s *service) execute(command requests.Command) (temporaryIDMapping map[string]int64, queuedRejections error, err error) {
+	queuedCommandDetails, err := s.queueRepository.GetAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	batch := requests.Command{
+		Token:    command.Token,
+		Commands: append(queuedCommandDetails, command.Commands...),
+	}
+
+	response, err := s.api.ExecuteSyncCommand(batch)
+	if err == todoist.ErrUnreachable {
+		return nil, nil, s.queueCommand(command)
+	}
+	if err != nil && !todoist.IsCommandRejection(err) {
+		return nil, nil, err
+	}
+
+	if len(queuedCommandDetails) > 0 {
+		deleteErr := s.queueRepository.DeleteAll()
+		if deleteErr != nil {
+			return nil, nil, deleteErr
+		}
+	}
+
+	if response != nil {
+		temporaryIDMapping = response.TempIDMapping
+	}
+
+	commandRejections, rejectedQueuedCommands := separateRejections(err, command)
+	if len(commandRejections) > 0 {
+		return temporaryIDMapping, nil, append(commandRejections, rejectedQueuedCommands...)
+	}
+	if len(rejectedQueuedCommands) > 0 {
+		return temporaryIDMapping, rejectedQueuedCommands, nil
+	}
+
+	return temporaryIDMapping, nil, nil
+}
+
+// separateRejections splits the commands Todoist rejected into those of the command and those that were queued while offline, the rejections
+// of queued commands are marked as such so that they are not mistaken for rejections of the command
+func separateRejections(err error, command requests.Command) (commandRejections todoist.CommandErrors, queuedRejections todoist.CommandErrors) {
+	rejections, _ := err.(todoist.CommandErrors)
+
+	uuids := make(map[string]bool)
+	for _, commandDetail := range command.Commands {
+		uuids[commandDetail.UUID] = true
+	}
+
+	for _, rejection := range rejections {
+		if uuids[rejection.UUID] {
+			commandRejections = append(commandRejections, rejection)
+			continue
+		}
+		rejection.Queued = true
+		queuedRejections = append(queuedRejections, rejection)
+	}
+
+	return commandRejections, queuedRejections
+}
+
+// GetQueuedCommands returns the commands waiting to be sent to Todoist
+func (s *service) GetQueuedCommands() ([]requests.CommandDetail, error) {
+	return s.queueRepository.GetAll()
+}
+
+// FlushQueuedCommands sends all queued commands to Todoist in a single batch, the queue is emptied once Todoist has processed them even if some were rejected.
+// The journal entries of rejected commands are discarded. A failures.Warning is returned when the journal could not be updated.
+func (s *service) FlushQueuedCommands() error {
+	queuedCommandDetails, err := s.queueRepository.GetAll()
+	if err != nil {
+		return err
+	}
+
+	if len(queuedCommandDetails) == 0 {
+		return nil
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	batch := requests.Command{
+		Token:    accessToken.AccessToken,
+		Commands: queuedCommandDetails,
+	}
+
+	response, err := s.api.ExecuteSyncCommand(batch)
+	if err == todoist.ErrUnreachable {
+		return err
+	}
+	if err != nil && !todoist.IsCommandRejection(err) {
+		return failures.Wrap(err, errorFailedToFlushQueue)
+	}
+
+	var journalErr error
+	if response != nil {
+		journalErr = s.journalRepository.AddTemporaryIDMapping(response.TempIDMapping)
+	}
+	if journalErr == nil {
+		journalErr = s.discardRejectedCommands(err)
+	}
+
+	deleteErr := s.queueRepository.DeleteAll()
+	if deleteErr != nil {
+		return deleteErr
+	}
+
+	_, queuedRejections := separateRejections(err, requests.Command{})
+	if len(queuedRejections) > 0 {
+		return queuedRejections
+	}
+	if journalErr != nil {
+		return failures.NewWarning(failures.Wrap(journalErr, errorFailedToJournalOperation))
+	}
+
+	return nil
+}
+
+// DropQueuedCommands discards all queued commands along with their journal entries. The replica is cleared since it contains the optimistic
+// changes of the discarded commands. A failures.Warning is returned when the journal entries could not be discarded.
+func (s *service) DropQueuedCommands() error {
+	queuedCommandDetails, err := s.queueRepository.GetAll()
+	if err != nil {
+		return err
+	}
+
+	err = s.queueRepository.DeleteAll()
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Delete()
+	if err != nil {
+		return err
+	}
+
+	var uuids []string
+	for _, commandDetail := range queuedCommandDetails {
+		uuids = append(uuids, commandDetail.UUID)
+	}
+
+	err = s.journalRepository.DiscardCommands(uuids)
+	if err != nil {
+		return failures.NewWarning(failures.Wrap(err, errorFailedToJournalDrop))
+	}
+
+	return nil
+}
+
+// History returns the journal of the operations sent to Todoist
+func (s *service) History() (*journal.Journal, error) {
+	return s.journalRepository.Get()
+}
+
+// JournalQuickAdd journals the item added with Todoist's quick add, which is not sent as a command, so that it can be undone by deleting
+// the item. A failures.Warning is returned when it could not be journaled since the item was added regardless.
+func (s *service) JournalQuickAdd(item *responses.Item) error {
+	err := s.journalRepository.Add(journal.Entry{
+		ID:          guid.NewString(),
+		Time:        time.Now(),
+		Description: fmt.Sprintf("quick add task '%s'", item.Content),
+		Inverse: []requests.CommandDetail{
+			{Type: commands.ItemDelete, Arguments: map[string]interface{}{"id": item.TodoistID}},
+		},
+		Reversible: true,
+	})
+	if err != nil {
+		return failures.NewWarning(failures.Wrap(err, errorFailedToJournalOperation))
+	}
+
+	return nil
+}
+
+// Undo sends the commands that reverse the most recent operations that have not been undone yet, steps is the number of operations to undo.
+// No operation is undone when one of them cannot be reversed, unless skipIrreversible is true in which case operations that cannot be reversed
+// are passed over. The undone operations are returned, most recent first, along with a failures.Warning when the undo could not be journaled or
+// queued commands sent along with it were rejected.
+func (s *service) Undo(steps int, skipIrreversible bool) ([]journal.Entry, error) {
+	if steps < 1 {
+		return nil, failures.New(failures.Validation, errorInvalidUndoSteps)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	history, err := s.journalRepository.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	operations := history.LastOperations(steps, skipIrreversible)
+	if len(operations) == 0 {
+		return nil, failures.New(failures.NotFound, errorNothingToUndo)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
+
+	var undoneIDs []string
+	var descriptions []string
+	for _, operation := range operations {
+		if !operation.Reversible {
+			return nil, failures.Newf(failures.Validation, errorIrreversibleOperation, operation.Description)
+		}
+
+		for _, inverseCommand := range operation.Inverse {
+			builder.Add(inverseCommand.Type, inverseCommand.Arguments)
+		}
+		undoneIDs = append(undoneIDs, operation.ID)
+		descriptions = append(descriptions, operation.Description)
+	}
+
+	command := builder.Build()
+	command = command.ResolveTemporaryIDs(history.TempIDMapping)
+
+	temporaryIDMapping, queuedRejections, err := s.execute(command)
+	journalErr := s.journalRepository.AddTemporaryIDMapping(temporaryIDMapping)
+	if journalErr == nil {
+		journalErr = s.discardRejectedCommands(queuedRejections, err)
+	}
+	if todoist.IsCommandRejection(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, failures.Wrap(err, errorFailedToUndo)
+	}
+
+	if journalErr == nil {
+		journalErr = s.journalRepository.Add(journal.Entry{
+			ID:          guid.NewString(),
+			Time:        time.Now(),
+			Description: "undo " + strings.Join(descriptions, "; "),
+			Commands:    command.Commands,
+			Undoes:      undoneIDs,
+		})
+	}
+	if journalErr != nil {
+		journalErr = failures.Wrap(journalErr, errorFailedToJournalUndo)
+	}
+
+	return operations, failures.NewWarning(queuedRejections, journalErr)
+}
+
+// discardRejectedCommands discards the journal entries of the commands Todoist rejected, since they were never applied
+func (s *service) discardRejectedCommands(errs ...error) error {
+	var uuids []string
+	for _, err := range errs {
+		rejections, _ := err.(todoist.CommandErrors)
+		for _, rejection := range rejections {
+			uuids = append(uuids, rejection.UUID)
+		}
+	}
+
+	return s.journalRepository.DiscardCommands(uuids)
+}
+
+// journalEntry creates the journal entry of the command, the commands that reverse it are determined from the replica as it is before the
+// command is sent, with each command of the batch applied before reversing the next
+func (s *service) journalEntry(command requests.Command) journal.Entry {
+	entry := journal.Entry{
+		ID:         guid.NewString(),
+		Time:       time.Now(),
+		Commands:   command.Commands,
+		Reversible: true,
+	}
+
+	replica, err := s.repository.Get()
+	if err != nil {
+		replica = &types.Replica{}
+	}
+
+	var descriptions []string
+	for _, commandDetail := range command.Commands {
+		descriptions = append(descriptions, replica.DescribeCommand(commandDetail))
+
+		inverseCommands, isReversible := replica.InverseCommands(commandDetail)
+		if !isReversible {
+			entry.Reversible = false
+		}
+		entry.Inverse = append(inverseCommands, entry.Inverse...)
+
+		replica.ApplyCommands([]requests.CommandDetail{commandDetail})
+	}
+	entry.Description = strings.Join(descriptions, ", ")
+
+	return entry
+}
+
+func (s *service) queueCommand(command requests.Command) error {
+	err := s.queueRepository.Add(command.Commands...)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToQueueCommand)
+	}
+
+	replica, err := s.repository.Get()
+	if err != nil {
+		return failures.Wrap(err, errorFailedToApplyQueuedCommand)
+	}
+
+	replica.ApplyCommands(command.Commands)
+	err = s.repository.Update(replica)
+	if err != nil {
+		return failures.Wrap(err, errorFailedToApplyQueuedCommand)
+	}
+
+	return nil
+}
+
+func (s *service) offlineReplica(replica *types.Replica) (*types.Replica, error) {
+	if replica.RequiresFullSync() {
+		return nil, failures.New(failures.Network, errorOfflineWithoutReplica)
+	}
+
+	return replica, nil
+}
@@ -0,0 +1,719 @@
+package replica
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
+	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/queue"
+	"github.com/kpdowns/todoist-cli/replica/types"
+	"github.com/kpdowns/todoist-cli/todoist"
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncingTheReplica(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+		AccessTokenToReturn:        "access-token",
+	}
+
+	t.Run("When syncing and the client is not authenticated, then an error is returned", func(t *testing.T) {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		service := NewReplicaService(&mocks.MockAPI{}, mockAuthenticationService, NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, replica)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+		}
+	})
+
+	t.Run("When syncing and no sync token has been persisted, then a full sync of all resource types is requested", func(t *testing.T) {
+		var executedQuery requests.Query
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				executedQuery = query
+				return &responses.Query{IsFullSync: true, SyncToken: "new-token"}, nil
+			},
+		}
+
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		_, err := service.Sync()
+		assert.Nil(t, err)
+		assert.Equal(t, "access-token", executedQuery.Token)
+		assert.Equal(t, fullSyncToken, executedQuery.SyncToken)
+		assert.Equal(t, resourceTypes, executedQuery.ResourceTypes)
+	})
+
+	t.Run("When syncing and a sync token has been persisted, then only changes since the last sync are requested and applied", func(t *testing.T) {
+		var executedQuery requests.Query
+
+		persistedReplica, _ := json.Marshal(&types.Replica{
+			SyncToken:     "persisted-token",
+			ResourceTypes: resourceTypes,
+			Items: []responses.Item{
+				{TodoistID: 1, Content: "first"},
+				{TodoistID: 2, Content: "second"},
+			},
+		})
+		file := &mocks.MockFile{Contents: string(persistedReplica)}
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				executedQuery = query
+				return &responses.Query{
+					SyncToken: "new-token",
+					Items: []responses.Item{
+						{TodoistID: 1, IsDeleted: 1},
+						{TodoistID: 3, Content: "third"},
+					},
+				}, nil
+			},
+		}
+
+		repository := NewReplicaRepository(file)
+		service := NewReplicaService(mockAPI, authenticated, repository, queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, err)
+		assert.Equal(t, "persisted-token", executedQuery.SyncToken)
+		assert.Equal(t, []responses.Item{
+			{TodoistID: 2, Content: "second"},
+			{TodoistID: 3, Content: "third"},
+		}, replica.Items)
+
+		storedReplica, _ := repository.Get()
+		assert.Equal(t, replica, storedReplica)
+		assert.Equal(t, "new-token", storedReplica.SyncToken)
+	})
+
+	t.Run("When syncing a replica that was synced without some of the resource types, then a full sync is requested", func(t *testing.T) {
+		var executedQuery requests.Query
+
+		persistedReplica, _ := json.Marshal(&types.Replica{
+			SyncToken:     "persisted-token",
+			ResourceTypes: requests.ResourceTypes{"items", "projects", "sections"},
+		})
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				executedQuery = query
+				return &responses.Query{IsFullSync: true, SyncToken: "new-token"}, nil
+			},
+		}
+
+		repository := NewReplicaRepository(&mocks.MockFile{Contents: string(persistedReplica)})
+		service := NewReplicaService(mockAPI, authenticated, repository, queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, err)
+		assert.Equal(t, fullSyncToken, executedQuery.SyncToken)
+		assert.Equal(t, resourceTypes, replica.ResourceTypes)
+	})
+
+	t.Run("When syncing with a sync token and Todoist answers with a full sync, then the replica is replaced", func(t *testing.T) {
+		persistedReplica, _ := json.Marshal(&types.Replica{
+			SyncToken: "expired-token",
+			Items:     []responses.Item{{TodoistID: 1}},
+		})
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					IsFullSync: true,
+					SyncToken:  "new-token",
+					Items:      []responses.Item{{TodoistID: 2}},
+				}, nil
+			},
+		}
+
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{Contents: string(persistedReplica)}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, err)
+		assert.Equal(t, []responses.Item{{TodoistID: 2}}, replica.Items)
+	})
+
+	t.Run("When syncing and an error is returned from the API, then an error is returned and the replica is untouched", func(t *testing.T) {
+		file := &mocks.MockFile{Contents: `{"SyncToken":"persisted-token"}`}
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(file), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, replica)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorOccurredDuringSyncOperation, err.Error())
+		}
+		assert.Equal(t, `{"SyncToken":"persisted-token"}`, file.Contents)
+	})
+
+	t.Run("When clearing the replica, then the next sync is a full sync", func(t *testing.T) {
+		var executedQuery requests.Query
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				executedQuery = query
+				return &responses.Query{SyncToken: "new-token"}, nil
+			},
+		}
+
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{Contents: `{"SyncToken":"persisted-token"}`}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		err := service.Clear()
+		assert.Nil(t, err)
+
+		service.Sync()
+		assert.Equal(t, fullSyncToken, executedQuery.SyncToken)
+	})
+
+	t.Run("When clearing the replica, then the history of operations is cleared so it cannot be undone by the next account", func(t *testing.T) {
+		journalRepository := journal.NewJournalRepository(&mocks.MockFile{})
+		journalRepository.Add(journal.Entry{ID: "1", Description: "complete task 'Buy milk'", Reversible: true})
+
+		service := NewReplicaService(&mocks.MockAPI{}, authenticated, NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journalRepository)
+
+		err := service.Clear()
+		assert.Nil(t, err)
+
+		history, _ := service.History()
+		assert.Empty(t, history.Entries)
+	})
+
+}
+
+func TestReadingTheCachedReplica(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	t.Run("When reading the cached replica, then the persisted replica is returned without contacting Todoist", func(t *testing.T) {
+		persistedReplica, _ := json.Marshal(&types.Replica{
+			SyncToken: "persisted-token",
+			Items:     []responses.Item{{TodoistID: 1}},
+		})
+
+		repository := NewReplicaRepository(&mocks.MockFile{Contents: string(persistedReplica)})
+		service := NewReplicaService(&mocks.MockAPI{}, authenticated, repository, queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Cached()
+		assert.Nil(t, err)
+		assert.Equal(t, []responses.Item{{TodoistID: 1}}, replica.Items)
+	})
+
+	t.Run("When reading the cached replica and it has never been synced, then a not found error is returned", func(t *testing.T) {
+		repository := NewReplicaRepository(&mocks.MockFile{})
+		service := NewReplicaService(&mocks.MockAPI{}, authenticated, repository, queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		_, err := service.Cached()
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorNoCachedReplica, err.Error())
+			assert.Equal(t, failures.NotFound, failures.KindOf(err))
+		}
+	})
+
+}
+
+func TestWorkingOffline(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+		AccessTokenToReturn:        "access-token",
+	}
+
+	unreachableAPI := &mocks.MockAPI{
+		ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+			return nil, todoist.ErrUnreachable
+		},
+		ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+			return nil, todoist.ErrUnreachable
+		},
+	}
+
+	itemAddCommand := requests.Command{
+		Token: "access-token",
+		Commands: []requests.CommandDetail{
+			{Type: commands.ItemAdd, TemporaryID: "temp-1", UUID: "uuid-1", Arguments: map[string]interface{}{"content": "test"}},
+		},
+	}
+
+	t.Run("When executing a command and Todoist cannot be reached, then the command is queued and applied to the replica", func(t *testing.T) {
+		replicaRepository := NewReplicaRepository(&mocks.MockFile{Contents: `{"SyncToken":"persisted-token"}`})
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		service := NewReplicaService(unreachableAPI, authenticated, replicaRepository, queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		_, err := service.ExecuteCommand(itemAddCommand)
+		assert.Nil(t, err)
+
+		queuedCommands, _ := service.GetQueuedCommands()
+		if assert.Len(t, queuedCommands, 1) {
+			assert.Equal(t, "temp-1", queuedCommands[0].TemporaryID)
+		}
+
+		replica, _ := replicaRepository.Get()
+		assert.Equal(t, []responses.Item{{TemporaryID: "temp-1", Content: "test", Priority: 1}}, replica.Items)
+	})
+
+	t.Run("When executing a command while Todoist cannot be reached and the replica cannot be read or written, then the command is queued and an error is returned", func(t *testing.T) {
+		for _, replicaFile := range []*mocks.MockFile{{ReadError: errors.New("test error")}, {OverwriteError: errors.New("test error")}} {
+			queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+			service := NewReplicaService(unreachableAPI, authenticated, NewReplicaRepository(replicaFile), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+			_, err := service.ExecuteCommand(itemAddCommand)
+			if assert.NotNil(t, err) {
+				assert.Equal(t, errorFailedToApplyQueuedCommand, err.Error())
+			}
+
+			queuedCommands, _ := queueRepository.GetAll()
+			assert.Len(t, queuedCommands, 1)
+		}
+	})
+
+	t.Run("When executing a command and Todoist rejects it, then the error is returned and nothing is queued", func(t *testing.T) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		_, err := service.ExecuteCommand(itemAddCommand)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, "test error", err.Error())
+		}
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Empty(t, queuedCommands)
+	})
+
+	t.Run("When executing a command while commands are queued, then the queued commands are sent first in the same batch", func(t *testing.T) {
+		var executedCommand requests.Command
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		queueRepository.Add(requests.CommandDetail{Type: commands.ItemClose, UUID: "queued"})
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		_, err := service.ExecuteCommand(itemAddCommand)
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 2) {
+			assert.Equal(t, "queued", executedCommand.Commands[0].UUID)
+			assert.Equal(t, "uuid-1", executedCommand.Commands[1].UUID)
+		}
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Empty(t, queuedCommands)
+	})
+
+	t.Run("When executing a command, then the ids Todoist assigned to temporary ids are returned", func(t *testing.T) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{TempIDMapping: map[string]int64{"temp-1": 12345}}, nil
+			},
+		}
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		temporaryIDMapping, err := service.ExecuteCommand(itemAddCommand)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]int64{"temp-1": 12345}, temporaryIDMapping)
+	})
+
+	t.Run("When executing a command while commands are queued and Todoist rejects one of them, then the command succeeds, the rejection is reported as a queued command's and the queue is emptied", func(t *testing.T) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{TempIDMapping: map[string]int64{"temp-1": 12345}}, todoist.CommandErrors{{UUID: "queued", Code: 22, Message: "Item not found"}}
+			},
+		}
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		queueRepository.Add(requests.CommandDetail{Type: commands.ItemClose, UUID: "queued"})
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		temporaryIDMapping, err := service.ExecuteCommand(itemAddCommand)
+		assert.Equal(t, map[string]int64{"temp-1": 12345}, temporaryIDMapping)
+		assert.True(t, failures.IsWarning(err))
+		assert.Equal(t, todoist.CommandErrors{{UUID: "queued", Code: 22, Message: "Item not found", Queued: true}}, errors.Unwrap(err))
+
+		history, _ := service.History()
+		assert.Len(t, history.Entries, 1)
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Empty(t, queuedCommands)
+	})
+
+	t.Run("When executing a command while commands are queued and Todoist rejects the command, then its rejection is returned before those of queued commands", func(t *testing.T) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{}, todoist.CommandErrors{
+					{UUID: "queued", Code: 22, Message: "Item not found"},
+					{UUID: "uuid-1", Code: 36, Message: "Date is invalid"},
+				}
+			},
+		}
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		queueRepository.Add(requests.CommandDetail{Type: commands.ItemClose, UUID: "queued"})
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		_, err := service.ExecuteCommand(itemAddCommand)
+		assert.Equal(t, todoist.CommandErrors{
+			{UUID: "uuid-1", Code: 36, Message: "Date is invalid"},
+			{UUID: "queued", Code: 22, Message: "Item not found", Queued: true},
+		}, err)
+
+		history, _ := service.History()
+		assert.Empty(t, history.Entries)
+	})
+
+	t.Run("When syncing and Todoist cannot be reached, then the local replica is returned", func(t *testing.T) {
+		replicaRepository := NewReplicaRepository(&mocks.MockFile{Contents: `{"SyncToken":"persisted-token","Items":[{"id":1}]}`})
+		service := NewReplicaService(unreachableAPI, authenticated, replicaRepository, queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, err)
+		assert.Equal(t, []responses.Item{{TodoistID: 1}}, replica.Items)
+	})
+
+	t.Run("When syncing and Todoist cannot be reached and there has never been a sync, then an error is returned", func(t *testing.T) {
+		service := NewReplicaService(unreachableAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, replica)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorOfflineWithoutReplica, err.Error())
+		}
+	})
+
+	t.Run("When syncing while commands are queued, then they are flushed and the optimistic resources are replaced by the synced ones", func(t *testing.T) {
+		var executedCommand requests.Command
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					SyncToken: "new-token",
+					Items:     []responses.Item{{TodoistID: 2, Content: "test"}},
+				}, nil
+			},
+		}
+		replicaRepository := NewReplicaRepository(&mocks.MockFile{Contents: `{"SyncToken":"persisted-token","Items":[{"id":0,"temp_id":"temp-1","content":"test"}]}`})
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		queueRepository.Add(itemAddCommand.Commands...)
+		service := NewReplicaService(mockAPI, authenticated, replicaRepository, queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, err)
+		assert.Equal(t, "access-token", executedCommand.Token)
+		assert.Len(t, executedCommand.Commands, 1)
+		assert.Equal(t, []responses.Item{{TodoistID: 2, Content: "test"}}, replica.Items)
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Empty(t, queuedCommands)
+	})
+
+	t.Run("When syncing and the queued commands are rejected, then an error is returned and the commands stay queued", func(t *testing.T) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		queueRepository.Add(itemAddCommand.Commands...)
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		replica, err := service.Sync()
+		assert.Nil(t, replica)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorFailedToFlushQueue, err.Error())
+		}
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Len(t, queuedCommands, 1)
+	})
+
+	t.Run("When dropping the queued commands, then the queue is emptied and the replica is cleared", func(t *testing.T) {
+		replicaFile := &mocks.MockFile{Contents: `{"SyncToken":"persisted-token"}`}
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		queueRepository.Add(itemAddCommand.Commands...)
+		service := NewReplicaService(unreachableAPI, authenticated, NewReplicaRepository(replicaFile), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		err := service.DropQueuedCommands()
+		assert.Nil(t, err)
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Empty(t, queuedCommands)
+		assert.Equal(t, "", replicaFile.Contents)
+	})
+
+	t.Run("When dropping commands queued while offline, then their operations are removed from the history", func(t *testing.T) {
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		service := NewReplicaService(unreachableAPI, authenticated, NewReplicaRepository(&mocks.MockFile{Contents: `{"SyncToken":"persisted-token"}`}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+		service.ExecuteCommand(itemAddCommand)
+
+		err := service.DropQueuedCommands()
+		assert.Nil(t, err)
+
+		history, _ := service.History()
+		assert.Empty(t, history.Entries)
+	})
+
+	t.Run("When dropping the queued commands and their operations cannot be removed from the history, then a warning is returned", func(t *testing.T) {
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		queueRepository.Add(itemAddCommand.Commands...)
+		journalFile := &mocks.MockFile{}
+		journal.NewJournalRepository(journalFile).Add(journal.Entry{ID: "entry-1", Commands: itemAddCommand.Commands, Reversible: true})
+		journalFile.OverwriteError = errors.New("test error")
+		service := NewReplicaService(unreachableAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(journalFile))
+
+		err := service.DropQueuedCommands()
+		assert.True(t, failures.IsWarning(err))
+		assert.Equal(t, errorFailedToJournalDrop, err.Error())
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Empty(t, queuedCommands)
+	})
+
+	t.Run("When a command queued while offline is rejected once it is sent, then its operation is removed from the history", func(t *testing.T) {
+		isOnline := false
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				if !isOnline {
+					return nil, todoist.ErrUnreachable
+				}
+				return nil, todoist.CommandErrors{{UUID: "uuid-1", Code: 36, Message: "Date is invalid"}}
+			},
+		}
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{Contents: `{"SyncToken":"persisted-token"}`}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+		service.ExecuteCommand(itemAddCommand)
+
+		isOnline = true
+		err := service.FlushQueuedCommands()
+		assert.True(t, todoist.IsCommandRejection(err))
+
+		history, _ := service.History()
+		assert.Empty(t, history.Entries)
+	})
+
+}
+
+func TestUndoingOperations(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+		AccessTokenToReturn:        "access-token",
+	}
+
+	persistedReplica, _ := json.Marshal(&types.Replica{
+		SyncToken: "persisted-token",
+		Items: []responses.Item{
+			{TodoistID: 1, Content: "Buy milk", Priority: 1},
+			{TodoistID: 2, Content: "Call the bank", Priority: 1},
+		},
+	})
+
+	newService := func(executedCommands *[]requests.Command) (Service, journal.Repository) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				*executedCommands = append(*executedCommands, command)
+				mapping := make(map[string]int64)
+				for _, commandDetail := range command.Commands {
+					if commandDetail.Type == commands.ItemAdd {
+						mapping[commandDetail.TemporaryID] = 3
+					}
+				}
+				return &responses.Command{TempIDMapping: mapping}, nil
+			},
+		}
+		journalRepository := journal.NewJournalRepository(&mocks.MockFile{})
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{Contents: string(persistedReplica)}), queue.NewQueueRepository(&mocks.MockFile{}), journalRepository)
+		return service, journalRepository
+	}
+
+	t.Run("When executing a command, then it is journaled with its description and the commands that reverse it", func(t *testing.T) {
+		var executedCommands []requests.Command
+		service, _ := newService(&executedCommands)
+
+		_, err := service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(1)}))
+		assert.Nil(t, err)
+
+		history, err := service.History()
+		assert.Nil(t, err)
+		if assert.Len(t, history.Entries, 1) {
+			assert.Equal(t, "complete task 'Buy milk'", history.Entries[0].Description)
+			assert.True(t, history.Entries[0].Reversible)
+			assert.Equal(t, []requests.CommandDetail{{Type: commands.ItemUncomplete, Arguments: map[string]interface{}{"id": float64(1)}}}, history.Entries[0].Inverse)
+		}
+	})
+
+	t.Run("When executing a command and Todoist rejects it, then it is not journaled", func(t *testing.T) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(1)}))
+
+		history, _ := service.History()
+		assert.Empty(t, history.Entries)
+	})
+
+	t.Run("When undoing the last operations, then their inverse commands are sent with temporary ids replaced by the ids Todoist assigned", func(t *testing.T) {
+		var executedCommands []requests.Command
+		service, _ := newService(&executedCommands)
+
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(1)}))
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemAdd, map[string]interface{}{"content": "Pay rent"}))
+
+		undoneOperations, err := service.Undo(2, false)
+		assert.Nil(t, err)
+		if assert.Len(t, undoneOperations, 2) {
+			assert.Equal(t, "add task 'Pay rent'", undoneOperations[0].Description)
+			assert.Equal(t, "complete task 'Buy milk'", undoneOperations[1].Description)
+		}
+
+		undoCommand := executedCommands[len(executedCommands)-1]
+		if assert.Len(t, undoCommand.Commands, 2) {
+			assert.Equal(t, commands.ItemDelete, undoCommand.Commands[0].Type)
+			assert.Equal(t, int64(3), undoCommand.Commands[0].Arguments["id"])
+			assert.Equal(t, commands.ItemUncomplete, undoCommand.Commands[1].Type)
+			assert.Equal(t, float64(1), undoCommand.Commands[1].Arguments["id"])
+		}
+
+		history, _ := service.History()
+		if assert.Len(t, history.Entries, 3) {
+			assert.True(t, history.Entries[2].IsUndo())
+			assert.True(t, history.IsUndone(history.Entries[0].ID))
+			assert.True(t, history.IsUndone(history.Entries[1].ID))
+		}
+	})
+
+	t.Run("When undoing one operation at a time, then each undo goes further back", func(t *testing.T) {
+		var executedCommands []requests.Command
+		service, _ := newService(&executedCommands)
+
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(1)}))
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(2)}))
+
+		first, _ := service.Undo(1, false)
+		second, _ := service.Undo(1, false)
+		_, err := service.Undo(1, false)
+
+		assert.Equal(t, "complete task 'Call the bank'", first[0].Description)
+		assert.Equal(t, "complete task 'Buy milk'", second[0].Description)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorNothingToUndo, err.Error())
+			assert.Equal(t, failures.NotFound, failures.KindOf(err))
+		}
+	})
+
+	t.Run("When one of the operations cannot be reversed, then nothing is undone", func(t *testing.T) {
+		var executedCommands []requests.Command
+		service, _ := newService(&executedCommands)
+
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemDelete, map[string]interface{}{"id": int64(1)}))
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(2)}))
+		executedCommands = nil
+
+		_, err := service.Undo(2, false)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, fmt.Sprintf(errorIrreversibleOperation, "delete task 'Buy milk'"), err.Error())
+			assert.Equal(t, failures.Validation, failures.KindOf(err))
+		}
+		assert.Empty(t, executedCommands)
+	})
+
+	t.Run("When operations that cannot be reversed are skipped, then the operations before them are undone", func(t *testing.T) {
+		var executedCommands []requests.Command
+		service, _ := newService(&executedCommands)
+
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(2)}))
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemDelete, map[string]interface{}{"id": int64(1)}))
+
+		undoneOperations, err := service.Undo(1, true)
+		assert.Nil(t, err)
+		if assert.Len(t, undoneOperations, 1) {
+			assert.Equal(t, "complete task 'Call the bank'", undoneOperations[0].Description)
+		}
+
+		undoCommand := executedCommands[len(executedCommands)-1]
+		if assert.Len(t, undoCommand.Commands, 1) {
+			assert.Equal(t, commands.ItemUncomplete, undoCommand.Commands[0].Type)
+		}
+	})
+
+	t.Run("When a command is sent but cannot be journaled, then the ids Todoist assigned are returned along with a warning", func(t *testing.T) {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{TempIDMapping: map[string]int64{"temp-1": 3}}, nil
+			},
+		}
+		journalRepository := journal.NewJournalRepository(&mocks.MockFile{OverwriteError: errors.New("test error")})
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{Contents: string(persistedReplica)}), queue.NewQueueRepository(&mocks.MockFile{}), journalRepository)
+
+		temporaryIDMapping, err := service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(1)}))
+		assert.Equal(t, map[string]int64{"temp-1": 3}, temporaryIDMapping)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorFailedToJournalOperation, err.Error())
+			assert.True(t, failures.IsWarning(err))
+		}
+	})
+
+	t.Run("When operations are undone but the undo cannot be journaled, then the undone operations are returned along with a warning", func(t *testing.T) {
+		journalFile := &mocks.MockFile{}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{}, nil
+			},
+		}
+		service := NewReplicaService(mockAPI, authenticated, NewReplicaRepository(&mocks.MockFile{Contents: string(persistedReplica)}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(journalFile))
+
+		service.ExecuteCommand(requests.NewCommand("access-token", commands.ItemClose, map[string]interface{}{"id": int64(1)}))
+		journalFile.OverwriteError = errors.New("test error")
+
+		undoneOperations, err := service.Undo(1, false)
+		assert.Len(t, undoneOperations, 1)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorFailedToJournalUndo, err.Error())
+			assert.True(t, failures.IsWarning(err))
+		}
+	})
+
+	t.Run("When undoing fewer than one operation, then a validation error is returned", func(t *testing.T) {
+		var executedCommands []requests.Command
+		service, _ := newService(&executedCommands)
+
+		_, err := service.Undo(0, false)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorInvalidUndoSteps, err.Error())
+		}
+	})
+
+}
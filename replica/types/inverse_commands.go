@@ -0,0 +1,268 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+)
+
+// InverseCommands returns the commands that reverse the command, given the replica as it was before the command was sent. Only the type
+// and arguments of the inverse commands are set. False is returned when the command cannot be reversed, such as when it deleted a resource
+// or changed a resource that is not in the replica.
+func (r *Replica) InverseCommands(commandDetail requests.CommandDetail) ([]requests.CommandDetail, bool) {
+	arguments := commandDetail.Arguments
+
+	switch commandDetail.Type {
+	case commands.ItemAdd:
+		return inverse(commands.ItemDelete, map[string]interface{}{"id": commandDetail.TemporaryID}), true
+
+	case commands.ItemClose, commands.ItemComplete:
+		item := r.findItem(arguments["id"])
+		if item == nil {
+			return nil, false
+		}
+		if commandDetail.Type == commands.ItemClose && item.IsRecurring() {
+			return inverse(commands.ItemUpdate, map[string]interface{}{"id": arguments["id"], "due": dueOf(item)}), true
+		}
+
+		var inverseCommands []requests.CommandDetail
+		for _, checkedItem := range r.uncheckedItemsClosedWith(item) {
+			inverseCommands = append(inverseCommands, inverse(commands.ItemUncomplete, map[string]interface{}{"id": itemReference(checkedItem)})...)
+		}
+		return inverseCommands, true
+
+	case commands.ItemUncomplete:
+		return inverse(commands.ItemComplete, map[string]interface{}{"id": arguments["id"]}), true
+
+	case commands.ItemUpdate:
+		item := r.findItem(arguments["id"])
+		if item == nil {
+			return nil, false
+		}
+
+		previousValues := map[string]interface{}{"id": arguments["id"]}
+		for key := range arguments {
+			switch key {
+			case "content":
+				previousValues[key] = item.Content
+			case "description":
+				previousValues[key] = item.Description
+			case "priority":
+				previousValues[key] = item.Priority
+			case "due":
+				previousValues[key] = dueOf(item)
+			case "deadline":
+				previousValues[key] = deadlineOf(item)
+			case "labels":
+				labels := make([]interface{}, 0, len(item.Labels))
+				for _, labelID := range item.Labels {
+					labels = append(labels, labelID)
+				}
+				previousValues[key] = labels
+			}
+		}
+		return inverse(commands.ItemUpdate, previousValues), true
+
+	case commands.ItemMove:
+		item := r.findItem(arguments["id"])
+		if item == nil {
+			return nil, false
+		}
+
+		switch {
+		case item.ParentID != 0:
+			return inverse(commands.ItemMove, map[string]interface{}{"id": arguments["id"], "parent_id": item.ParentID}), true
+		case item.SectionID != 0:
+			return inverse(commands.ItemMove, map[string]interface{}{"id": arguments["id"], "section_id": item.SectionID}), true
+		case item.ProjectID != 0:
+			return inverse(commands.ItemMove, map[string]interface{}{"id": arguments["id"], "project_id": item.ProjectID}), true
+		}
+		return nil, false
+
+	case commands.ItemReorder:
+		orderedItems, _ := arguments["items"].([]interface{})
+		var previousOrders []interface{}
+		for _, orderedItem := range orderedItems {
+			order, _ := orderedItem.(map[string]interface{})
+			item := r.findItem(order["id"])
+			if item == nil {
+				return nil, false
+			}
+			previousOrders = append(previousOrders, map[string]interface{}{"id": order["id"], "child_order": item.ChildOrder})
+		}
+		return inverse(commands.ItemReorder, map[string]interface{}{"items": previousOrders}), true
+
+	case commands.ItemUpdateDayOrders:
+		idsToOrders, _ := arguments["ids_to_orders"].(map[string]interface{})
+		previousOrders := make(map[string]interface{})
+		for reference := range idsToOrders {
+			item := r.findItem(reference)
+			if item == nil {
+				return nil, false
+			}
+			previousOrders[reference] = item.DayOrder
+		}
+		return inverse(commands.ItemUpdateDayOrders, map[string]interface{}{"ids_to_orders": previousOrders}), true
+
+	case commands.ProjectAdd:
+		return inverse(commands.ProjectDelete, map[string]interface{}{"id": commandDetail.TemporaryID}), true
+
+	case commands.ProjectUpdate:
+		project := r.findProject(arguments["id"])
+		if project == nil {
+			return nil, false
+		}
+		return inverse(commands.ProjectUpdate, map[string]interface{}{"id": arguments["id"], "name": project.Name}), true
+
+	case commands.ProjectArchive:
+		return inverse(commands.ProjectUnarchive, map[string]interface{}{"id": arguments["id"]}), true
+
+	case commands.ProjectUnarchive:
+		return inverse(commands.ProjectArchive, map[string]interface{}{"id": arguments["id"]}), true
+
+	case commands.LabelAdd:
+		return inverse(commands.LabelDelete, map[string]interface{}{"id": commandDetail.TemporaryID}), true
+
+	case commands.LabelUpdate:
+		label := r.findLabel(arguments["id"])
+		if label == nil {
+			return nil, false
+		}
+		return inverse(commands.LabelUpdate, map[string]interface{}{"id": arguments["id"], "name": label.Name}), true
+
+	case commands.NoteAdd:
+		return inverse(commands.NoteDelete, map[string]interface{}{"id": commandDetail.TemporaryID}), true
+	}
+
+	return nil, false
+}
+
+// DescribeCommand returns a short description of what the command does, given the replica as it was before the command was sent
+func (r *Replica) DescribeCommand(commandDetail requests.CommandDetail) string {
+	arguments := commandDetail.Arguments
+
+	switch commandDetail.Type {
+	case commands.ItemAdd:
+		return fmt.Sprintf("add task '%s'", asString(arguments["content"]))
+	case commands.ItemClose, commands.ItemComplete:
+		return "complete " + r.describeItem(arguments["id"])
+	case commands.ItemUncomplete:
+		return "uncomplete " + r.describeItem(arguments["id"])
+	case commands.ItemUpdate:
+		return "update " + r.describeItem(arguments["id"])
+	case commands.ItemDelete:
+		return "delete " + r.describeItem(arguments["id"])
+	case commands.ItemMove:
+		return "move " + r.describeItem(arguments["id"])
+	case commands.ItemReorder, commands.ItemUpdateDayOrders:
+		return "reorder tasks"
+	case commands.ProjectAdd:
+		return fmt.Sprintf("add project '%s'", asString(arguments["name"]))
+	case commands.ProjectUpdate, commands.ProjectArchive, commands.ProjectUnarchive, commands.ProjectDelete:
+		name := "a project"
+		if project := r.findProject(arguments["id"]); project != nil {
+			name = fmt.Sprintf("'%s'", project.Name)
+		}
+		return fmt.Sprintf("%s project %s", projectVerbs[commandDetail.Type], name)
+	case commands.LabelAdd:
+		return fmt.Sprintf("add label '%s'", asString(arguments["name"]))
+	case commands.LabelUpdate, commands.LabelDelete:
+		name := "a label"
+		if label := r.findLabel(arguments["id"]); label != nil {
+			name = fmt.Sprintf("'%s'", label.Name)
+		}
+		if commandDetail.Type == commands.LabelDelete {
+			return "delete label " + name
+		}
+		return "rename label " + name
+	case commands.NoteAdd:
+		if projectID, ok := arguments["project_id"]; ok {
+			name := "a project"
+			if project := r.findProject(projectID); project != nil {
+				name = fmt.Sprintf("'%s'", project.Name)
+			}
+			return "comment on project " + name
+		}
+		return "comment on " + r.describeItem(arguments["item_id"])
+	case commands.NoteDelete:
+		return "delete a comment"
+	}
+
+	return string(commandDetail.Type)
+}
+
+var projectVerbs = map[commands.CommandType]string{
+	commands.ProjectUpdate:    "rename",
+	commands.ProjectArchive:   "archive",
+	commands.ProjectUnarchive: "unarchive",
+	commands.ProjectDelete:    "delete",
+}
+
+func (r *Replica) describeItem(reference interface{}) string {
+	if item := r.findItem(reference); item != nil {
+		return fmt.Sprintf("task '%s'", item.Content)
+	}
+	if _, isTemporaryID := reference.(string); isTemporaryID {
+		return "a task added offline"
+	}
+	return fmt.Sprintf("task t:%d", asInt64(reference))
+}
+
+// uncheckedItemsClosedWith returns the item and its unchecked sub-items, which Todoist closes along with the item
+func (r *Replica) uncheckedItemsClosedWith(item *responses.Item) []responses.Item {
+	var items []responses.Item
+	if item.Checked == 0 {
+		items = append(items, *item)
+	}
+	if item.TodoistID == 0 {
+		return items
+	}
+
+	for index := range r.Items {
+		if r.Items[index].ParentID == item.TodoistID {
+			items = append(items, r.uncheckedItemsClosedWith(&r.Items[index])...)
+		}
+	}
+	return items
+}
+
+func inverse(commandType commands.CommandType, arguments map[string]interface{}) []requests.CommandDetail {
+	return []requests.CommandDetail{{Type: commandType, Arguments: arguments}}
+}
+
+// itemReference returns the argument referencing the item in a command, items created while offline are referenced by their temporary id
+func itemReference(item responses.Item) interface{} {
+	if item.TodoistID == 0 {
+		return item.TemporaryID
+	}
+	return item.TodoistID
+}
+
+// deadlineOf returns the deadline that restores the deadline of the item, nil removes the deadline
+func deadlineOf(item *responses.Item) *requests.Deadline {
+	if item.Deadline == nil {
+		return nil
+	}
+	return &requests.Deadline{Date: item.Deadline.Date, Lang: item.Deadline.Lang}
+}
+
+// dueOf returns the due date that restores the due date of the item, nil removes the due date
+func dueOf(item *responses.Item) *requests.Due {
+	if item.Due == nil {
+		return nil
+	}
+
+	date := item.Due.DateString
+	if item.Due.DatetimeString != "" {
+		date = item.Due.DatetimeString
+	}
+	return &requests.Due{
+		Value:       item.Due.String,
+		Date:        date,
+		Timezone:    item.Due.Timezone,
+		Lang:        item.Due.Lang,
+		IsRecurring: item.Due.IsRecurring,
+	}
+}
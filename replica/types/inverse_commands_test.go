@@ -0,0 +1,176 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReversingCommands(t *testing.T) {
+
+	replica := &Replica{
+		Items: []responses.Item{
+			{TodoistID: 1, Content: "Plan trip", ProjectID: 10, Priority: 1, Labels: []int64{100}},
+			{TodoistID: 2, Content: "Book flights", ProjectID: 10, ParentID: 1},
+			{TodoistID: 3, Content: "Book hotel", ProjectID: 10, ParentID: 1, Checked: 1},
+			{TodoistID: 4, Content: "Water plants", ProjectID: 20, SectionID: 200, ChildOrder: 3, DayOrder: 2, Due: &responses.Due{DateString: "2020-04-10", String: "every day", IsRecurring: true}},
+			{TodoistID: 5, Content: "File taxes", Deadline: &responses.Deadline{Date: "2020-05-15", Lang: "en"}},
+		},
+		Projects: []responses.Project{{TodoistID: 10, Name: "Travel"}},
+		Labels:   []responses.Label{{TodoistID: 100, Name: "errand"}},
+	}
+
+	var commandsToTest = []struct {
+		description     string
+		command         requests.CommandDetail
+		expectedInverse []requests.CommandDetail
+	}{
+		{
+			"adding a task deletes the task by its temporary id",
+			requests.CommandDetail{Type: commands.ItemAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "Pack"}},
+			[]requests.CommandDetail{{Type: commands.ItemDelete, Arguments: map[string]interface{}{"id": "temp-1"}}},
+		},
+		{
+			"closing a task uncompletes the task and the sub-tasks that were uncompleted",
+			requests.CommandDetail{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": int64(1)}},
+			[]requests.CommandDetail{
+				{Type: commands.ItemUncomplete, Arguments: map[string]interface{}{"id": int64(1)}},
+				{Type: commands.ItemUncomplete, Arguments: map[string]interface{}{"id": int64(2)}},
+			},
+		},
+		{
+			"closing a recurring task restores its due date",
+			requests.CommandDetail{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": int64(4)}},
+			[]requests.CommandDetail{{Type: commands.ItemUpdate, Arguments: map[string]interface{}{
+				"id":  int64(4),
+				"due": &requests.Due{Value: "every day", Date: "2020-04-10", IsRecurring: true},
+			}}},
+		},
+		{
+			"uncompleting a task completes it",
+			requests.CommandDetail{Type: commands.ItemUncomplete, Arguments: map[string]interface{}{"id": int64(9)}},
+			[]requests.CommandDetail{{Type: commands.ItemComplete, Arguments: map[string]interface{}{"id": int64(9)}}},
+		},
+		{
+			"updating a task restores only the changed properties",
+			requests.CommandDetail{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": int64(1), "content": "Plan holiday", "labels": []interface{}{}, "due": nil}},
+			[]requests.CommandDetail{{Type: commands.ItemUpdate, Arguments: map[string]interface{}{
+				"id":      int64(1),
+				"content": "Plan trip",
+				"labels":  []interface{}{int64(100)},
+				"due":     (*requests.Due)(nil),
+			}}},
+		},
+		{
+			"updating the deadline of a task restores the deadline, or removes it when there was none",
+			requests.CommandDetail{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": int64(5), "deadline": (*requests.Deadline)(nil)}},
+			[]requests.CommandDetail{{Type: commands.ItemUpdate, Arguments: map[string]interface{}{
+				"id":       int64(5),
+				"deadline": &requests.Deadline{Date: "2020-05-15", Lang: "en"},
+			}}},
+		},
+		{
+			"setting the deadline of a task without one removes it",
+			requests.CommandDetail{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": int64(1), "deadline": &requests.Deadline{Date: "2020-05-15"}}},
+			[]requests.CommandDetail{{Type: commands.ItemUpdate, Arguments: map[string]interface{}{
+				"id":       int64(1),
+				"deadline": (*requests.Deadline)(nil),
+			}}},
+		},
+		{
+			"moving a sub-task moves it back below its parent",
+			requests.CommandDetail{Type: commands.ItemMove, Arguments: map[string]interface{}{"id": int64(2), "project_id": int64(20)}},
+			[]requests.CommandDetail{{Type: commands.ItemMove, Arguments: map[string]interface{}{"id": int64(2), "parent_id": int64(1)}}},
+		},
+		{
+			"moving a task in a section moves it back to the section",
+			requests.CommandDetail{Type: commands.ItemMove, Arguments: map[string]interface{}{"id": int64(4), "project_id": int64(10)}},
+			[]requests.CommandDetail{{Type: commands.ItemMove, Arguments: map[string]interface{}{"id": int64(4), "section_id": int64(200)}}},
+		},
+		{
+			"reordering tasks restores their orders",
+			requests.CommandDetail{Type: commands.ItemUpdateDayOrders, Arguments: map[string]interface{}{"ids_to_orders": map[string]interface{}{"4": 1}}},
+			[]requests.CommandDetail{{Type: commands.ItemUpdateDayOrders, Arguments: map[string]interface{}{"ids_to_orders": map[string]interface{}{"4": int32(2)}}}},
+		},
+		{
+			"renaming a project restores its name",
+			requests.CommandDetail{Type: commands.ProjectUpdate, Arguments: map[string]interface{}{"id": int64(10), "name": "Holiday"}},
+			[]requests.CommandDetail{{Type: commands.ProjectUpdate, Arguments: map[string]interface{}{"id": int64(10), "name": "Travel"}}},
+		},
+		{
+			"archiving a project unarchives it",
+			requests.CommandDetail{Type: commands.ProjectArchive, Arguments: map[string]interface{}{"id": int64(10)}},
+			[]requests.CommandDetail{{Type: commands.ProjectUnarchive, Arguments: map[string]interface{}{"id": int64(10)}}},
+		},
+		{
+			"adding a label deletes the label by its temporary id",
+			requests.CommandDetail{Type: commands.LabelAdd, TemporaryID: "temp-2", Arguments: map[string]interface{}{"name": "home"}},
+			[]requests.CommandDetail{{Type: commands.LabelDelete, Arguments: map[string]interface{}{"id": "temp-2"}}},
+		},
+		{
+			"commenting on a task deletes the comment by its temporary id",
+			requests.CommandDetail{Type: commands.NoteAdd, TemporaryID: "temp-3", Arguments: map[string]interface{}{"item_id": int64(1), "content": "Called the plumber"}},
+			[]requests.CommandDetail{{Type: commands.NoteDelete, Arguments: map[string]interface{}{"id": "temp-3"}}},
+		},
+	}
+
+	for _, commandToTest := range commandsToTest {
+		commandToTest := commandToTest
+
+		t.Run("When "+commandToTest.description+", then the command can be reversed", func(t *testing.T) {
+			inverseCommands, isReversible := replica.InverseCommands(commandToTest.command)
+
+			assert.True(t, isReversible)
+			assert.Equal(t, commandToTest.expectedInverse, inverseCommands)
+		})
+	}
+
+	var irreversibleCommandsToTest = []struct {
+		description string
+		command     requests.CommandDetail
+	}{
+		{"deleting a task", requests.CommandDetail{Type: commands.ItemDelete, Arguments: map[string]interface{}{"id": int64(1)}}},
+		{"deleting a comment", requests.CommandDetail{Type: commands.NoteDelete, Arguments: map[string]interface{}{"id": int64(100)}}},
+		{"deleting a project", requests.CommandDetail{Type: commands.ProjectDelete, Arguments: map[string]interface{}{"id": int64(10)}}},
+		{"updating a task that is not in the replica", requests.CommandDetail{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": int64(9), "content": "test"}}},
+	}
+
+	for _, commandToTest := range irreversibleCommandsToTest {
+		commandToTest := commandToTest
+
+		t.Run("When "+commandToTest.description+", then the command cannot be reversed", func(t *testing.T) {
+			_, isReversible := replica.InverseCommands(commandToTest.command)
+
+			assert.False(t, isReversible)
+		})
+	}
+
+}
+
+func TestDescribingCommands(t *testing.T) {
+
+	replica := &Replica{
+		Items:    []responses.Item{{TodoistID: 1, Content: "Buy milk"}},
+		Projects: []responses.Project{{TodoistID: 10, Name: "Travel"}},
+	}
+
+	var commandsToTest = []struct {
+		command             requests.CommandDetail
+		expectedDescription string
+	}{
+		{requests.CommandDetail{Type: commands.ItemAdd, Arguments: map[string]interface{}{"content": "Pack"}}, "add task 'Pack'"},
+		{requests.CommandDetail{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": float64(1)}}, "complete task 'Buy milk'"},
+		{requests.CommandDetail{Type: commands.ItemUncomplete, Arguments: map[string]interface{}{"id": int64(9)}}, "uncomplete task t:9"},
+		{requests.CommandDetail{Type: commands.ItemDelete, Arguments: map[string]interface{}{"id": "temp-1"}}, "delete a task added offline"},
+		{requests.CommandDetail{Type: commands.ProjectArchive, Arguments: map[string]interface{}{"id": int64(10)}}, "archive project 'Travel'"},
+		{requests.CommandDetail{Type: commands.NoteAdd, Arguments: map[string]interface{}{"item_id": int64(1), "content": "Called the plumber"}}, "comment on task 'Buy milk'"},
+	}
+
+	for _, commandToTest := range commandsToTest {
+		assert.Equal(t, commandToTest.expectedDescription, replica.DescribeCommand(commandToTest.command))
+	}
+
+}
@@ -0,0 +1,454 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+)
+
+// ApplyCommands optimistically applies commands that have not yet been sent to Todoist, so that the replica reflects them while offline.
+// Resources created by the commands are identified by their temporary id until they have been synced.
+func (r *Replica) ApplyCommands(commandDetails []requests.CommandDetail) {
+	for _, commandDetail := range commandDetails {
+		r.applyCommand(commandDetail)
+	}
+}
+
+// RemoveTemporaryResources removes the resources that were optimistically created while offline, the synced versions of them replace them on the next sync
+func (r *Replica) RemoveTemporaryResources() {
+	var items []responses.Item
+	for _, item := range r.Items {
+		if item.TemporaryID == "" {
+			items = append(items, item)
+		}
+	}
+	r.Items = items
+
+	var projects []responses.Project
+	for _, project := range r.Projects {
+		if project.TemporaryID == "" {
+			projects = append(projects, project)
+		}
+	}
+	r.Projects = projects
+
+	var labels []responses.Label
+	for _, label := range r.Labels {
+		if label.TemporaryID == "" {
+			labels = append(labels, label)
+		}
+	}
+	r.Labels = labels
+
+	var notes []responses.Note
+	for _, note := range r.Notes {
+		if note.TemporaryID == "" {
+			notes = append(notes, note)
+		}
+	}
+	r.Notes = notes
+
+	var projectNotes []responses.Note
+	for _, note := range r.ProjectNotes {
+		if note.TemporaryID == "" {
+			projectNotes = append(projectNotes, note)
+		}
+	}
+	r.ProjectNotes = projectNotes
+}
+
+func (r *Replica) applyCommand(commandDetail requests.CommandDetail) {
+	arguments := commandDetail.Arguments
+
+	switch commandDetail.Type {
+	case commands.ItemAdd:
+		item := responses.Item{
+			TemporaryID: commandDetail.TemporaryID,
+			Content:     asString(arguments["content"]),
+			Priority:    int16(asInt64(arguments["priority"])),
+			ProjectID:   asInt64(arguments["project_id"]),
+			SectionID:   asInt64(arguments["section_id"]),
+			ParentID:    asInt64(arguments["parent_id"]),
+			Labels:      r.labelIDs(arguments["labels"]),
+			Due:         asDue(arguments["due"]),
+			Deadline:    asDeadline(arguments["deadline"]),
+		}
+		if item.Priority == 0 {
+			item.Priority = 1
+		}
+		r.Items = append(r.Items, item)
+
+	case commands.ItemClose:
+		if item := r.findItem(arguments["id"]); item != nil && !item.IsRecurring() {
+			r.checkItem(item)
+		}
+
+	case commands.ItemComplete:
+		if item := r.findItem(arguments["id"]); item != nil {
+			r.checkItem(item)
+		}
+
+	case commands.ItemUncomplete:
+		if item := r.findItem(arguments["id"]); item != nil {
+			item.Checked = 0
+		}
+
+	case commands.ItemUpdate:
+		item := r.findItem(arguments["id"])
+		if item == nil {
+			return
+		}
+		if content, ok := arguments["content"]; ok {
+			item.Content = asString(content)
+		}
+		if description, ok := arguments["description"]; ok {
+			item.Description = asString(description)
+		}
+		if priority, ok := arguments["priority"]; ok {
+			item.Priority = int16(asInt64(priority))
+		}
+		if due, ok := arguments["due"]; ok {
+			item.Due = asDue(due)
+		}
+		if deadline, ok := arguments["deadline"]; ok {
+			item.Deadline = asDeadline(deadline)
+		}
+		if labels, ok := arguments["labels"]; ok {
+			item.Labels = r.labelIDs(labels)
+		}
+
+	case commands.ItemDelete:
+		if item := r.findItem(arguments["id"]); item != nil {
+			r.deleteItem(*item)
+		}
+
+	case commands.ItemMove:
+		item := r.findItem(arguments["id"])
+		if item == nil {
+			return
+		}
+		if parentID, ok := arguments["parent_id"]; ok {
+			if parent := r.findItem(parentID); parent != nil {
+				item.ParentID = parent.TodoistID
+				r.moveItem(item, parent.ProjectID, parent.SectionID)
+			}
+		}
+		if sectionID, ok := arguments["section_id"]; ok {
+			if section := r.findSection(sectionID); section != nil {
+				item.ParentID = 0
+				r.moveItem(item, section.ProjectID, section.TodoistID)
+			}
+		}
+		if projectID, ok := arguments["project_id"]; ok {
+			item.ParentID = 0
+			r.moveItem(item, asInt64(projectID), 0)
+		}
+
+	case commands.ItemReorder:
+		orderedItems, _ := arguments["items"].([]interface{})
+		for _, orderedItem := range orderedItems {
+			order, _ := orderedItem.(map[string]interface{})
+			if item := r.findItem(order["id"]); item != nil {
+				item.ChildOrder = int32(asInt64(order["child_order"]))
+			}
+		}
+
+	case commands.ItemUpdateDayOrders:
+		idsToOrders, _ := arguments["ids_to_orders"].(map[string]interface{})
+		for reference, dayOrder := range idsToOrders {
+			if item := r.findItem(reference); item != nil {
+				item.DayOrder = int32(asInt64(dayOrder))
+			}
+		}
+
+	case commands.ProjectAdd:
+		r.Projects = append(r.Projects, responses.Project{
+			TemporaryID: commandDetail.TemporaryID,
+			Name:        asString(arguments["name"]),
+		})
+
+	case commands.ProjectUpdate:
+		if project := r.findProject(arguments["id"]); project != nil {
+			if name, ok := arguments["name"]; ok {
+				project.Name = asString(name)
+			}
+		}
+
+	case commands.ProjectArchive, commands.ProjectUnarchive:
+		if project := r.findProject(arguments["id"]); project != nil {
+			project.IsArchived = 0
+			if commandDetail.Type == commands.ProjectArchive {
+				project.IsArchived = 1
+			}
+		}
+
+	case commands.ProjectDelete:
+		if project := r.findProject(arguments["id"]); project != nil {
+			project.IsDeleted = 1
+			applyChanges(&r.Projects, []responses.Project{*project})
+		}
+
+	case commands.LabelAdd:
+		r.Labels = append(r.Labels, responses.Label{
+			TemporaryID: commandDetail.TemporaryID,
+			Name:        asString(arguments["name"]),
+		})
+
+	case commands.LabelUpdate:
+		if label := r.findLabel(arguments["id"]); label != nil {
+			if name, ok := arguments["name"]; ok {
+				label.Name = asString(name)
+			}
+		}
+
+	case commands.LabelDelete:
+		if label := r.findLabel(arguments["id"]); label != nil {
+			label.IsDeleted = 1
+			applyChanges(&r.Labels, []responses.Label{*label})
+		}
+
+	case commands.NoteAdd:
+		note := responses.Note{
+			TemporaryID:    commandDetail.TemporaryID,
+			PostedUID:      r.UserID(),
+			ItemID:         asInt64(arguments["item_id"]),
+			Content:        asString(arguments["content"]),
+			FileAttachment: asFileAttachment(arguments["file_attachment"]),
+		}
+		if projectID, ok := arguments["project_id"]; ok {
+			note.ProjectID = asInt64(projectID)
+			if project := r.findProject(projectID); project != nil {
+				note.ProjectID = project.TodoistID
+				note.ProjectTemporaryID = project.TemporaryID
+			}
+			r.ProjectNotes = append(r.ProjectNotes, note)
+			return
+		}
+		if item := r.findItem(arguments["item_id"]); item != nil {
+			note.ItemID = item.TodoistID
+			note.ItemTemporaryID = item.TemporaryID
+			note.ProjectID = item.ProjectID
+		}
+		r.Notes = append(r.Notes, note)
+
+	case commands.NoteDelete:
+		if note := r.findNote(arguments["id"]); note != nil {
+			deletedNote := *note
+			deletedNote.IsDeleted = 1
+			applyChanges(&r.Notes, []responses.Note{deletedNote})
+			applyChanges(&r.ProjectNotes, []responses.Note{deletedNote})
+		}
+	}
+}
+
+func (r *Replica) findItem(reference interface{}) *responses.Item {
+	for index, item := range r.Items {
+		if isReferenceTo(reference, item.TodoistID, item.TemporaryID) {
+			return &r.Items[index]
+		}
+	}
+	return nil
+}
+
+// checkItem checks the item and its sub-items, as Todoist does when an item is closed. Sub-items refer to their parent by its
+// Todoist id, so the sub-items of items created while offline are not known until they have been synced.
+func (r *Replica) checkItem(item *responses.Item) {
+	item.Checked = 1
+	if item.TodoistID == 0 {
+		return
+	}
+
+	for index := range r.Items {
+		if r.Items[index].ParentID == item.TodoistID {
+			r.checkItem(&r.Items[index])
+		}
+	}
+}
+
+// deleteItem removes the item and its sub-items, as Todoist does when an item is deleted
+func (r *Replica) deleteItem(item responses.Item) {
+	var items []responses.Item
+	for _, existingItem := range r.Items {
+		if existingItem.TodoistID != item.TodoistID || existingItem.TemporaryID != item.TemporaryID {
+			items = append(items, existingItem)
+		}
+	}
+	r.Items = items
+
+	if item.TodoistID == 0 {
+		return
+	}
+
+	for _, subItem := range r.Items {
+		if subItem.ParentID == item.TodoistID {
+			r.deleteItem(subItem)
+		}
+	}
+}
+
+// moveItem moves the item and its sub-items to the project and section, sub-items keep their parent
+func (r *Replica) moveItem(item *responses.Item, projectID int64, sectionID int64) {
+	item.ProjectID = projectID
+	item.SectionID = sectionID
+	if item.TodoistID == 0 {
+		return
+	}
+
+	for index := range r.Items {
+		if r.Items[index].ParentID == item.TodoistID {
+			r.moveItem(&r.Items[index], projectID, sectionID)
+		}
+	}
+}
+
+func (r *Replica) findSection(reference interface{}) *responses.Section {
+	for index, section := range r.Sections {
+		if isReferenceTo(reference, section.TodoistID, "") {
+			return &r.Sections[index]
+		}
+	}
+	return nil
+}
+
+func (r *Replica) findProject(reference interface{}) *responses.Project {
+	for index, project := range r.Projects {
+		if isReferenceTo(reference, project.TodoistID, project.TemporaryID) {
+			return &r.Projects[index]
+		}
+	}
+	return nil
+}
+
+func (r *Replica) findLabel(reference interface{}) *responses.Label {
+	for index, label := range r.Labels {
+		if isReferenceTo(reference, label.TodoistID, label.TemporaryID) {
+			return &r.Labels[index]
+		}
+	}
+	return nil
+}
+
+func (r *Replica) findNote(reference interface{}) *responses.Note {
+	for index, note := range r.Notes {
+		if isReferenceTo(reference, note.TodoistID, note.TemporaryID) {
+			return &r.Notes[index]
+		}
+	}
+	for index, note := range r.ProjectNotes {
+		if isReferenceTo(reference, note.TodoistID, note.TemporaryID) {
+			return &r.ProjectNotes[index]
+		}
+	}
+	return nil
+}
+
+// labelIDs converts the labels argument of an item command into label ids. Labels that have only been created while
+// offline have no Todoist id yet and are left out, they are attached to the item once it has been synced.
+func (r *Replica) labelIDs(value interface{}) []int64 {
+	references, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var labelIDs []int64
+	for _, reference := range references {
+		if label := r.findLabel(reference); label != nil && label.TodoistID != 0 {
+			labelIDs = append(labelIDs, label.TodoistID)
+		}
+	}
+	return labelIDs
+}
+
+// isReferenceTo returns true if the command argument refers to the resource, either by its Todoist id or by its temporary id
+func isReferenceTo(reference interface{}, todoistID int64, temporaryID string) bool {
+	if temporaryIDReference, ok := reference.(string); ok && temporaryID != "" && temporaryIDReference == temporaryID {
+		return true
+	}
+	return todoistID != 0 && asInt64(reference) == todoistID
+}
+
+// asInt64 converts a command argument to an integer, arguments read back from disk are decoded from JSON as float64
+func asInt64(value interface{}) int64 {
+	switch typedValue := value.(type) {
+	case int:
+		return int64(typedValue)
+	case int16:
+		return int64(typedValue)
+	case int32:
+		return int64(typedValue)
+	case int64:
+		return typedValue
+	case float64:
+		return int64(typedValue)
+	case string:
+		parsedValue, _ := strconv.ParseInt(typedValue, 10, 64)
+		return parsedValue
+	}
+	return 0
+}
+
+// asFileAttachment converts the file attachment argument of a note command, arguments read back from disk are decoded from JSON as maps
+func asFileAttachment(value interface{}) *responses.FileAttachment {
+	if value == nil {
+		return nil
+	}
+
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var fileAttachment responses.FileAttachment
+	if json.Unmarshal(encodedValue, &fileAttachment) != nil {
+		return nil
+	}
+	return &fileAttachment
+}
+
+// asDue converts the due date sent to Todoist into the due date of an item. Plain-text dates are interpreted by Todoist, so until
+// the item has been synced it keeps the plain-text date without a date.
+func asDue(value interface{}) *responses.Due {
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var due *requests.Due
+	if json.Unmarshal(encodedValue, &due) != nil || due == nil {
+		return nil
+	}
+
+	return &responses.Due{
+		DateString:  due.Date,
+		Timezone:    due.Timezone,
+		String:      due.Value,
+		Lang:        due.Lang,
+		IsRecurring: due.IsRecurring,
+	}
+}
+
+// asDeadline converts the deadline sent to Todoist into the deadline of an item
+func asDeadline(value interface{}) *responses.Deadline {
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var deadline *requests.Deadline
+	if json.Unmarshal(encodedValue, &deadline) != nil || deadline == nil {
+		return nil
+	}
+
+	return &responses.Deadline{Date: deadline.Date, Lang: deadline.Lang}
+}
+
+func asString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,304 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyingCommandsOptimistically(t *testing.T) {
+
+	t.Run("Given an item add command, when applying it, then an item identified by the temporary id is added", func(t *testing.T) {
+		replica := &Replica{}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "test", "project_id": int64(10)}},
+		})
+
+		assert.Equal(t, []responses.Item{
+			{TemporaryID: "temp-1", Content: "test", ProjectID: 10, Priority: 1},
+		}, replica.Items)
+	})
+
+	t.Run("Given commands referring to a temporary id, when applying them, then the optimistically added item is changed", func(t *testing.T) {
+		replica := &Replica{}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "test"}},
+			{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": "temp-1", "content": "updated", "priority": 4}},
+			{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": "temp-1"}},
+		})
+
+		assert.Equal(t, []responses.Item{
+			{TemporaryID: "temp-1", Content: "updated", Priority: 4, Checked: 1},
+		}, replica.Items)
+	})
+
+	t.Run("Given commands read back from disk, when applying them, then ids decoded as floats still refer to the synced resources", func(t *testing.T) {
+		replica := &Replica{
+			Items:    []responses.Item{{TodoistID: 1, Content: "before"}},
+			Projects: []responses.Project{{TodoistID: 10, Name: "Work"}, {TodoistID: 11, Name: "Old"}},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": float64(1), "content": "after"}},
+			{Type: commands.ProjectUpdate, Arguments: map[string]interface{}{"id": float64(10), "name": "Office"}},
+			{Type: commands.ProjectArchive, Arguments: map[string]interface{}{"id": float64(10)}},
+			{Type: commands.ProjectDelete, Arguments: map[string]interface{}{"id": float64(11)}},
+		})
+
+		assert.Equal(t, []responses.Item{{TodoistID: 1, Content: "after"}}, replica.Items)
+		assert.Equal(t, []responses.Project{{TodoistID: 10, Name: "Office", IsArchived: 1}}, replica.Projects)
+	})
+
+	t.Run("Given commands with due dates, when applying them, then the due dates of the items are changed", func(t *testing.T) {
+		replica := &Replica{Items: []responses.Item{{TodoistID: 1, Due: &responses.Due{DateString: "2020-04-10"}}}}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "test", "due": requests.NewDue("2020-04-10T17:00")}},
+			{Type: commands.ItemAdd, TemporaryID: "temp-2", Arguments: map[string]interface{}{"content": "test", "due": requests.NewDue("every day")}},
+			{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": "temp-1", "due": map[string]interface{}{"date": "2020-04-11"}}},
+			{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": float64(1), "due": nil}},
+		})
+
+		assert.Nil(t, replica.Items[0].Due)
+		assert.Equal(t, &responses.Due{DateString: "2020-04-11"}, replica.Items[1].Due)
+		assert.Equal(t, &responses.Due{String: "every day"}, replica.Items[2].Due)
+	})
+
+	t.Run("Given commands with deadlines, when applying them, then the deadlines of the items are changed", func(t *testing.T) {
+		replica := &Replica{Items: []responses.Item{{TodoistID: 1, Deadline: &responses.Deadline{Date: "2020-05-15"}}}}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "test", "deadline": &requests.Deadline{Date: "2020-05-20"}}},
+			{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": float64(1), "deadline": nil}},
+		})
+
+		assert.Nil(t, replica.Items[0].Deadline)
+		assert.Equal(t, &responses.Deadline{Date: "2020-05-20"}, replica.Items[1].Deadline)
+	})
+
+	t.Run("Given label commands, when applying them, then the labels and the labels of items are changed", func(t *testing.T) {
+		replica := &Replica{
+			Items:  []responses.Item{{TodoistID: 1, Labels: []int64{100}}},
+			Labels: []responses.Label{{TodoistID: 100, Name: "errand"}, {TodoistID: 101, Name: "focus"}, {TodoistID: 102, Name: "old"}},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.LabelAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{"name": "new"}},
+			{Type: commands.LabelUpdate, Arguments: map[string]interface{}{"id": float64(101), "name": "deep-work"}},
+			{Type: commands.LabelDelete, Arguments: map[string]interface{}{"id": float64(102)}},
+			{Type: commands.ItemUpdate, Arguments: map[string]interface{}{"id": float64(1), "labels": []interface{}{float64(101), "temp-1"}}},
+		})
+
+		assert.Equal(t, []responses.Label{
+			{TodoistID: 100, Name: "errand"},
+			{TodoistID: 101, Name: "deep-work"},
+			{TemporaryID: "temp-1", Name: "new"},
+		}, replica.Labels)
+		assert.Equal(t, []int64{101}, replica.Items[0].Labels)
+	})
+
+	t.Run("Given an item close command, when applying it, then the sub-items of the item are checked as well", func(t *testing.T) {
+		replica := &Replica{
+			Items: []responses.Item{
+				{TodoistID: 1},
+				{TodoistID: 2, ParentID: 1},
+				{TodoistID: 3, ParentID: 2},
+				{TodoistID: 4},
+			},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{"content": "test", "parent_id": int64(4)}},
+			{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": float64(1)}},
+		})
+
+		assert.Equal(t, []responses.Item{
+			{TodoistID: 1, Checked: 1},
+			{TodoistID: 2, ParentID: 1, Checked: 1},
+			{TodoistID: 3, ParentID: 2, Checked: 1},
+			{TodoistID: 4},
+			{TemporaryID: "temp-1", Content: "test", ParentID: 4, Priority: 1},
+		}, replica.Items)
+	})
+
+	t.Run("Given commands completing recurring items, when applying them, then closed items are left to be rescheduled and completed items are checked", func(t *testing.T) {
+		recurring := &responses.Due{DateString: "2020-04-10", IsRecurring: true}
+		replica := &Replica{
+			Items: []responses.Item{
+				{TodoistID: 1, Due: recurring},
+				{TodoistID: 2, Due: recurring},
+				{TodoistID: 3, Checked: 1},
+			},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": float64(1)}},
+			{Type: commands.ItemComplete, Arguments: map[string]interface{}{"id": float64(2)}},
+			{Type: commands.ItemUncomplete, Arguments: map[string]interface{}{"id": float64(3)}},
+		})
+
+		assert.Equal(t, []responses.Item{
+			{TodoistID: 1, Due: recurring},
+			{TodoistID: 2, Due: recurring, Checked: 1},
+			{TodoistID: 3},
+		}, replica.Items)
+	})
+
+	t.Run("Given an item delete command, when applying it, then the item and its sub-items are removed", func(t *testing.T) {
+		replica := &Replica{
+			Items: []responses.Item{
+				{TodoistID: 1},
+				{TodoistID: 2, ParentID: 1},
+				{TodoistID: 3, ParentID: 2},
+				{TodoistID: 4},
+			},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemDelete, Arguments: map[string]interface{}{"id": float64(1)}},
+		})
+
+		assert.Equal(t, []responses.Item{{TodoistID: 4}}, replica.Items)
+	})
+
+	t.Run("Given item move commands, when applying them, then the items and their sub-items are moved", func(t *testing.T) {
+		replica := &Replica{
+			Items: []responses.Item{
+				{TodoistID: 1, ProjectID: 10},
+				{TodoistID: 2, ProjectID: 10, ParentID: 1},
+				{TodoistID: 3, ProjectID: 20, SectionID: 200},
+				{TodoistID: 4, ProjectID: 10},
+			},
+			Sections: []responses.Section{{TodoistID: 200, ProjectID: 20}},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemMove, Arguments: map[string]interface{}{"id": float64(1), "section_id": float64(200)}},
+			{Type: commands.ItemMove, Arguments: map[string]interface{}{"id": float64(3), "project_id": float64(10)}},
+			{Type: commands.ItemMove, Arguments: map[string]interface{}{"id": float64(4), "parent_id": float64(1)}},
+		})
+
+		assert.Equal(t, []responses.Item{
+			{TodoistID: 1, ProjectID: 20, SectionID: 200},
+			{TodoistID: 2, ProjectID: 20, SectionID: 200, ParentID: 1},
+			{TodoistID: 3, ProjectID: 10},
+			{TodoistID: 4, ProjectID: 20, SectionID: 200, ParentID: 1},
+		}, replica.Items)
+	})
+
+	t.Run("Given commands reordering items, when applying them, then the child and day orders of the items are changed", func(t *testing.T) {
+		replica := &Replica{
+			Items: []responses.Item{{TodoistID: 1, ChildOrder: 1}, {TodoistID: 2, ChildOrder: 2}},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemReorder, Arguments: map[string]interface{}{"items": []interface{}{
+				map[string]interface{}{"id": float64(2), "child_order": float64(1)},
+				map[string]interface{}{"id": float64(1), "child_order": float64(2)},
+			}}},
+			{Type: commands.ItemUpdateDayOrders, Arguments: map[string]interface{}{"ids_to_orders": map[string]interface{}{"1": float64(3)}}},
+		})
+
+		assert.Equal(t, []responses.Item{{TodoistID: 1, ChildOrder: 2, DayOrder: 3}, {TodoistID: 2, ChildOrder: 1}}, replica.Items)
+	})
+
+	t.Run("Given note commands, when applying them, then comments are posted on the items by the user and deleted", func(t *testing.T) {
+		replica := &Replica{
+			User:         &responses.User{TodoistID: 5},
+			Items:        []responses.Item{{TodoistID: 1, ProjectID: 10}},
+			Notes:        []responses.Note{{TodoistID: 100, ItemID: 1}},
+			ProjectNotes: []responses.Note{{TodoistID: 200, ProjectID: 10}},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.NoteAdd, TemporaryID: "temp-1", Arguments: map[string]interface{}{
+				"item_id":         float64(1),
+				"content":         "Quote",
+				"file_attachment": map[string]interface{}{"file_name": "quote.pdf", "file_url": "https://example.com/quote.pdf"},
+			}},
+			{Type: commands.NoteDelete, Arguments: map[string]interface{}{"id": float64(100)}},
+			{Type: commands.NoteDelete, Arguments: map[string]interface{}{"id": float64(200)}},
+		})
+
+		assert.Equal(t, []responses.Note{{
+			TemporaryID:    "temp-1",
+			PostedUID:      5,
+			ItemID:         1,
+			ProjectID:      10,
+			Content:        "Quote",
+			FileAttachment: &responses.FileAttachment{FileName: "quote.pdf", FileURL: "https://example.com/quote.pdf"},
+		}}, replica.Notes)
+		assert.Empty(t, replica.ProjectNotes)
+	})
+
+	t.Run("Given a note command on an item that has not been synced yet, when applying it, then the comment refers to the item by its temporary id", func(t *testing.T) {
+		replica := &Replica{
+			User:  &responses.User{TodoistID: 5},
+			Items: []responses.Item{{TemporaryID: "temp-1", ProjectID: 10}},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.NoteAdd, TemporaryID: "temp-2", Arguments: map[string]interface{}{"item_id": "temp-1", "content": "Called the plumber"}},
+		})
+
+		assert.Equal(t, []responses.Note{{
+			TemporaryID:     "temp-2",
+			PostedUID:       5,
+			ItemTemporaryID: "temp-1",
+			ProjectID:       10,
+			Content:         "Called the plumber",
+		}}, replica.Notes)
+	})
+
+	t.Run("Given note commands on projects, when applying them, then the comments are posted on the projects, by their temporary id until they have been synced", func(t *testing.T) {
+		replica := &Replica{
+			User:     &responses.User{TodoistID: 5},
+			Projects: []responses.Project{{TodoistID: 10, Name: "Work"}, {TemporaryID: "temp-1", Name: "Home"}},
+		}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.NoteAdd, TemporaryID: "temp-2", Arguments: map[string]interface{}{"project_id": float64(10), "content": "Kick-off on Monday"}},
+			{Type: commands.NoteAdd, TemporaryID: "temp-3", Arguments: map[string]interface{}{"project_id": "temp-1", "content": "Paint the fence"}},
+		})
+
+		assert.Equal(t, []responses.Note{
+			{TemporaryID: "temp-2", PostedUID: 5, ProjectID: 10, Content: "Kick-off on Monday"},
+			{TemporaryID: "temp-3", PostedUID: 5, ProjectTemporaryID: "temp-1", Content: "Paint the fence"},
+		}, replica.ProjectNotes)
+		assert.Empty(t, replica.Notes)
+	})
+
+	t.Run("Given a command referring to an unknown resource, when applying it, then the replica is unchanged", func(t *testing.T) {
+		replica := &Replica{Items: []responses.Item{{TodoistID: 1, Content: "test"}}}
+
+		replica.ApplyCommands([]requests.CommandDetail{
+			{Type: commands.ItemClose, Arguments: map[string]interface{}{"id": 2}},
+		})
+
+		assert.Equal(t, []responses.Item{{TodoistID: 1, Content: "test"}}, replica.Items)
+	})
+
+}
+
+func TestRemovingTemporaryResources(t *testing.T) {
+	replica := &Replica{
+		Items:        []responses.Item{{TodoistID: 1}, {TemporaryID: "temp-1"}},
+		Projects:     []responses.Project{{TemporaryID: "temp-2"}, {TodoistID: 10}},
+		Labels:       []responses.Label{{TodoistID: 100}, {TemporaryID: "temp-3"}},
+		Notes:        []responses.Note{{TemporaryID: "temp-4"}, {TodoistID: 1000}},
+		ProjectNotes: []responses.Note{{TodoistID: 2000}, {TemporaryID: "temp-5"}},
+	}
+
+	replica.RemoveTemporaryResources()
+
+	assert.Equal(t, []responses.Item{{TodoistID: 1}}, replica.Items)
+	assert.Equal(t, []responses.Project{{TodoistID: 10}}, replica.Projects)
+	assert.Equal(t, []responses.Label{{TodoistID: 100}}, replica.Labels)
+	assert.Equal(t, []responses.Note{{TodoistID: 1000}}, replica.Notes)
+	assert.Equal(t, []responses.Note{{TodoistID: 2000}}, replica.ProjectNotes)
+}
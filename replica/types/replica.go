@@ -0,0 +1,165 @@
+package types
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+)
+
+// Replica is a local copy of the resources on Todoist along with the sync token used to retrieve changes made since it was last synced
+type Replica struct {
+	SyncToken     string
+	ResourceTypes requests.ResourceTypes
+	Items         []responses.Item
+	Projects      []responses.Project
+	Sections      []responses.Section
+	Labels        []responses.Label
+	User          *responses.User
+	Collaborators []responses.Collaborator
+	Notes         []responses.Note
+	ProjectNotes  []responses.Note
+}
+
+// RequiresFullSync returns true if there is no sync token that can be used to retrieve only the changes since the last sync
+func (r *Replica) RequiresFullSync() bool {
+	return r.SyncToken == ""
+}
+
+// Covers returns true if every resource type was included when the replica was synced. Resource types that were not
+// included require a full sync, since the sync token only retrieves changes to the resource types it was issued for.
+func (r *Replica) Covers(resourceTypes requests.ResourceTypes) bool {
+	for _, resourceType := range resourceTypes {
+		covered := false
+		for _, syncedResourceType := range r.ResourceTypes {
+			if syncedResourceType == resourceType {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply updates the replica with the response of a sync query. Full syncs replace all resources, partial syncs add, update or remove only the resources that changed.
+func (r *Replica) Apply(response *responses.Query) {
+	if response.IsFullSync {
+		r.Items = nil
+		r.Projects = nil
+		r.Sections = nil
+		r.Labels = nil
+		r.Collaborators = nil
+		r.Notes = nil
+		r.ProjectNotes = nil
+	}
+
+	applyChanges(&r.Items, response.Items)
+	applyChanges(&r.Projects, response.Projects)
+	applyChanges(&r.Sections, response.Sections)
+	applyChanges(&r.Labels, response.Labels)
+	applyChanges(&r.Collaborators, response.Collaborators)
+	applyChanges(&r.Notes, response.Notes)
+	applyChanges(&r.ProjectNotes, response.ProjectNotes)
+	if response.User != nil {
+		r.User = response.User
+	}
+	r.SyncToken = response.SyncToken
+}
+
+// resourceKey identifies a resource of the replica, resources created while offline have no Todoist id yet and are told apart by their temporary id
+type resourceKey struct {
+	todoistID   int64
+	temporaryID string
+}
+
+// applyChanges adds, updates or removes the changed resources in the slice that resources points to, changedResources is a slice of the same
+// type. Every resource type of the replica has a TodoistID and IsDeleted field, and resources that can be created while offline a TemporaryID.
+func applyChanges(resources interface{}, changedResources interface{}) {
+	existing := reflect.ValueOf(resources).Elem()
+	changed := reflect.ValueOf(changedResources)
+
+	for changedIndex := 0; changedIndex < changed.Len(); changedIndex++ {
+		changedResource := changed.Index(changedIndex)
+
+		index := -1
+		for existingIndex := 0; existingIndex < existing.Len(); existingIndex++ {
+			if keyOf(existing.Index(existingIndex)) == keyOf(changedResource) {
+				index = existingIndex
+				break
+			}
+		}
+
+		if changedResource.FieldByName("IsDeleted").Int() == 1 {
+			if index >= 0 {
+				existing.Set(reflect.AppendSlice(existing.Slice(0, index), existing.Slice(index+1, existing.Len())))
+			}
+			continue
+		}
+
+		if index >= 0 {
+			existing.Index(index).Set(changedResource)
+		} else {
+			existing.Set(reflect.Append(existing, changedResource))
+		}
+	}
+}
+
+func keyOf(resource reflect.Value) resourceKey {
+	key := resourceKey{todoistID: resource.FieldByName("TodoistID").Int()}
+	if temporaryID := resource.FieldByName("TemporaryID"); temporaryID.IsValid() {
+		key.temporaryID = temporaryID.String()
+	}
+	return key
+}
+
+// LabelNames returns the names of the labels by their Todoist id
+func (r *Replica) LabelNames() map[int64]string {
+	labelNames := make(map[int64]string, len(r.Labels))
+	for _, label := range r.Labels {
+		labelNames[label.TodoistID] = label.Name
+	}
+	return labelNames
+}
+
+// CollaboratorNames returns the full names of the collaborators by their Todoist id, including the user
+func (r *Replica) CollaboratorNames() map[int64]string {
+	collaboratorNames := make(map[int64]string, len(r.Collaborators)+1)
+	for _, collaborator := range r.Collaborators {
+		collaboratorNames[collaborator.TodoistID] = collaborator.FullName
+	}
+	if r.User != nil {
+		collaboratorNames[r.User.TodoistID] = r.User.FullName
+	}
+	return collaboratorNames
+}
+
+// UserID returns the Todoist id of the user, 0 if the user has not been synced yet
+func (r *Replica) UserID() int64 {
+	if r.User == nil {
+		return 0
+	}
+	return r.User.TodoistID
+}
+
+// UserLocation returns the timezone of the user that fixed due dates are shown in, the timezone of the machine is used until the user has been synced
+func (r *Replica) UserLocation() *time.Location {
+	if r.User == nil {
+		return time.Local
+	}
+	return r.User.Location()
+}
+
+// UncompletedItems returns the items that have not yet been checked off
+func (r *Replica) UncompletedItems() []responses.Item {
+	var uncompletedItems []responses.Item
+	for _, item := range r.Items {
+		if item.Checked == 0 {
+			uncompletedItems = append(uncompletedItems, item)
+		}
+	}
+	return uncompletedItems
+}
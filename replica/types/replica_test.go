@@ -0,0 +1,165 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyingSyncResponses(t *testing.T) {
+
+	t.Run("Given a full sync response, when applying it, then all existing resources are replaced", func(t *testing.T) {
+		replica := &Replica{
+			SyncToken: "old-token",
+			Items:     []responses.Item{{TodoistID: 1}},
+			Projects:  []responses.Project{{TodoistID: 10}},
+			Sections:  []responses.Section{{TodoistID: 100}},
+			Labels:    []responses.Label{{TodoistID: 1000}},
+		}
+
+		replica.Apply(&responses.Query{
+			IsFullSync: true,
+			SyncToken:  "new-token",
+			Items:      []responses.Item{{TodoistID: 2}},
+		})
+
+		assert.Equal(t, "new-token", replica.SyncToken)
+		assert.Equal(t, []responses.Item{{TodoistID: 2}}, replica.Items)
+		assert.Empty(t, replica.Projects)
+		assert.Empty(t, replica.Sections)
+		assert.Empty(t, replica.Labels)
+	})
+
+	t.Run("Given a partial sync response, when applying it, then new resources are added and changed resources are updated", func(t *testing.T) {
+		replica := &Replica{
+			Items: []responses.Item{
+				{TodoistID: 1, Content: "unchanged"},
+				{TodoistID: 2, Content: "before"},
+			},
+			Projects: []responses.Project{{TodoistID: 10, Name: "Work"}},
+		}
+
+		replica.Apply(&responses.Query{
+			SyncToken: "new-token",
+			Items: []responses.Item{
+				{TodoistID: 2, Content: "after"},
+				{TodoistID: 3, Content: "added"},
+			},
+			Projects: []responses.Project{{TodoistID: 10, Name: "Office"}},
+			Sections: []responses.Section{{TodoistID: 100, Name: "Meetings"}},
+			Labels:   []responses.Label{{TodoistID: 1000, Name: "errand"}},
+		})
+
+		assert.Equal(t, "new-token", replica.SyncToken)
+		assert.Equal(t, []responses.Item{
+			{TodoistID: 1, Content: "unchanged"},
+			{TodoistID: 2, Content: "after"},
+			{TodoistID: 3, Content: "added"},
+		}, replica.Items)
+		assert.Equal(t, "Office", replica.Projects[0].Name)
+		assert.Equal(t, "Meetings", replica.Sections[0].Name)
+		assert.Equal(t, map[int64]string{1000: "errand"}, replica.LabelNames())
+	})
+
+	t.Run("Given a sync response with the user and collaborators, when applying it, then their names are known by Todoist id", func(t *testing.T) {
+		replica := &Replica{
+			User:          &responses.User{TodoistID: 1, FullName: "Old Name"},
+			Collaborators: []responses.Collaborator{{TodoistID: 2, FullName: "Alex"}},
+		}
+
+		replica.Apply(&responses.Query{
+			User:          &responses.User{TodoistID: 1, FullName: "Sam"},
+			Collaborators: []responses.Collaborator{{TodoistID: 3, FullName: "Jordan"}},
+		})
+
+		assert.Equal(t, int64(1), replica.UserID())
+		assert.Equal(t, map[int64]string{1: "Sam", 2: "Alex", 3: "Jordan"}, replica.CollaboratorNames())
+	})
+
+	t.Run("Given a sync response without the user, when applying it, then the user is kept", func(t *testing.T) {
+		replica := &Replica{User: &responses.User{TodoistID: 1}}
+
+		replica.Apply(&responses.Query{SyncToken: "new-token"})
+
+		assert.Equal(t, int64(1), replica.UserID())
+	})
+
+	t.Run("Given a partial sync response containing deleted resources, when applying it, then those resources are removed", func(t *testing.T) {
+		replica := &Replica{
+			Items:         []responses.Item{{TodoistID: 1}, {TodoistID: 2}},
+			Projects:      []responses.Project{{TodoistID: 10}},
+			Sections:      []responses.Section{{TodoistID: 100}},
+			Labels:        []responses.Label{{TodoistID: 1000}},
+			Collaborators: []responses.Collaborator{{TodoistID: 5}, {TodoistID: 6}},
+		}
+
+		replica.Apply(&responses.Query{
+			Items: []responses.Item{
+				{TodoistID: 1, IsDeleted: 1},
+				{TodoistID: 4, IsDeleted: 1},
+			},
+			Projects:      []responses.Project{{TodoistID: 10, IsDeleted: 1}},
+			Sections:      []responses.Section{{TodoistID: 100, IsDeleted: 1}},
+			Labels:        []responses.Label{{TodoistID: 1000, IsDeleted: 1}},
+			Collaborators: []responses.Collaborator{{TodoistID: 5, IsDeleted: 1}},
+		})
+
+		assert.Equal(t, []responses.Item{{TodoistID: 2}}, replica.Items)
+		assert.Empty(t, replica.Projects)
+		assert.Empty(t, replica.Sections)
+		assert.Empty(t, replica.Labels)
+		assert.Equal(t, []responses.Collaborator{{TodoistID: 6}}, replica.Collaborators)
+	})
+
+	t.Run("Given a sync response with notes, when applying it, then item notes and project notes are kept apart", func(t *testing.T) {
+		replica := &Replica{
+			Notes:        []responses.Note{{TodoistID: 1, ItemID: 10, Content: "before"}, {TodoistID: 2, ItemID: 10}},
+			ProjectNotes: []responses.Note{{TodoistID: 3, ProjectID: 100}},
+		}
+
+		replica.Apply(&responses.Query{
+			Notes: []responses.Note{
+				{TodoistID: 1, ItemID: 10, Content: "after"},
+				{TodoistID: 2, IsDeleted: 1},
+			},
+			ProjectNotes: []responses.Note{{TodoistID: 4, ProjectID: 100}},
+		})
+
+		assert.Equal(t, []responses.Note{{TodoistID: 1, ItemID: 10, Content: "after"}}, replica.Notes)
+		assert.Equal(t, []responses.Note{{TodoistID: 3, ProjectID: 100}, {TodoistID: 4, ProjectID: 100}}, replica.ProjectNotes)
+	})
+
+}
+
+func TestReplicaState(t *testing.T) {
+
+	t.Run("Given a replica without a sync token, then a full sync is required", func(t *testing.T) {
+		replica := &Replica{}
+		assert.True(t, replica.RequiresFullSync())
+
+		replica.SyncToken = "token"
+		assert.False(t, replica.RequiresFullSync())
+	})
+
+	t.Run("Given a replica synced with some resource types, then it only covers those resource types", func(t *testing.T) {
+		replica := &Replica{ResourceTypes: requests.ResourceTypes{"items", "projects"}}
+
+		assert.True(t, replica.Covers(requests.ResourceTypes{"items"}))
+		assert.True(t, replica.Covers(requests.ResourceTypes{"projects", "items"}))
+		assert.False(t, replica.Covers(requests.ResourceTypes{"items", "labels"}))
+	})
+
+	t.Run("Given a replica containing completed items, then only uncompleted items are returned", func(t *testing.T) {
+		replica := &Replica{
+			Items: []responses.Item{
+				{TodoistID: 1, Checked: 1},
+				{TodoistID: 2, Checked: 0},
+			},
+		}
+
+		assert.Equal(t, []responses.Item{{TodoistID: 2}}, replica.UncompletedItems())
+	})
+
+}
@@ -2,10 +2,17 @@ package rest
 
 import (
 	"bytes"
+	"errors"
+	"net"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
+// DefaultTimeout is the time allowed for each attempt of a request when no other timeout has been configured
+const DefaultTimeout = 10 * time.Second
+
 var (
 	// Client is the HTTP client to be used
 	Client HTTPClient
@@ -17,12 +24,15 @@ type HTTPClient interface {
 }
 
 func init() {
-	Client = &http.Client{
-		Timeout: time.Second * 10,
-	}
+	Configure(DefaultTimeout, DefaultRetryPolicy)
+}
+
+// Configure replaces the HTTP client with one that times out each attempt after the timeout and retries failed requests using the policy
+func Configure(timeout time.Duration, policy RetryPolicy) {
+	Client = NewRetryingClient(&http.Client{Timeout: timeout}, policy)
 }
 
-// Post sends a post request to the url with a body
+// Post sends a post request to the url with a body, the request is sent once
 func Post(url string, contentType string, body *bytes.Buffer) (*http.Response, error) {
 	request, err := http.NewRequest(http.MethodPost, url, body)
 	if err != nil {
@@ -32,11 +42,54 @@ func Post(url string, contentType string, body *bytes.Buffer) (*http.Response, e
 	return Client.Do(request)
 }
 
-// Get sends a get request to the url
-func Get(url string) (*http.Response, error) {
-	request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer([]byte{}))
+// PostForm sends a post request to the url with the values form encoded in the body, authorized using the access token. The request is
+// sent once, as a request that failed may still have been received.
+func PostForm(requestURL string, values url.Values, accessToken string) (*http.Response, error) {
+	request, err := newFormRequest(requestURL, values, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return Client.Do(request)
+}
+
+// PostRetryableForm sends a post request like PostForm, but retries it when it fails. It is only used for requests the server handles
+// once however often they are received, such as sync requests whose commands carry a UUID.
+func PostRetryableForm(requestURL string, values url.Values, accessToken string) (*http.Response, error) {
+	request, err := newFormRequest(requestURL, values, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return Client.Do(Retryable(request))
+}
+
+func newFormRequest(requestURL string, values url.Values, accessToken string) (*http.Request, error) {
+	request, err := http.NewRequest(http.MethodPost, requestURL, strings.NewReader(values.Encode()))
 	if err != nil {
 		return nil, err
 	}
+	request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	request.Header.Set("authorization", "Bearer "+accessToken)
+	return request, nil
+}
+
+// PostAuthorized sends a post request to the url with a body of the content type, authorized using the access token
+func PostAuthorized(url string, contentType string, body *bytes.Buffer, accessToken string) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("content-type", contentType)
+	request.Header.Set("authorization", "Bearer "+accessToken)
 	return Client.Do(request)
 }
+
+// IsConnectionError returns true if the request failed before a connection to the server was made, so the server cannot have received it
+func IsConnectionError(err error) bool {
+	var dnsError *net.DNSError
+	if errors.As(err, &dnsError) {
+		return true
+	}
+
+	var opError *net.OpError
+	return errors.As(err, &opError) && opError.Op == "dial"
+}
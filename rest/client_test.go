@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type receivedRequest struct {
+	method  string
+	path    string
+	query   string
+	headers http.Header
+	body    string
+}
+
+func newServer(received *receivedRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		*received = receivedRequest{
+			method:  r.Method,
+			path:    r.URL.Path,
+			query:   r.URL.RawQuery,
+			headers: r.Header,
+			body:    string(body),
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+// recordingClient records whether the requests it sends have been marked as retryable
+type recordingClient struct {
+	client    HTTPClient
+	retryable *bool
+}
+
+func (c *recordingClient) Do(request *http.Request) (*http.Response, error) {
+	*c.retryable = IsRetryable(request)
+	return c.client.Do(request)
+}
+
+func TestSendingRequests(t *testing.T) {
+
+	t.Run("When sending a post request, then the body is sent with the content type", func(t *testing.T) {
+		var received receivedRequest
+		server := newServer(&received)
+		defer server.Close()
+		Client = server.Client()
+
+		_, err := Post(server.URL+"/resource", "application/json", bytes.NewBufferString(`{"key":"value"}`))
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, received.method)
+		assert.Equal(t, "application/json", received.headers.Get("content-type"))
+		assert.Equal(t, `{"key":"value"}`, received.body)
+	})
+
+	t.Run("When posting a form, then the values are form encoded in the body and the access token is sent as a bearer token", func(t *testing.T) {
+		var received receivedRequest
+		server := newServer(&received)
+		defer server.Close()
+		Client = server.Client()
+
+		values := url.Values{"commands": {`[{"type":"item_add"}]`}}
+		_, err := PostForm(server.URL+"/sync", values, "access-token")
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, received.method)
+		assert.Equal(t, "", received.query)
+		assert.Equal(t, "application/x-www-form-urlencoded", received.headers.Get("content-type"))
+		assert.Equal(t, "Bearer access-token", received.headers.Get("authorization"))
+		assert.Equal(t, values.Encode(), received.body)
+	})
+
+	t.Run("When posting a retryable form, then the request is marked as retryable and sent like any other form", func(t *testing.T) {
+		var received receivedRequest
+		server := newServer(&received)
+		defer server.Close()
+
+		var marked bool
+		Client = &recordingClient{client: server.Client(), retryable: &marked}
+
+		values := url.Values{"commands": {`[{"type":"item_add"}]`}}
+		_, err := PostRetryableForm(server.URL+"/sync", values, "access-token")
+
+		assert.Nil(t, err)
+		assert.True(t, marked)
+		assert.Equal(t, "Bearer access-token", received.headers.Get("authorization"))
+		assert.Equal(t, values.Encode(), received.body)
+	})
+
+	t.Run("When posting a form, then the request is not marked as retryable", func(t *testing.T) {
+		var received receivedRequest
+		server := newServer(&received)
+		defer server.Close()
+
+		marked := true
+		Client = &recordingClient{client: server.Client(), retryable: &marked}
+
+		_, err := PostForm(server.URL+"/quick/add", url.Values{"text": {"Buy milk"}}, "access-token")
+
+		assert.Nil(t, err)
+		assert.False(t, marked)
+	})
+
+	t.Run("When sending an authorized post request, then the body is sent with the content type and the access token is sent as a bearer token", func(t *testing.T) {
+		var received receivedRequest
+		server := newServer(&received)
+		defer server.Close()
+		Client = server.Client()
+
+		_, err := PostAuthorized(server.URL+"/uploads/add", "text/plain", bytes.NewBufferString("contents"), "access-token")
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, received.method)
+		assert.Equal(t, "text/plain", received.headers.Get("content-type"))
+		assert.Equal(t, "Bearer access-token", received.headers.Get("authorization"))
+		assert.Equal(t, "contents", received.body)
+	})
+
+	t.Run("When the server cannot be reached, then an error is returned", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		Client = server.Client()
+		server.Close()
+
+		_, err := PostForm(server.URL+"/quick/add", url.Values{"text": {"Buy milk"}}, "access-token")
+
+		assert.NotNil(t, err)
+		assert.True(t, IsConnectionError(err))
+	})
+
+	t.Run("When the server closes the connection without responding, then the error is not a connection error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			connection, _, _ := w.(http.Hijacker).Hijack()
+			connection.Close()
+		}))
+		defer server.Close()
+		Client = server.Client()
+
+		_, err := PostForm(server.URL+"/quick/add", url.Values{"text": {"Buy milk"}}, "access-token")
+
+		assert.NotNil(t, err)
+		assert.False(t, IsConnectionError(err))
+	})
+
+}
@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried
+type RetryPolicy struct {
+	MaximumAttempts int
+	InitialBackoff  time.Duration
+	MaximumBackoff  time.Duration
+}
+
+// DefaultRetryPolicy is used when no other policy has been configured
+var DefaultRetryPolicy = RetryPolicy{
+	MaximumAttempts: 4,
+	InitialBackoff:  500 * time.Millisecond,
+	MaximumBackoff:  30 * time.Second,
+}
+
+// retryableKey is the key of the context value that marks requests as safe to send more than once
+type retryableKey struct{}
+
+// Retryable marks the request as safe to send again when it fails, because the server handles a request it receives more than once
+// as if it was received once. Requests that have not been marked are sent once, since a request that failed may still have been received.
+func Retryable(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), retryableKey{}, true))
+}
+
+// IsRetryable returns true if the request has been marked as safe to send again when it fails
+func IsRetryable(request *http.Request) bool {
+	retryable, _ := request.Context().Value(retryableKey{}).(bool)
+	return retryable
+}
+
+type retryingClient struct {
+	client HTTPClient
+	policy RetryPolicy
+	sleep  func(time.Duration)
+	random func() float64
+}
+
+// NewRetryingClient wraps the client so that network errors, rate limited responses (429) and server errors (5xx) of requests marked
+// as Retryable are retried. Retries wait using exponential backoff with full jitter capped at the maximum backoff, or for the duration
+// requested by Todoist in the Retry-After header. When Todoist asks to wait longer than the maximum backoff the rate limited response
+// is returned instead of waiting. Requests are sent again with exactly the same body, so sync commands are not applied twice as Todoist
+// ignores commands with a UUID it has already processed.
+func NewRetryingClient(client HTTPClient, policy RetryPolicy) HTTPClient {
+	return &retryingClient{
+		client: client,
+		policy: policy,
+		sleep:  time.Sleep,
+		random: rand.Float64,
+	}
+}
+
+// Do sends the request, retrying requests marked as Retryable until it succeeds, it fails in a way that cannot be retried or the maximum number of attempts is reached
+func (c *retryingClient) Do(request *http.Request) (*http.Response, error) {
+	if !IsRetryable(request) {
+		return c.client.Do(request)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			request.Body = body
+		}
+
+		response, err := c.client.Do(request)
+		if attempt >= c.policy.MaximumAttempts || !isRetryable(response, err) {
+			return response, err
+		}
+
+		delay, ok := retryAfter(response)
+		if ok && delay > c.policy.MaximumBackoff {
+			return response, err
+		}
+		if !ok {
+			delay = c.backoff(attempt)
+		}
+
+		if response != nil {
+			response.Body.Close()
+		}
+
+		c.sleep(delay)
+	}
+}
+
+// backoff returns a random delay between zero and the exponentially increasing backoff for the attempt
+func (c *retryingClient) backoff(attempt int) time.Duration {
+	backoff := float64(c.policy.InitialBackoff) * math.Pow(2, float64(attempt-1))
+	if backoff > float64(c.policy.MaximumBackoff) {
+		backoff = float64(c.policy.MaximumBackoff)
+	}
+	return time.Duration(c.random() * backoff)
+}
+
+func isRetryable(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+}
+
+// retryAfter reads the Retry-After header of rate limited responses, which is either a number of seconds or a date
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil || response.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
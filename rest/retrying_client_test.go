@@ -0,0 +1,234 @@
+package rest
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type scriptedClient struct {
+	responses []*http.Response
+	errors    []error
+	bodies    []string
+}
+
+func (c *scriptedClient) Do(request *http.Request) (*http.Response, error) {
+	attempt := len(c.bodies)
+
+	body := ""
+	if request.Body != nil {
+		contents, _ := ioutil.ReadAll(request.Body)
+		body = string(contents)
+	}
+	c.bodies = append(c.bodies, body)
+
+	return c.responses[attempt], c.errors[attempt]
+}
+
+func newResponse(statusCode int, headers map[string]string) *http.Response {
+	response := &http.Response{
+		StatusCode: statusCode,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+	}
+	for name, value := range headers {
+		response.Header.Set(name, value)
+	}
+	return response
+}
+
+func newTestRetryingClient(client HTTPClient, delays *[]time.Duration) *retryingClient {
+	return &retryingClient{
+		client: client,
+		policy: RetryPolicy{
+			MaximumAttempts: 3,
+			InitialBackoff:  time.Second,
+			MaximumBackoff:  3 * time.Second,
+		},
+		sleep:  func(delay time.Duration) { *delays = append(*delays, delay) },
+		random: func() float64 { return 1 },
+	}
+}
+
+func TestRetryingRequests(t *testing.T) {
+
+	t.Run("When a request succeeds, then it is not retried", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(200, nil)},
+			errors:    []error{nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		response, err := newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, response.StatusCode)
+		assert.Len(t, client.bodies, 1)
+		assert.Empty(t, delays)
+	})
+
+	t.Run("When a request fails with a client error, then it is not retried", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(400, nil)},
+			errors:    []error{nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		response, _ := newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Equal(t, 400, response.StatusCode)
+		assert.Len(t, client.bodies, 1)
+	})
+
+	t.Run("When network errors and server errors occur, then the request is retried with exponential backoff until it succeeds", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{nil, newResponse(503, nil), newResponse(200, nil)},
+			errors:    []error{errors.New("connection reset"), nil, nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodPost, "http://todoist", strings.NewReader("commands=uuid"))
+		response, err := newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 200, response.StatusCode)
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, delays)
+	})
+
+	t.Run("When a request is retried, then exactly the same body is sent again so commands keep their UUIDs", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(500, nil), newResponse(200, nil)},
+			errors:    []error{nil, nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodPost, "http://todoist", strings.NewReader(`commands=[{"uuid":"uuid-1"}]`))
+		newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Equal(t, []string{`commands=[{"uuid":"uuid-1"}]`, `commands=[{"uuid":"uuid-1"}]`}, client.bodies)
+	})
+
+	t.Run("When a request that has not been marked as retryable fails, then it is sent only once", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{nil, newResponse(200, nil)},
+			errors:    []error{errors.New("timeout"), nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodPost, "http://todoist/quick/add", strings.NewReader("text=Buy milk"))
+		_, err := newTestRetryingClient(client, &delays).Do(request)
+
+		assert.Equal(t, "timeout", err.Error())
+		assert.Len(t, client.bodies, 1)
+		assert.Empty(t, delays)
+	})
+
+	t.Run("When the maximum number of attempts is reached, then the last result is returned", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{nil, nil, nil},
+			errors:    []error{errors.New("1"), errors.New("2"), errors.New("3")},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		_, err := newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Equal(t, "3", err.Error())
+		assert.Len(t, client.bodies, 3)
+		assert.Len(t, delays, 2)
+	})
+
+	t.Run("When the backoff exceeds the maximum, then the maximum backoff is used", func(t *testing.T) {
+		var delays []time.Duration
+		client := newTestRetryingClient(nil, &delays)
+
+		assert.Equal(t, 3*time.Second, client.backoff(5))
+	})
+
+	t.Run("When the backoff is jittered, then the delay is a random portion of the backoff", func(t *testing.T) {
+		var delays []time.Duration
+		client := newTestRetryingClient(nil, &delays)
+		client.random = func() float64 { return 0.5 }
+
+		assert.Equal(t, 1*time.Second, client.backoff(2))
+	})
+
+	t.Run("When rate limited with a Retry-After header in seconds, then the request is retried after that delay", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(429, map[string]string{"Retry-After": "2"}), newResponse(200, nil)},
+			errors:    []error{nil, nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		response, _ := newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Equal(t, 200, response.StatusCode)
+		assert.Equal(t, []time.Duration{2 * time.Second}, delays)
+	})
+
+	t.Run("When rate limited with a Retry-After header as long as the maximum backoff, then the request is retried after that delay", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(429, map[string]string{"Retry-After": "3"}), newResponse(200, nil)},
+			errors:    []error{nil, nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		response, _ := newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Equal(t, 200, response.StatusCode)
+		assert.Equal(t, []time.Duration{3 * time.Second}, delays)
+	})
+
+	t.Run("When rate limited with a Retry-After header longer than the maximum backoff, then the rate limited response is returned without retrying", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(429, map[string]string{"Retry-After": "7200"}), newResponse(200, nil)},
+			errors:    []error{nil, nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		response, err := newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 429, response.StatusCode)
+		assert.Len(t, client.bodies, 1)
+		assert.Empty(t, delays)
+	})
+
+	t.Run("When rate limited with a Retry-After date in the past, then the request is retried immediately", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(429, map[string]string{"Retry-After": "Wed, 21 Oct 2015 07:28:00 GMT"}), newResponse(200, nil)},
+			errors:    []error{nil, nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Equal(t, []time.Duration{0}, delays)
+	})
+
+	t.Run("When rate limited without a Retry-After header, then the backoff is used", func(t *testing.T) {
+		var delays []time.Duration
+		client := &scriptedClient{
+			responses: []*http.Response{newResponse(429, nil), newResponse(200, nil)},
+			errors:    []error{nil, nil},
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "http://todoist", nil)
+		newTestRetryingClient(client, &delays).Do(Retryable(request))
+
+		assert.Equal(t, []time.Duration{time.Second}, delays)
+	})
+
+}
@@ -8,6 +8,9 @@ import (
 
 const (
 	errorFailedToAccessFile = "Failed to access file located at '%s'"
+
+	// maximumLineLength allows files such as the local replica, which are written as a single line of JSON, to be read in full
+	maximumLineLength = 64 * 1024 * 1024
 )
 
 // File is a facade in front of raw filesystem access. Files are opened in read/write.
@@ -38,10 +41,15 @@ func (f *file) ReadContents() (string, error) {
 
 	var contents string
 	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maximumLineLength)
 	for scanner.Scan() {
 		contents = contents + scanner.Text()
 	}
 
+	if scanner.Err() != nil {
+		return "", fmt.Errorf(errorFailedToAccessFile, f.path)
+	}
+
 	return contents, nil
 }
 
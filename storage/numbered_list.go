@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+// NumberedListErrors are the messages of the errors returned by a NumberedList, so that they can name the resources in the list
+type NumberedListErrors struct {
+	Read   string
+	Write  string
+	Delete string
+}
+
+// NumberedList persists a list of resources that are referenced on the command line by the cli's own ids, the position of each resource
+// in the list when it was last written starting at 1. Repositories of a resource type are built on it, the resources are structs with an ID uint32 field.
+type NumberedList struct {
+	file   File
+	errors NumberedListErrors
+}
+
+// NewNumberedList creates a new instance of a list that is persisted to the file
+func NewNumberedList(file File, errors NumberedListErrors) *NumberedList {
+	return &NumberedList{
+		file:   file,
+		errors: errors,
+	}
+}
+
+// Read decodes the persisted list into the slice that resources points to
+func (l *NumberedList) Read(resources interface{}) error {
+	contents, err := l.file.ReadContents()
+	if err != nil {
+		return errors.New(l.errors.Read)
+	}
+
+	err = json.Unmarshal([]byte(contents), resources)
+	if err != nil {
+		return errors.New(l.errors.Read)
+	}
+
+	return nil
+}
+
+// Write assigns each resource of the slice its id, in order starting at 1, and persists the list
+func (l *NumberedList) Write(resources interface{}) error {
+	list := reflect.ValueOf(resources)
+	for index := 0; index < list.Len(); index++ {
+		list.Index(index).FieldByName("ID").SetUint(uint64(index + 1))
+	}
+
+	contents, _ := json.Marshal(resources)
+	err := l.file.OverwriteContents(string(contents))
+	if err != nil {
+		return errors.New(l.errors.Write)
+	}
+
+	return nil
+}
+
+// Delete removes the persisted list
+func (l *NumberedList) Delete() error {
+	err := l.file.OverwriteContents("")
+	if err != nil {
+		return errors.New(l.errors.Delete)
+	}
+
+	return nil
+}
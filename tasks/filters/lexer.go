@@ -0,0 +1,106 @@
+package filters
+
+import (
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenTerm tokenKind = iota
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+)
+
+// token is an operator or a term of a query, the position is the offset of the token in the query used in error messages
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+var operators = map[rune]tokenKind{
+	'&': tokenAnd,
+	'|': tokenOr,
+	'!': tokenNot,
+	'(': tokenOpen,
+	')': tokenClose,
+}
+
+// tokenize splits a single query into operators and terms. Terms are the trimmed text between operators, so they may
+// contain spaces such as 'due before: May 5'. A backslash escapes an operator character so it can be used in a term.
+func tokenize(query string) []token {
+	var tokens []token
+	var term strings.Builder
+	termPosition := 0
+
+	endTerm := func() {
+		text := strings.TrimSpace(term.String())
+		if text != "" {
+			tokens = append(tokens, token{kind: tokenTerm, text: text, position: termPosition})
+		}
+		term.Reset()
+	}
+
+	runes := []rune(query)
+	for position := 0; position < len(runes); position++ {
+		character := runes[position]
+
+		if character == '\\' && position+1 < len(runes) {
+			if term.Len() == 0 {
+				termPosition = position
+			}
+			position++
+			term.WriteRune(runes[position])
+			continue
+		}
+
+		if kind, isOperator := operators[character]; isOperator {
+			endTerm()
+			tokens = append(tokens, token{kind: kind, text: string(character), position: position})
+			continue
+		}
+
+		if term.Len() == 0 {
+			if character == ' ' {
+				continue
+			}
+			termPosition = position
+		}
+		term.WriteRune(character)
+	}
+	endTerm()
+
+	return tokens
+}
+
+// splitQueries splits the text on commas into separate queries, a backslash escapes a comma so it can be used in a term
+func splitQueries(text string) []string {
+	var queries []string
+	var query strings.Builder
+
+	runes := []rune(text)
+	for position := 0; position < len(runes); position++ {
+		character := runes[position]
+
+		if character == '\\' && position+1 < len(runes) && runes[position+1] == ',' {
+			position++
+			query.WriteRune(',')
+			continue
+		}
+
+		if character == ',' {
+			queries = append(queries, strings.TrimSpace(query.String()))
+			query.Reset()
+			continue
+		}
+
+		query.WriteRune(character)
+	}
+	queries = append(queries, strings.TrimSpace(query.String()))
+
+	return queries
+}
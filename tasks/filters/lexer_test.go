@@ -0,0 +1,60 @@
+package filters
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGivenAQueryWhenTokenizingThenOperatorsAndTrimmedTermsAreReturned(t *testing.T) {
+	var queriesToTest = []struct {
+		query          string
+		expectedTokens []token
+	}{
+		{"today", []token{{tokenTerm, "today", 0}}},
+		{"  due before: May 5  ", []token{{tokenTerm, "due before: May 5", 2}}},
+		{"today|overdue", []token{
+			{tokenTerm, "today", 0},
+			{tokenOr, "|", 5},
+			{tokenTerm, "overdue", 6},
+		}},
+		{"(today | od) & !#Work", []token{
+			{tokenOpen, "(", 0},
+			{tokenTerm, "today", 1},
+			{tokenOr, "|", 7},
+			{tokenTerm, "od", 9},
+			{tokenClose, ")", 11},
+			{tokenAnd, "&", 13},
+			{tokenNot, "!", 15},
+			{tokenTerm, "#Work", 16},
+		}},
+		{`#Research \& Development`, []token{{tokenTerm, "#Research & Development", 0}}},
+		{`@\!important`, []token{{tokenTerm, "@!important", 0}}},
+		{"", nil},
+	}
+
+	for _, queryToTest := range queriesToTest {
+		actualTokens := tokenize(queryToTest.query)
+		if !reflect.DeepEqual(queryToTest.expectedTokens, actualTokens) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", queryToTest.expectedTokens, queryToTest.query, actualTokens)
+		}
+	}
+}
+
+func TestGivenAFilterWhenSplittingThenEachCommaSeparatedQueryIsReturned(t *testing.T) {
+	var filtersToTest = []struct {
+		filter          string
+		expectedQueries []string
+	}{
+		{"today", []string{"today"}},
+		{"today, overdue ,p1", []string{"today", "overdue", "p1"}},
+		{`#Friends\, Family, today`, []string{"#Friends, Family", "today"}},
+		{"today,", []string{"today", ""}},
+	}
+
+	for _, filterToTest := range filtersToTest {
+		actualQueries := splitQueries(filterToTest.filter)
+		if !reflect.DeepEqual(filterToTest.expectedQueries, actualQueries) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", filterToTest.expectedQueries, filterToTest.filter, actualQueries)
+		}
+	}
+}
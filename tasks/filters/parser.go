@@ -0,0 +1,172 @@
+package filters
+
+import (
+	"time"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+)
+
+const (
+	errorEmptyQuery       = "The filter contains an empty query, remove the extra comma"
+	errorExpectedTerm     = "Expected a filter such as 'today', '#project', '@label' or 'p1' at position %d of '%s'"
+	errorUnexpectedToken  = "Unexpected '%s' at position %d of '%s'"
+	errorUnclosedGroup    = "The '(' at position %d of '%s' is never closed"
+	errorUnexpectedFinish = "The query '%s' ends with an operator"
+)
+
+// Query is a single query of a filter, multiple queries are separated by commas and listed as separate sections
+type Query struct {
+	Text      string
+	Predicate types.TaskPredicate
+}
+
+// Parse parses a filter written in the Todoist filter language into its queries. Dates are relative to the provided
+// time. The operators are ! (not), & (and) and | (or), in order of precedence, and parentheses group terms.
+func Parse(filter string, now time.Time) ([]Query, error) {
+	var queries []Query
+	for _, text := range splitQueries(filter) {
+		if text == "" {
+			return nil, failures.New(failures.Validation, errorEmptyQuery)
+		}
+
+		predicate, err := parseQuery(text, now)
+		if err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, Query{Text: text, Predicate: predicate})
+	}
+	return queries, nil
+}
+
+// parser is a recursive descent parser over the tokens of a single query
+type parser struct {
+	query    string
+	tokens   []token
+	position int
+	now      time.Time
+}
+
+func parseQuery(query string, now time.Time) (types.TaskPredicate, error) {
+	p := &parser{
+		query:  query,
+		tokens: tokenize(query),
+		now:    now,
+	}
+
+	predicate, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next, ok := p.peek(); ok {
+		return nil, failures.Newf(failures.Validation, errorUnexpectedToken, next.text, next.position+1, p.query)
+	}
+
+	return predicate, nil
+}
+
+// parseOr parses terms separated by |, which has the lowest precedence
+func (p *parser) parseOr() (types.TaskPredicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(tokenOr) {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = or(left, right)
+	}
+
+	return left, nil
+}
+
+// parseAnd parses terms separated by &
+func (p *parser) parseAnd() (types.TaskPredicate, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(tokenAnd) {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = and(left, right)
+	}
+
+	return left, nil
+}
+
+// parseNot parses a term optionally preceded by one or more !
+func (p *parser) parseNot() (types.TaskPredicate, error) {
+	if p.accept(tokenNot) {
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return not(operand), nil
+	}
+
+	return p.parsePrimary()
+}
+
+// parsePrimary parses a term or a parenthesised query
+func (p *parser) parsePrimary() (types.TaskPredicate, error) {
+	next, ok := p.peek()
+	if !ok {
+		return nil, failures.Newf(failures.Validation, errorUnexpectedFinish, p.query)
+	}
+
+	switch next.kind {
+	case tokenOpen:
+		p.position++
+		predicate, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(tokenClose) {
+			return nil, failures.Newf(failures.Validation, errorUnclosedGroup, next.position+1, p.query)
+		}
+		return predicate, nil
+	case tokenTerm:
+		p.position++
+		return parseTerm(next.text, p.now)
+	}
+
+	return nil, failures.Newf(failures.Validation, errorExpectedTerm, next.position+1, p.query)
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.position >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.position], true
+}
+
+// accept consumes the next token if it is of the provided kind
+func (p *parser) accept(kind tokenKind) bool {
+	next, ok := p.peek()
+	if !ok || next.kind != kind {
+		return false
+	}
+	p.position++
+	return true
+}
+
+func and(left types.TaskPredicate, right types.TaskPredicate) types.TaskPredicate {
+	return func(task *types.Task) bool { return left(task) && right(task) }
+}
+
+func or(left types.TaskPredicate, right types.TaskPredicate) types.TaskPredicate {
+	return func(task *types.Task) bool { return left(task) || right(task) }
+}
+
+func not(operand types.TaskPredicate) types.TaskPredicate {
+	return func(task *types.Task) bool { return !operand(task) }
+}
@@ -0,0 +1,132 @@
+package filters
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Wednesday
+var now = time.Date(2020, 5, 13, 15, 30, 0, 0, time.Local)
+
+var tasks = types.TaskList{
+	{ID: 1, Content: "Send report", ProjectName: "Work", Priority: 4, DueDate: date(2020, 5, 12), Labels: []string{"email"}},
+	{ID: 2, Content: "Review pull request", ProjectName: "Work", Priority: 3, DueDate: date(2020, 5, 13), ResponsibleID: 10, ResponsibleName: "Sam", AssignedToMe: true},
+	{ID: 3, Content: "Buy milk", ProjectName: "Errands", Priority: 1, DueDate: date(2020, 5, 13), Labels: []string{"shopping", "quick"}},
+	{ID: 4, Content: "Plan holiday", ProjectName: "Personal", Priority: 2, DueDate: date(2020, 5, 18)},
+	{ID: 5, Content: "Read book", ProjectName: "Personal", Priority: 1, ParentID: 400},
+	{ID: 6, Content: "Prepare slides", ProjectName: "Work Shared", Priority: 4, DueDate: date(2020, 5, 14), ResponsibleID: 20, ResponsibleName: "Alex Smith"},
+}
+
+func TestGivenAQueryWithOperatorsWhenFilteringThenTheOperatorsAreAppliedByPrecedence(t *testing.T) {
+	var queriesToTest = []struct {
+		query       string
+		expectedIDs []uint32
+	}{
+		{"today | overdue", []uint32{1, 2, 3}},
+		{"today & #Work", []uint32{2}},
+		{"!#Work", []uint32{3, 4, 5, 6}},
+		{"!!#Work", []uint32{1, 2}},
+		{"(today | overdue) & #Work & p1", []uint32{1}},
+		{"today | overdue & #Work", []uint32{1, 2, 3}},
+		{"today & #Errands | p1", []uint32{1, 3, 6}},
+		{"today & (#Errands | p1)", []uint32{3}},
+		{"!(today | overdue) & !no date", []uint32{4, 6}},
+		{"((p1))", []uint32{1, 6}},
+		{"  p1 &p2  ", nil},
+	}
+
+	for _, queryToTest := range queriesToTest {
+		queries, err := Parse(queryToTest.query, now)
+		if err != nil {
+			t.Errorf("Expected no error for '%s', got '%s'", queryToTest.query, err.Error())
+			continue
+		}
+
+		actualIDs := idsOf(tasks.Filter(queries[0].Predicate))
+		if !reflect.DeepEqual(queryToTest.expectedIDs, actualIDs) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", queryToTest.expectedIDs, queryToTest.query, actualIDs)
+		}
+	}
+}
+
+func TestGivenAFilterWithSeveralQueriesWhenParsingThenAQueryIsReturnedForEach(t *testing.T) {
+	queries, err := Parse("today, #Personal | @email ,p1", now)
+	if err != nil {
+		t.Fatalf("Expected no error, got '%s'", err.Error())
+	}
+
+	var expectedQueries = []struct {
+		text        string
+		expectedIDs []uint32
+	}{
+		{"today", []uint32{2, 3}},
+		{"#Personal | @email", []uint32{1, 4, 5}},
+		{"p1", []uint32{1, 6}},
+	}
+
+	if len(queries) != len(expectedQueries) {
+		t.Fatalf("Expected %d queries, got %d", len(expectedQueries), len(queries))
+	}
+
+	for index, expectedQuery := range expectedQueries {
+		if queries[index].Text != expectedQuery.text {
+			t.Errorf("Expected the text of query %d to be '%s', got '%s'", index, expectedQuery.text, queries[index].Text)
+		}
+
+		actualIDs := idsOf(tasks.Filter(queries[index].Predicate))
+		if !reflect.DeepEqual(expectedQuery.expectedIDs, actualIDs) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", expectedQuery.expectedIDs, expectedQuery.text, actualIDs)
+		}
+	}
+}
+
+func TestGivenAnInvalidQueryWhenParsingThenAValidationErrorIsReturned(t *testing.T) {
+	var queriesToTest = []struct {
+		query           string
+		expectedMessage string
+	}{
+		{"", errorEmptyQuery},
+		{"today,,p1", errorEmptyQuery},
+		{"today &", "The query 'today &' ends with an operator"},
+		{"!", "The query '!' ends with an operator"},
+		{"& today", "Expected a filter such as 'today', '#project', '@label' or 'p1' at position 1 of '& today'"},
+		{"today | )", "Expected a filter such as 'today', '#project', '@label' or 'p1' at position 9 of 'today | )'"},
+		{"(today | od", "The '(' at position 1 of '(today | od' is never closed"},
+		{"today) & p1", "Unexpected ')' at position 6 of 'today) & p1'"},
+		{"(today)(od)", "Unexpected '(' at position 8 of '(today)(od)'"},
+		{"today tomorrow", "'today tomorrow' is not a filter that can be used, e.g. today, overdue, no date, 7 days, due before: May 5, #project, @label, p1, assigned to: me or search: text"},
+		{"p5", "'p5' is not a filter that can be used, e.g. today, overdue, no date, 7 days, due before: May 5, #project, @label, p1, assigned to: me or search: text"},
+		{"due before: someday", "'someday' is not a date, use today, tomorrow, yesterday, a weekday, a date such as May 5 or YYYY-MM-DD"},
+	}
+
+	for _, queryToTest := range queriesToTest {
+		_, err := Parse(queryToTest.query, now)
+		if err == nil {
+			t.Errorf("Expected an error for '%s'", queryToTest.query)
+			continue
+		}
+
+		if failures.KindOf(err) != failures.Validation {
+			t.Errorf("Expected a validation error for '%s', got '%v'", queryToTest.query, failures.KindOf(err))
+		}
+		if err.Error() != queryToTest.expectedMessage {
+			t.Errorf("Expected '%s' for '%s', got '%s'", queryToTest.expectedMessage, queryToTest.query, err.Error())
+		}
+	}
+}
+
+func idsOf(tasks types.TaskList) []uint32 {
+	var ids []uint32
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
@@ -0,0 +1,216 @@
+package filters
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+)
+
+const (
+	errorUnknownTerm = "'%s' is not a filter that can be used, e.g. today, overdue, no date, 7 days, due before: May 5, #project, @label, p1, assigned to: me or search: text"
+	errorInvalidDate = "'%s' is not a date, use today, tomorrow, yesterday, a weekday, a date such as May 5 or YYYY-MM-DD"
+)
+
+var (
+	nextDaysPattern     = regexp.MustCompile(`^(next )?(\d+) days?$`)
+	previousDaysPattern = regexp.MustCompile(`^-(\d+) days?$`)
+	whitespacePattern   = regexp.MustCompile(`\s+`)
+
+	// dateLayouts are the formats of dates that can be used in filters, dates without a year are in the current year
+	dateLayouts = []string{
+		"2006-01-02",
+		"Jan 2 2006",
+		"January 2 2006",
+		"2 Jan 2006",
+		"2 January 2006",
+		"Jan 2",
+		"January 2",
+		"2 Jan",
+		"2 January",
+	}
+
+	// endOfTime is later than any due date, it is used as the end of open ranges
+	endOfTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// parseTerm creates the predicate for a single term of a query, terms are not case sensitive
+func parseTerm(term string, now time.Time) (types.TaskPredicate, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	normalized := whitespacePattern.ReplaceAllString(strings.ToLower(term), " ")
+
+	switch normalized {
+	case "all", "view all":
+		return func(task *types.Task) bool { return true }, nil
+	case "overdue", "od":
+		return types.DueBetween(time.Time{}, today), nil
+	case "no date", "no due date":
+		return func(task *types.Task) bool { return !task.HasDueDate() }, nil
+	case "no labels":
+		return func(task *types.Task) bool { return len(task.Labels) == 0 }, nil
+	case "no priority", "p4":
+		return hasPriority(1), nil
+	case "p1":
+		return hasPriority(4), nil
+	case "p2":
+		return hasPriority(3), nil
+	case "p3":
+		return hasPriority(2), nil
+	case "assigned":
+		return func(task *types.Task) bool { return task.IsAssigned() }, nil
+	case "subtask", "subtasks":
+		return func(task *types.Task) bool { return task.IsSubTask() }, nil
+	}
+
+	if matches := nextDaysPattern.FindStringSubmatch(normalized); matches != nil {
+		days, _ := strconv.Atoi(matches[2])
+		return types.DueBetween(today, today.AddDate(0, 0, days)), nil
+	}
+
+	if matches := previousDaysPattern.FindStringSubmatch(normalized); matches != nil {
+		days, _ := strconv.Atoi(matches[1])
+		return types.DueBetween(today.AddDate(0, 0, -days), today), nil
+	}
+
+	if value, ok := valueAfter(term, "due before:", "date before:"); ok {
+		date, err := parseDate(value, today)
+		if err != nil {
+			return nil, err
+		}
+		return types.DueBetween(time.Time{}, date), nil
+	}
+
+	if value, ok := valueAfter(term, "due after:", "date after:"); ok {
+		date, err := parseDate(value, today)
+		if err != nil {
+			return nil, err
+		}
+		return types.DueBetween(date.AddDate(0, 0, 1), endOfTime), nil
+	}
+
+	if value, ok := valueAfter(term, "due:", "date:"); ok {
+		date, err := parseDate(value, today)
+		if err != nil {
+			return nil, err
+		}
+		return dueOn(date), nil
+	}
+
+	if value, ok := valueAfter(term, "search:"); ok {
+		return types.ParseSearchFilter(value)
+	}
+
+	if value, ok := valueAfter(term, "assigned to:"); ok {
+		return assignedTo(value), nil
+	}
+
+	// ## also matches sub-projects on Todoist, projects are not nested here so it is the same as #
+	if strings.HasPrefix(term, "#") {
+		matches := wildcard(strings.TrimLeft(term, "#"))
+		return func(task *types.Task) bool { return matches(task.ProjectName) }, nil
+	}
+
+	if strings.HasPrefix(term, "@") {
+		matches := wildcard(strings.TrimPrefix(term, "@"))
+		return func(task *types.Task) bool {
+			for _, label := range task.Labels {
+				if matches(label) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+
+	date, err := parseDate(term, today)
+	if err == nil {
+		return dueOn(date), nil
+	}
+
+	return nil, failures.Newf(failures.Validation, errorUnknownTerm, term)
+}
+
+// valueAfter returns the trimmed text following any of the prefixes, the prefixes are not case sensitive
+func valueAfter(term string, prefixes ...string) (string, bool) {
+	for _, prefix := range prefixes {
+		if len(term) >= len(prefix) && strings.EqualFold(term[:len(prefix)], prefix) {
+			return strings.TrimSpace(term[len(prefix):]), true
+		}
+	}
+	return "", false
+}
+
+// parseDate parses a date relative to today. Weekdays are the next occurrence of that day, including today.
+func parseDate(value string, today time.Time) (time.Time, error) {
+	normalized := whitespacePattern.ReplaceAllString(strings.ToLower(strings.TrimSpace(value)), " ")
+
+	switch normalized {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := strings.ToLower(weekday.String())
+		if normalized == name || normalized == name[:3] {
+			daysUntil := (int(weekday) - int(today.Weekday()) + 7) % 7
+			return today.AddDate(0, 0, daysUntil), nil
+		}
+	}
+
+	for _, layout := range dateLayouts {
+		date, err := time.Parse(layout, normalized)
+		if err != nil {
+			continue
+		}
+		if !strings.Contains(layout, "2006") {
+			date = date.AddDate(today.Year(), 0, 0)
+		}
+		return date, nil
+	}
+
+	return time.Time{}, failures.Newf(failures.Validation, errorInvalidDate, value)
+}
+
+func dueOn(date time.Time) types.TaskPredicate {
+	return types.DueBetween(date, date.AddDate(0, 0, 1))
+}
+
+// hasPriority keeps tasks with the priority used by the Todoist API, where 4 is p1 in the Todoist apps
+func hasPriority(priority int16) types.TaskPredicate {
+	return func(task *types.Task) bool { return task.Priority == priority }
+}
+
+// assignedTo keeps tasks assigned to me, to others, or to the collaborator with the name
+func assignedTo(assignee string) types.TaskPredicate {
+	switch strings.ToLower(assignee) {
+	case "me":
+		return func(task *types.Task) bool { return task.AssignedToMe }
+	case "others":
+		return func(task *types.Task) bool { return task.IsAssigned() && !task.AssignedToMe }
+	}
+
+	matches := wildcard(assignee)
+	return func(task *types.Task) bool { return task.IsAssigned() && matches(task.ResponsibleName) }
+}
+
+// wildcard creates a case insensitive matcher for the pattern, where * matches any text
+func wildcard(pattern string) func(string) bool {
+	pattern = strings.TrimSpace(pattern)
+	if !strings.Contains(pattern, "*") {
+		return func(text string) bool { return strings.EqualFold(text, pattern) }
+	}
+
+	parts := strings.Split(pattern, "*")
+	for index, part := range parts {
+		parts[index] = regexp.QuoteMeta(part)
+	}
+	expression := regexp.MustCompile("(?i)^" + strings.Join(parts, ".*") + "$")
+	return expression.MatchString
+}
@@ -0,0 +1,100 @@
+package filters
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGivenATermWhenFilteringThenOnlyMatchingTasksAreKept(t *testing.T) {
+	var termsToTest = []struct {
+		term        string
+		expectedIDs []uint32
+	}{
+		{"all", []uint32{1, 2, 3, 4, 5, 6}},
+		{"View All", []uint32{1, 2, 3, 4, 5, 6}},
+
+		{"today", []uint32{2, 3}},
+		{"Tomorrow", []uint32{6}},
+		{"yesterday", []uint32{1}},
+		{"overdue", []uint32{1}},
+		{"od", []uint32{1}},
+		{"no date", []uint32{5}},
+		{"no  due date", []uint32{5}},
+		{"2 days", []uint32{2, 3, 6}},
+		{"next 7 days", []uint32{2, 3, 4, 6}},
+		{"-1 day", []uint32{1}},
+		{"due before: today", []uint32{1}},
+		{"date before: 2020-05-14", []uint32{1, 2, 3}},
+		{"due after: tomorrow", []uint32{4}},
+		{"Due After: May 13", []uint32{4, 6}},
+		{"due: 13 May", []uint32{2, 3}},
+		{"date: thursday", []uint32{6}},
+		{"wed", []uint32{2, 3}},
+		{"Monday", []uint32{4}},
+		{"May 18", []uint32{4}},
+		{"may 18 2020", []uint32{4}},
+		{"2020-05-12", []uint32{1}},
+
+		{"#work", []uint32{1, 2}},
+		{"##Personal", []uint32{4, 5}},
+		{"#Work*", []uint32{1, 2, 6}},
+		{"#*s", []uint32{3}},
+		{"#Nowhere", nil},
+
+		{"@email", []uint32{1}},
+		{"@QUICK", []uint32{3}},
+		{"@shop*", []uint32{3}},
+		{"no labels", []uint32{2, 4, 5, 6}},
+
+		{"p1", []uint32{1, 6}},
+		{"P2", []uint32{2}},
+		{"p3", []uint32{4}},
+		{"p4", []uint32{3, 5}},
+		{"no priority", []uint32{3, 5}},
+
+		{"assigned", []uint32{2, 6}},
+		{"assigned to: me", []uint32{2}},
+		{"assigned to: others", []uint32{6}},
+		{"Assigned to: alex smith", []uint32{6}},
+		{"assigned to: Alex*", []uint32{6}},
+		{"assigned to: Jordan", nil},
+
+		{"subtask", []uint32{5}},
+		{"Subtasks", []uint32{5}},
+
+		{"search: re", []uint32{1, 2, 5, 6}},
+		{"search: BOOK", []uint32{5}},
+		{"search: /^P/", []uint32{4, 6}},
+	}
+
+	for _, termToTest := range termsToTest {
+		predicate, err := parseTerm(termToTest.term, now)
+		if err != nil {
+			t.Errorf("Expected no error for '%s', got '%s'", termToTest.term, err.Error())
+			continue
+		}
+
+		actualIDs := idsOf(tasks.Filter(predicate))
+		if !reflect.DeepEqual(termToTest.expectedIDs, actualIDs) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", termToTest.expectedIDs, termToTest.term, actualIDs)
+		}
+	}
+}
+
+func TestGivenAnInvalidTermWhenParsingThenAnErrorIsReturned(t *testing.T) {
+	var termsToTest = []string{
+		"someday",
+		"p0",
+		"due before:",
+		"date: May 32",
+		"search: /(unclosed/",
+		"next few days",
+	}
+
+	for _, term := range termsToTest {
+		_, err := parseTerm(term, now)
+		if err == nil {
+			t.Errorf("Expected an error for '%s'", term)
+		}
+	}
+}
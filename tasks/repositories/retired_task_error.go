@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"fmt"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/tasks/types"
+)
+
+// RetiredTaskError is returned when a task is referenced by an id that belonged to a task that has since been completed or deleted.
+// The id is not given to another task for a while so that a stale reference cannot change a different task.
+// The Todoist id of the task is kept so that a completed task can still be referenced, e.g. to uncomplete it.
+type RetiredTaskError struct {
+	ID          uint32
+	TodoistID   int64
+	TemporaryID string
+	Content     string
+}
+
+func (e *RetiredTaskError) Error() string {
+	return fmt.Sprintf("The task %d no longer exists, '%s' was completed or deleted since the tasks were last listed.", e.ID, e.Content)
+}
+
+// Kind returns failures.NotFound, the task that was referenced does not exist anymore
+func (e *RetiredTaskError) Kind() failures.Kind {
+	return failures.NotFound
+}
+
+// Task returns the task the id belonged to as far as it is known, it can be used to reference the task in commands sent to Todoist
+func (e *RetiredTaskError) Task() *types.Task {
+	return &types.Task{
+		ID:          e.ID,
+		TodoistID:   e.TodoistID,
+		TemporaryID: e.TemporaryID,
+		Content:     e.Content,
+	}
+}
+
+// IsRetiredTask returns true if the error occurred because the task that was referenced has been completed or deleted
+func IsRetiredTask(err error) bool {
+	_, isRetiredTask := err.(*RetiredTaskError)
+	return isRetiredTask
+}
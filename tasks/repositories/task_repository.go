@@ -3,6 +3,8 @@ package repositories
 import (
 	"encoding/json"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/kpdowns/todoist-cli/storage"
 	"github.com/kpdowns/todoist-cli/tasks/types"
@@ -13,16 +15,34 @@ const (
 	errorRepositoryTaskNotFound         = "The requested task does not exist"
 	errorRepositoryErrorPersistingTasks = "An error occurred while persisting the list of tasks to disk"
 	errorRepositoryErrorDeletingTasks   = "An error occurred deleting the persisted tasks"
+
+	// retiredIDLifetime is how long the id of a completed or deleted task is kept from being given to a new task
+	retiredIDLifetime = 7 * 24 * time.Hour
 )
 
 // TaskRepository handles persisting the task with the cli's own internal identifier
 type TaskRepository interface {
 	GetAll() (types.TaskList, error)
 	Get(uint32) (*types.Task, error)
-	CreateAll(types.TaskList) (types.TaskList, error)
+	CreateAll(types.TaskList, map[string]int64) (types.TaskList, error)
 	DeleteAll() error
 }
 
+// taskStore is what is persisted to disk, the tasks as they were last listed and the ids of tasks that have since gone away
+type taskStore struct {
+	Tasks   types.TaskList `json:"tasks"`
+	Retired []retiredTask  `json:"retired"`
+}
+
+// retiredTask is the id of a task that was completed or deleted, kept so that stale references to it can be detected
+type retiredTask struct {
+	ID          uint32    `json:"id"`
+	TodoistID   int64     `json:"todoist_id"`
+	TemporaryID string    `json:"temp_id,omitempty"`
+	Content     string    `json:"content"`
+	RetiredAt   time.Time `json:"retired_at"`
+}
+
 type taskRepository struct {
 	file storage.File
 }
@@ -36,59 +56,102 @@ func NewTaskRepository(file storage.File) TaskRepository {
 
 // GetAll retrieves all tasks, error if an error occurs while retrieving the tasks
 func (r *taskRepository) GetAll() (types.TaskList, error) {
-	contents, err := r.file.ReadContents()
+	store, err := r.read()
 	if err != nil {
-		return nil, errors.New(errorRepositoryNotAbleToGetTask)
+		return nil, err
 	}
 
-	var tasks types.TaskList
-	err = json.Unmarshal([]byte(contents), &tasks)
-	if err != nil {
-		return nil, errors.New(errorRepositoryNotAbleToGetTask)
-	}
-
-	return tasks, nil
+	return store.Tasks, nil
 }
 
-// Get retrieves a single task with the provided id, error if the task does not exist
+// Get retrieves a single task with the provided id, error if the task does not exist. A RetiredTaskError is returned
+// when the id belonged to a task that has been completed or deleted since the tasks were listed.
 func (r *taskRepository) Get(taskID uint32) (*types.Task, error) {
-	tasks, err := r.GetAll()
+	store, err := r.read()
 	if err != nil {
-		return nil, errors.New(errorRepositoryNotAbleToGetTask)
+		return nil, err
 	}
 
-	for _, task := range tasks {
+	for _, task := range store.Tasks {
 		if task.ID == taskID {
 			return &task, nil
 		}
 	}
 
+	for _, retired := range store.Retired {
+		if retired.ID == taskID {
+			return nil, &RetiredTaskError{ID: retired.ID, TodoistID: retired.TodoistID, TemporaryID: retired.TemporaryID, Content: retired.Content}
+		}
+	}
+
 	return nil, errors.New(errorRepositoryTaskNotFound)
 }
 
-// CreateAll persists all tasks with a generated id for later retrieval, returns a new list of tasks with the generated ids populated if there is no error
-func (r *taskRepository) CreateAll(tasks types.TaskList) (types.TaskList, error) {
-	var tasksToPersist types.TaskList
-
-	id := uint32(1)
-	for _, task := range tasks {
-		taskToPersist := types.Task{
-			ID:        id,
-			Checked:   task.Checked,
-			Content:   task.Content,
-			DayOrder:  task.DayOrder,
-			DueDate:   task.DueDate,
-			Priority:  task.Priority,
-			TodoistID: task.TodoistID,
+// CreateAll persists all tasks, returns a new list of tasks with their ids populated if there is no error. Tasks that were
+// persisted before keep their id, new tasks are given the lowest id that is free. The ids of tasks that are no longer in the
+// list are retired and only given to new tasks once they have been retired for a while. Tasks that were persisted while they
+// were only known by their temporary id are recognized by the Todoist id they were assigned in the temporary id mapping.
+func (r *taskRepository) CreateAll(tasks types.TaskList, temporaryIDMapping map[string]int64) (types.TaskList, error) {
+	previousStore, err := r.read()
+	if err != nil {
+		previousStore = &taskStore{}
+	}
+
+	now := time.Now()
+	store := &taskStore{}
+	for _, retired := range previousStore.Retired {
+		if now.Sub(retired.RetiredAt) < retiredIDLifetime {
+			if retired.TodoistID == 0 {
+				retired.TodoistID = temporaryIDMapping[retired.TemporaryID]
+			}
+			store.Retired = append(store.Retired, retired)
+		}
+	}
+	for _, previousTask := range previousStore.Tasks {
+		todoistID := previousTask.TodoistID
+		if todoistID == 0 {
+			todoistID = temporaryIDMapping[previousTask.TemporaryID]
 		}
-		tasksToPersist = append(tasksToPersist, taskToPersist)
-		id++
+		store.Retired = append(store.Retired, retiredTask{
+			ID:          previousTask.ID,
+			TodoistID:   todoistID,
+			TemporaryID: previousTask.TemporaryID,
+			Content:     previousTask.Content,
+			RetiredAt:   now,
+		})
 	}
 
-	taskString, _ := json.Marshal(tasksToPersist)
-	err := r.file.OverwriteContents(string(taskString))
+	tasksToPersist := make(types.TaskList, len(tasks))
+	usedIDs := make(map[uint32]bool)
+	for index, task := range tasks {
+		tasksToPersist[index] = task
+		tasksToPersist[index].ID = 0
+		if id, found := store.takeRetiredID(task); found && !usedIDs[id] {
+			tasksToPersist[index].ID = id
+			usedIDs[id] = true
+		}
+	}
+
+	for _, retired := range store.Retired {
+		usedIDs[retired.ID] = true
+	}
+
+	nextID := uint32(1)
+	for index := range tasksToPersist {
+		if tasksToPersist[index].ID != 0 {
+			continue
+		}
+		for usedIDs[nextID] {
+			nextID++
+		}
+		tasksToPersist[index].ID = nextID
+		usedIDs[nextID] = true
+	}
+
+	store.Tasks = tasksToPersist
+	err = r.write(store)
 	if err != nil {
-		return nil, errors.New(errorRepositoryErrorPersistingTasks)
+		return nil, err
 	}
 
 	return tasksToPersist, nil
@@ -103,3 +166,51 @@ func (r *taskRepository) DeleteAll() error {
 
 	return nil
 }
+
+// read returns the persisted tasks, nothing has been persisted when the file is empty. Files written by earlier versions
+// contain only the list of tasks.
+func (r *taskRepository) read() (*taskStore, error) {
+	contents, err := r.file.ReadContents()
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetTask)
+	}
+
+	store := &taskStore{}
+	contents = strings.TrimSpace(contents)
+	switch {
+	case contents == "":
+		return store, nil
+	case strings.HasPrefix(contents, "["):
+		err = json.Unmarshal([]byte(contents), &store.Tasks)
+	default:
+		err = json.Unmarshal([]byte(contents), store)
+	}
+	if err != nil {
+		return nil, errors.New(errorRepositoryNotAbleToGetTask)
+	}
+
+	return store, nil
+}
+
+func (r *taskRepository) write(store *taskStore) error {
+	contents, _ := json.Marshal(store)
+	err := r.file.OverwriteContents(string(contents))
+	if err != nil {
+		return errors.New(errorRepositoryErrorPersistingTasks)
+	}
+
+	return nil
+}
+
+// takeRetiredID removes the retired id of the task from the store and returns it, if the task has been persisted before
+func (s *taskStore) takeRetiredID(task types.Task) (uint32, bool) {
+	for index, retired := range s.Retired {
+		isSameTask := (task.TodoistID != 0 && retired.TodoistID == task.TodoistID) ||
+			(task.TemporaryID != "" && retired.TemporaryID == task.TemporaryID)
+		if isSameTask {
+			s.Retired = append(s.Retired[:index], s.Retired[index+1:]...)
+			return retired.ID, true
+		}
+	}
+	return 0, false
+}
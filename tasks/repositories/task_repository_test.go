@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kpdowns/todoist-cli/failures"
 	"github.com/kpdowns/todoist-cli/mocks"
 	"github.com/kpdowns/todoist-cli/tasks/types"
 	"github.com/stretchr/testify/assert"
@@ -154,12 +155,13 @@ func TestPersistingAllTasks(t *testing.T) {
 		inMemoryFile := &mocks.MockFile{}
 		repository := NewTaskRepository(inMemoryFile)
 
-		_, err := repository.CreateAll(tasksToWrite)
+		_, err := repository.CreateAll(tasksToWrite, nil)
 
 		assert.Nil(t, err)
 
-		var tasksAfterBeingWritten types.TaskList
-		json.Unmarshal([]byte(inMemoryFile.Contents), &tasksAfterBeingWritten)
+		var store taskStore
+		json.Unmarshal([]byte(inMemoryFile.Contents), &store)
+		tasksAfterBeingWritten := store.Tasks
 		assert.Equal(t, uint32(1), tasksAfterBeingWritten[0].ID)
 		assert.Equal(t, int64(100), tasksAfterBeingWritten[0].TodoistID)
 		assert.Equal(t, uint32(2), tasksAfterBeingWritten[1].ID)
@@ -182,11 +184,12 @@ func TestPersistingAllTasks(t *testing.T) {
 		inMemoryFile := &mocks.MockFile{}
 		repository := NewTaskRepository(inMemoryFile)
 
-		tasksAfterBeingWritten, err := repository.CreateAll(tasksToWrite)
+		tasksAfterBeingWritten, err := repository.CreateAll(tasksToWrite, nil)
 
 		assert.Nil(t, err)
-		var storedTasks types.TaskList
-		json.Unmarshal([]byte(inMemoryFile.Contents), &storedTasks)
+		var store taskStore
+		json.Unmarshal([]byte(inMemoryFile.Contents), &store)
+		storedTasks := store.Tasks
 		assert.Equal(t, storedTasks[0].ID, tasksAfterBeingWritten[0].ID)
 		assert.Equal(t, storedTasks[0].TodoistID, tasksAfterBeingWritten[0].TodoistID)
 		assert.Equal(t, storedTasks[1].ID, tasksAfterBeingWritten[1].ID)
@@ -201,7 +204,7 @@ func TestPersistingAllTasks(t *testing.T) {
 		}
 		repository := NewTaskRepository(inMemoryFile)
 
-		tasksAfterBeingWritten, err := repository.CreateAll(tasksToWrite)
+		tasksAfterBeingWritten, err := repository.CreateAll(tasksToWrite, nil)
 		assert.NotNil(t, err)
 		assert.Nil(t, tasksAfterBeingWritten)
 		assert.Equal(t, errorRepositoryErrorPersistingTasks, err.Error())
@@ -210,6 +213,107 @@ func TestPersistingAllTasks(t *testing.T) {
 
 }
 
+func TestKeepingTaskIDsStable(t *testing.T) {
+
+	idsOf := func(tasks types.TaskList) map[int64]uint32 {
+		ids := make(map[int64]uint32)
+		for _, task := range tasks {
+			ids[task.TodoistID] = task.ID
+		}
+		return ids
+	}
+
+	t.Run("Given persisted tasks, when persisting the tasks again in a different order, then every task keeps its id", func(t *testing.T) {
+		repository := NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(types.TaskList{{TodoistID: 100}, {TodoistID: 200}, {TodoistID: 300}}, nil)
+
+		tasks, err := repository.CreateAll(types.TaskList{{TodoistID: 300}, {TodoistID: 100}, {TodoistID: 200}}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[int64]uint32{100: 1, 200: 2, 300: 3}, idsOf(tasks))
+		assert.Equal(t, uint32(3), tasks[0].ID)
+	})
+
+	t.Run("Given a task that is no longer listed, when persisting new tasks, then its id is retired rather than given to a new task", func(t *testing.T) {
+		repository := NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(types.TaskList{{TodoistID: 100}, {TodoistID: 200, Content: "buy milk"}, {TodoistID: 300}}, nil)
+
+		tasks, err := repository.CreateAll(types.TaskList{{TodoistID: 100}, {TodoistID: 300}, {TodoistID: 400}}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[int64]uint32{100: 1, 300: 3, 400: 4}, idsOf(tasks))
+
+		task, err := repository.Get(2)
+		assert.Nil(t, task)
+		assert.True(t, IsRetiredTask(err))
+		assert.Equal(t, "The task 2 no longer exists, 'buy milk' was completed or deleted since the tasks were last listed.", err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+	})
+
+	t.Run("Given a retired task, when it is listed again, then it gets its id back", func(t *testing.T) {
+		repository := NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(types.TaskList{{TodoistID: 100}, {TodoistID: 200}}, nil)
+		repository.CreateAll(types.TaskList{{TodoistID: 100}}, nil)
+
+		tasks, err := repository.CreateAll(types.TaskList{{TodoistID: 100}, {TodoistID: 300}, {TodoistID: 200}}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[int64]uint32{100: 1, 200: 2, 300: 3}, idsOf(tasks))
+	})
+
+	t.Run("Given a task created while offline, when persisting the tasks again, then it keeps its id until it has been synced", func(t *testing.T) {
+		repository := NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(types.TaskList{{TemporaryID: "temp-1"}, {TodoistID: 100}}, nil)
+
+		tasks, err := repository.CreateAll(types.TaskList{{TodoistID: 100}, {TemporaryID: "temp-1"}}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), tasks[0].ID)
+		assert.Equal(t, uint32(1), tasks[1].ID)
+	})
+
+	t.Run("Given a task created while offline, when persisting the tasks after it has been synced, then it keeps its id", func(t *testing.T) {
+		repository := NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(types.TaskList{{TodoistID: 100}, {TemporaryID: "temp-1"}}, nil)
+
+		tasks, err := repository.CreateAll(types.TaskList{{TodoistID: 200}, {TodoistID: 100}, {TodoistID: 300}}, map[string]int64{"temp-1": 200})
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[int64]uint32{100: 1, 200: 2, 300: 3}, idsOf(tasks))
+	})
+
+	t.Run("Given an id that has been retired for longer than the lifetime of retired ids, when persisting new tasks, then the id is given to a new task", func(t *testing.T) {
+		store := taskStore{
+			Tasks: types.TaskList{{ID: 1, TodoistID: 100}},
+			Retired: []retiredTask{
+				{ID: 2, TodoistID: 200, RetiredAt: time.Now().Add(-retiredIDLifetime - time.Hour)},
+				{ID: 3, TodoistID: 300, RetiredAt: time.Now().Add(-time.Hour)},
+			},
+		}
+		contents, _ := json.Marshal(store)
+		repository := NewTaskRepository(&mocks.MockFile{Contents: string(contents)})
+
+		tasks, err := repository.CreateAll(types.TaskList{{TodoistID: 100}, {TodoistID: 400}, {TodoistID: 500}}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[int64]uint32{100: 1, 400: 2, 500: 4}, idsOf(tasks))
+
+		_, err = repository.Get(3)
+		assert.True(t, IsRetiredTask(err))
+	})
+
+	t.Run("Given tasks persisted by an earlier version as a list, when persisting the tasks, then the listed ids are kept", func(t *testing.T) {
+		contents, _ := json.Marshal(types.TaskList{{ID: 1, TodoistID: 200}, {ID: 2, TodoistID: 100}})
+		repository := NewTaskRepository(&mocks.MockFile{Contents: string(contents)})
+
+		tasks, err := repository.CreateAll(types.TaskList{{TodoistID: 100}, {TodoistID: 200}}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[int64]uint32{100: 2, 200: 1}, idsOf(tasks))
+	})
+
+}
+
 func TestDeletingTasks(t *testing.T) {
 
 	t.Run("When deleting all tasks and an error occurs, then an error is returned", func(t *testing.T) {
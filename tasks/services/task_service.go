@@ -1,42 +1,89 @@
 package services
 
 import (
-	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	labelTypes "github.com/kpdowns/todoist-cli/labels/types"
+	projectTypes "github.com/kpdowns/todoist-cli/projects/types"
+	"github.com/kpdowns/todoist-cli/replica"
+	replicaTypes "github.com/kpdowns/todoist-cli/replica/types"
 	"github.com/kpdowns/todoist-cli/tasks/repositories"
 	"github.com/kpdowns/todoist-cli/tasks/types"
 	"github.com/kpdowns/todoist-cli/todoist"
 	"github.com/kpdowns/todoist-cli/todoist/requests"
 	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/kpdowns/todoist-cli/todoist/responses"
 )
 
 const (
 	errorNotCurrentlyAuthenticated   = "Error, you are not currently logged in."
 	errorOccurredDuringSyncOperation = "Error occurred while syncing with Todoist."
 	errorNoContent                   = "Task content must be provided when adding a task."
-	errorNoTaskToComplete            = "The requested task does not exist."
+	errorNoTasksProvided             = "At least one task must be provided."
+	errorNoTaskToComplete            = "The requested task %d does not exist."
 	errorFailedToCompleteTask        = "An error occurred while flagging the task as completed on Todoist, please try again."
+	errorFailedToUncompleteTask      = "An error occurred while flagging the task as uncompleted on Todoist, please try again."
+	errorUncompleteByContent         = "Completed tasks cannot be found by their content, reference '%s' by its id or Todoist id instead."
+	errorTaskHasSubTasks             = "The task %d has %d uncompleted sub-tasks that Todoist completes along with it, use --with-sub-tasks to complete them as well."
+	errorFailedToQuickAddTask        = "An error occurred while adding the task on Todoist, please try again."
+	errorQuickAddWithoutResponse     = "Todoist did not respond, the task may have been added. List your tasks before adding it again."
+	errorProjectNotFound             = "The project '%s' does not exist."
+	errorSectionNotFound             = "The section '%s' does not exist."
+	errorParentTaskNotFound          = "The requested parent task does not exist."
+	errorNoChangesToTask             = "At least one property of the task must be changed when updating a task."
+	errorInvalidDeadline             = "The deadline '%s' is not a date, provide it as YYYY-MM-DD."
+	errorNoTaskToUpdate              = "The requested task does not exist."
+	errorFailedToUpdateTask          = "An error occurred while updating the task on Todoist, please try again."
+	errorNoTaskToDelete              = "The requested task %d does not exist."
+	errorFailedToDeleteTask          = "An error occurred while deleting the task on Todoist, please try again."
+	errorNoMoveDestination           = "A project, section or parent task to move the task to must be provided."
+	errorAmbiguousMoveDestination    = "A task is moved either below a parent task or to a project and section, not both."
+	errorMoveBelowItself             = "The task %d cannot be moved below itself."
+	errorMoveBelowSubTask            = "The task %d cannot be moved below its own sub-task %d."
+	errorNoTaskToMove                = "The requested task %d does not exist."
+	errorFailedToMoveTask            = "An error occurred while moving the task on Todoist, please try again."
+	errorTaskListedTwice             = "The task %d is listed more than once, list each task once in the order it should have."
+	errorTasksNotSiblings            = "The tasks %d and %d do not have the same parent task in the same project and section, only tasks that are next to each other can be reordered."
+	errorNoTaskToReorder             = "The requested task %d does not exist."
+	errorFailedToReorderTasks        = "An error occurred while reordering the tasks on Todoist, please try again."
+	errorNoTaskWithID                = "The requested task %d does not exist."
+	errorNoTaskWithTodoistID         = "The task t:%d does not exist or has already been completed."
+	errorNoTaskMatchesContent        = "No task matches '%s'."
 )
 
 // TaskService provides functionality to retrieve and update tasks on Todoist
 type TaskService interface {
 	GetAllTasks() (types.TaskList, error)
-	AddTask(content string, due string, priority int) error
-	CompleteTask(taskID uint32) error
+	GetCachedTasks() (types.TaskList, error)
+	ResolveTask(reference types.TaskReference) (*types.Task, error)
+	AddTask(options types.AddTaskOptions) (int64, error)
+	QuickAddTask(text string) (int64, error)
+	CompleteTasks(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error)
+	UncompleteTasks(references []types.TaskReference) error
+	UpdateTask(taskID uint32, changes types.TaskChanges) error
+	DeleteTasks(taskIDs []uint32) error
+	MoveTask(taskID uint32, options types.MoveTaskOptions) error
+	ReorderTasks(taskIDs []uint32, options types.ReorderTaskOptions) error
 }
 
 type taskService struct {
 	api                   todoist.API
 	authenticationService authentication.Service
+	replicaService        replica.Service
 	taskRepository        repositories.TaskRepository
 }
 
 // NewTaskService creates a new instance of the task service
-func NewTaskService(api todoist.API, authenticationService authentication.Service, taskRepository repositories.TaskRepository) TaskService {
+func NewTaskService(api todoist.API, authenticationService authentication.Service, replicaService replica.Service, taskRepository repositories.TaskRepository) TaskService {
 	return &taskService{
 		api:                   api,
 		authenticationService: authenticationService,
+		replicaService:        replicaService,
 		taskRepository:        taskRepository,
 	}
 }
@@ -45,27 +92,66 @@ func NewTaskService(api todoist.API, authenticationService authentication.Servic
 func (s *taskService) GetAllTasks() (types.TaskList, error) {
 	isAuthenticated, err := s.authenticationService.IsAuthenticated()
 	if err != nil || !isAuthenticated {
-		return nil, errors.New(errorNotCurrentlyAuthenticated)
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
 	}
 
-	accessToken, _ := s.authenticationService.GetAccessToken()
-	resourceTypes := []requests.ResourceType{"items"}
-	syncQuery := requests.NewQuery(accessToken.AccessToken, "*", resourceTypes)
+	replica, err := s.replicaService.Sync()
+	if err != nil {
+		return nil, failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	return s.tasksInReplica(replica)
+}
+
+// GetCachedTasks returns the tasks as they were after the last sync without contacting Todoist, sorted the same way as GetAllTasks
+func (s *taskService) GetCachedTasks() (types.TaskList, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
 
-	syncResponse, err := s.api.ExecuteSyncQuery(syncQuery)
+	replica, err := s.replicaService.Cached()
 	if err != nil {
-		return nil, errors.New(errorOccurredDuringSyncOperation)
+		return nil, err
+	}
+
+	return s.tasksInReplica(replica)
+}
+
+func (s *taskService) tasksInReplica(replica *replicaTypes.Replica) (types.TaskList, error) {
+	projectNames := make(map[int64]string)
+	for _, project := range replica.Projects {
+		projectNames[project.TodoistID] = project.Name
 	}
+	labelNames := replica.LabelNames()
+	collaboratorNames := replica.CollaboratorNames()
+	userID := replica.UserID()
+	location := replica.UserLocation()
 
 	var tasks types.TaskList
-	for _, item := range syncResponse.Items {
-		newTask := item.ToTask()
+	for _, item := range replica.UncompletedItems() {
+		newTask := item.ToTask(location)
+		newTask.ProjectName = projectNames[newTask.ProjectID]
+		for _, labelID := range item.Labels {
+			if labelName, ok := labelNames[labelID]; ok {
+				newTask.Labels = append(newTask.Labels, labelName)
+			}
+		}
+		if newTask.IsAssigned() {
+			newTask.ResponsibleName = collaboratorNames[newTask.ResponsibleID]
+			newTask.AssignedToMe = newTask.ResponsibleID == userID
+		}
 		tasks = append(tasks, newTask)
 	}
 
 	sortedTasks := tasks.SortByDueDateThenSortByPriority()
 
-	persistedTasks, err := s.taskRepository.CreateAll(sortedTasks)
+	var temporaryIDMapping map[string]int64
+	if history, err := s.replicaService.History(); err == nil {
+		temporaryIDMapping = history.TempIDMapping
+	}
+
+	persistedTasks, err := s.taskRepository.CreateAll(sortedTasks, temporaryIDMapping)
 	if err != nil {
 		return nil, err
 	}
@@ -73,59 +159,720 @@ func (s *taskService) GetAllTasks() (types.TaskList, error) {
 	return persistedTasks, nil
 }
 
-func (s *taskService) AddTask(content string, due string, priority int) error {
+// ResolveTask returns the task identified by the reference. Todoist ids and content are looked up in the tasks as they were
+// last listed, the tasks are synced when the task is not found among them. An AmbiguousTaskError is returned when the content
+// matches several tasks.
+func (s *taskService) ResolveTask(reference types.TaskReference) (*types.Task, error) {
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	if reference.ID != 0 {
+		task, err := s.taskRepository.Get(reference.ID)
+		if repositories.IsRetiredTask(err) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, failures.Newf(failures.NotFound, errorNoTaskWithID, reference.ID)
+		}
+		return task, nil
+	}
+
+	matchingTasks := func(tasks types.TaskList) types.TaskList {
+		if reference.TodoistID != 0 {
+			return tasks.Filter(func(task *types.Task) bool { return task.TodoistID == reference.TodoistID })
+		}
+		return tasks.MatchContent(reference.Content)
+	}
+
+	listedTasks, err := s.taskRepository.GetAll()
+	matches := matchingTasks(listedTasks)
+	if err != nil || len(matches) == 0 {
+		syncedTasks, err := s.GetAllTasks()
+		if err != nil {
+			return nil, err
+		}
+		matches = matchingTasks(syncedTasks)
+	}
+
+	switch {
+	case len(matches) == 0 && reference.TodoistID != 0:
+		return nil, failures.Newf(failures.NotFound, errorNoTaskWithTodoistID, reference.TodoistID)
+	case len(matches) == 0:
+		return nil, failures.Newf(failures.NotFound, errorNoTaskMatchesContent, reference.Content)
+	case len(matches) > 1:
+		return nil, &types.AmbiguousTaskError{Reference: reference.Content, Matches: matches}
+	}
+
+	return &matches[0], nil
+}
+
+// AddTask creates a new task on Todoist, resolving the project, section, labels and parent task to their Todoist ids.
+// @label tokens in the content are removed from it and attached as labels, labels that do not exist yet are created.
+// The Todoist id of the created task is returned, it is 0 when the task has been queued to be created on the next sync.
+func (s *taskService) AddTask(options types.AddTaskOptions) (int64, error) {
+	content, contentLabelNames := labelTypes.ExtractLabelNames(options.Content)
 	if content == "" {
-		return errors.New(errorNoContent)
+		return 0, failures.New(failures.Validation, errorNoContent)
 	}
+	labelNames := labelTypes.UniqueLabelNames(append(options.Labels, contentLabelNames...))
 
 	isAuthenticated, err := s.authenticationService.IsAuthenticated()
 	if err != nil || !isAuthenticated {
-		return errors.New(errorNotCurrentlyAuthenticated)
+		return 0, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
 	}
 
 	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
 
 	arguments := make(map[string]interface{})
 	arguments["content"] = content
 
-	if due != "" {
-		arguments["due"] = &requests.Due{
-			Value: due,
+	if options.Due != "" {
+		arguments["due"] = requests.NewDue(options.Due)
+	}
+	if options.Deadline != "" {
+		deadline, err := requests.NewDeadline(options.Deadline)
+		if err != nil {
+			return 0, failures.Newf(failures.Validation, errorInvalidDeadline, options.Deadline)
+		}
+		arguments["deadline"] = deadline
+	}
+	if options.Priority != 0 {
+		arguments["priority"] = options.Priority
+	}
+
+	if options.Project != "" || options.Section != "" || len(labelNames) > 0 {
+		replica, err := s.replicaService.Sync()
+		if err != nil {
+			return 0, failures.Wrap(err, errorOccurredDuringSyncOperation)
+		}
+
+		err = resolveProjectAndSection(replica, options, arguments)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(labelNames) > 0 {
+			arguments["labels"] = resolveLabels(builder, replica, labelNames)
 		}
 	}
-	if priority != 0 {
-		arguments["priority"] = priority
+
+	if options.ParentID != 0 {
+		parentTask, err := s.taskRepository.Get(options.ParentID)
+		if repositories.IsRetiredTask(err) {
+			return 0, err
+		}
+		if err != nil {
+			return 0, failures.New(failures.NotFound, errorParentTaskNotFound)
+		}
+		arguments["parent_id"] = parentTask.TodoistReference()
 	}
 
-	command := requests.NewCommand(accessToken.AccessToken, commands.ItemAdd, arguments)
-	err = s.api.ExecuteSyncCommand(command)
+	temporaryID := builder.Add(commands.ItemAdd, arguments)
+	temporaryIDMapping, err := s.replicaService.ExecuteCommand(builder.Build())
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return 0, err
+	}
 	if err != nil {
-		return errors.New(errorOccurredDuringSyncOperation)
+		return 0, failures.Wrap(err, errorOccurredDuringSyncOperation)
+	}
+
+	return temporaryIDMapping[temporaryID], warning
+}
+
+// QuickAddTask creates a new task from a line of text such as "Buy milk tomorrow 5pm #Groceries @errand p2", letting Todoist's quick add
+// read the due date, project, labels and priority out of it. When Todoist cannot be reached the text is parsed locally with
+// types.ParseQuickAdd and the task is queued the same way AddTask queues it, the Todoist id returned is then 0. When the text was sent
+// but no response arrived the task is not queued, as Todoist may already have added it. The added task is journaled so that it can be undone.
+// Rejections of the commands queued while offline, which are sent first, and failing to journal the task are returned as a failures.Warning.
+func (s *taskService) QuickAddTask(text string) (int64, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return 0, failures.New(failures.Validation, errorNoContent)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return 0, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	err = s.replicaService.FlushQueuedCommands()
+	if err == todoist.ErrUnreachable {
+		return s.AddTask(types.ParseQuickAdd(text))
+	}
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		warning, err = failures.NewWarning(err), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	item, err := s.api.QuickAdd(requests.NewQuickAdd(accessToken.AccessToken, text))
+	if err == todoist.ErrUnreachable {
+		return s.AddTask(types.ParseQuickAdd(text))
+	}
+	if err == todoist.ErrNoResponse {
+		return 0, failures.Wrap(err, errorQuickAddWithoutResponse)
+	}
+	if err != nil {
+		return 0, failures.Wrap(err, errorFailedToQuickAddTask)
+	}
+
+	journalErr := s.replicaService.JournalQuickAdd(item)
+	return item.TodoistID, failures.NewWarning(warning, journalErr)
+}
+
+func resolveProjectAndSection(replica *replicaTypes.Replica, options types.AddTaskOptions, arguments map[string]interface{}) error {
+	var projectID int64
+	if options.Project != "" {
+		var projects projectTypes.ProjectList
+		for _, todoistProject := range replica.Projects {
+			projects = append(projects, todoistProject.ToProject())
+		}
+
+		project := projects.FindByNameOrID(options.Project)
+		if project == nil {
+			return failures.Newf(failures.NotFound, errorProjectNotFound, options.Project)
+		}
+
+		projectID = project.TodoistID
+		arguments["project_id"] = project.TodoistReference()
+	}
+
+	if options.Section != "" {
+		section := findSection(replica.Sections, projectID, options.Section)
+		if section == nil {
+			return failures.Newf(failures.NotFound, errorSectionNotFound, options.Section)
+		}
+
+		arguments["section_id"] = section.TodoistID
+		arguments["project_id"] = section.ProjectID
 	}
 
 	return nil
 }
 
-func (s *taskService) CompleteTask(taskID uint32) error {
+// resolveLabels returns the references to the labels with the provided names, a label_add command is added to the builder for
+// each label that does not exist yet and the label is referenced by the temporary id of that command
+func resolveLabels(builder *requests.CommandBuilder, replica *replicaTypes.Replica, names []string) []interface{} {
+	var labels labelTypes.LabelList
+	for _, todoistLabel := range replica.Labels {
+		labels = append(labels, todoistLabel.ToLabel())
+	}
+
+	references := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		if label := labels.FindByName(name); label != nil {
+			references = append(references, label.TodoistReference())
+			continue
+		}
+
+		arguments := make(map[string]interface{})
+		arguments["name"] = name
+		references = append(references, builder.Add(commands.LabelAdd, arguments))
+	}
+	return references
+}
+
+// findSection returns the section with the provided name or Todoist id, restricted to the project when a project id is provided
+func findSection(sections []responses.Section, projectID int64, nameOrID string) *responses.Section {
+	sectionID, err := strconv.ParseInt(nameOrID, 10, 64)
+	isID := err == nil
+
+	for index, section := range sections {
+		if projectID != 0 && section.ProjectID != projectID {
+			continue
+		}
+
+		if strings.EqualFold(section.Name, nameOrID) || (isID && section.TodoistID == sectionID) {
+			return &sections[index]
+		}
+	}
+
+	return nil
+}
+
+// CompleteTasks flags the tasks with the provided ids as completed on Todoist, all tasks are completed in a single sync request.
+// Todoist completes the sub-tasks of a task along with it, so a task with uncompleted sub-tasks that were not provided is only
+// completed when options.WithSubTasks is true. Sub-tasks are completed before their parent.
+// Todoist reschedules recurring tasks to their next occurrence unless options.Forever is true, the tasks are synced after
+// completing recurring tasks and the rescheduled tasks are returned with their next due date.
+func (s *taskService) CompleteTasks(taskIDs []uint32, options types.CompleteTaskOptions) (types.TaskList, error) {
+	if len(taskIDs) == 0 {
+		return nil, failures.New(failures.Validation, errorNoTasksProvided)
+	}
+
 	isAuthenticated, err := s.authenticationService.IsAuthenticated()
 	if err != nil || !isAuthenticated {
-		return errors.New(errorNotCurrentlyAuthenticated)
+		return nil, failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	commandType := commands.ItemClose
+	if options.Forever {
+		commandType = commands.ItemComplete
 	}
 
 	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
+
+	var tasksToComplete types.TaskList
+	isRequested := make(map[uint32]bool)
+	for _, taskID := range taskIDs {
+		taskToComplete, err := s.taskRepository.Get(taskID)
+		if repositories.IsRetiredTask(err) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, failures.Newf(failures.NotFound, errorNoTaskToComplete, taskID)
+		}
+
+		tasksToComplete = append(tasksToComplete, *taskToComplete)
+		isRequested[taskID] = true
+	}
 
-	taskToComplete, err := s.taskRepository.Get(taskID)
+	listedTasks, err := s.taskRepository.GetAll()
 	if err != nil {
-		return errors.New(errorNoTaskToComplete)
+		return nil, err
+	}
+
+	var completedTasks types.TaskList
+	isCompleted := make(map[uint32]bool)
+	complete := func(task types.Task) {
+		isCompleted[task.ID] = true
+		completedTasks = append(completedTasks, task)
+		addCompletionCommand(builder, commandType, task)
+	}
+
+	for _, taskToComplete := range tasksToComplete {
+		subTasks := listedTasks.SubTasksOf(taskToComplete.TodoistID)
+
+		if !options.WithSubTasks {
+			var unrequestedSubTasks int
+			for _, subTask := range subTasks {
+				if !isRequested[subTask.ID] {
+					unrequestedSubTasks++
+				}
+			}
+			if unrequestedSubTasks > 0 {
+				return nil, failures.Newf(failures.Validation, errorTaskHasSubTasks, taskToComplete.ID, unrequestedSubTasks)
+			}
+		}
+
+		for index := len(subTasks) - 1; index >= 0; index-- {
+			if isCompleted[subTasks[index].ID] {
+				continue
+			}
+			if options.WithSubTasks || isRequested[subTasks[index].ID] {
+				complete(subTasks[index])
+			}
+		}
+
+		if !isCompleted[taskToComplete.ID] {
+			complete(taskToComplete)
+		}
+	}
+
+	_, err = s.replicaService.ExecuteCommand(builder.Build())
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, failures.Wrap(err, errorFailedToCompleteTask)
+	}
+
+	if options.Forever {
+		return nil, warning
 	}
+	return s.rescheduledTasks(completedTasks), warning
+}
 
+func addCompletionCommand(builder *requests.CommandBuilder, commandType commands.CommandType, task types.Task) {
 	arguments := make(map[string]interface{})
-	arguments["id"] = taskToComplete.TodoistID
-	command := requests.NewCommand(accessToken.AccessToken, commands.ItemClose, arguments)
-	err = s.api.ExecuteSyncCommand(command)
+	arguments["id"] = task.TodoistReference()
+	builder.Add(commandType, arguments)
+}
+
+// rescheduledTasks returns the recurring tasks among the closed tasks as Todoist rescheduled them, the tasks are synced to learn
+// their next due date. Nothing is returned when the tasks could not be synced or the commands were queued to be sent later, the
+// tasks have been completed either way.
+func (s *taskService) rescheduledTasks(closedTasks types.TaskList) types.TaskList {
+	isRecurring := make(map[int64]bool)
+	for _, closedTask := range closedTasks {
+		if closedTask.IsRecurring && closedTask.TodoistID != 0 {
+			isRecurring[closedTask.TodoistID] = true
+		}
+	}
+	if len(isRecurring) == 0 {
+		return nil
+	}
+
+	queuedCommands, err := s.replicaService.GetQueuedCommands()
+	if err != nil || len(queuedCommands) > 0 {
+		return nil
+	}
+
+	listedTasks, err := s.GetAllTasks()
 	if err != nil {
-		return errors.New(errorFailedToCompleteTask)
+		return nil
 	}
 
-	return nil
+	var rescheduledTasks types.TaskList
+	for _, listedTask := range listedTasks {
+		if isRecurring[listedTask.TodoistID] {
+			rescheduledTasks = append(rescheduledTasks, listedTask)
+		}
+	}
+	return rescheduledTasks
+}
+
+// UncompleteTasks flags completed tasks as uncompleted on Todoist. Completed tasks are no longer listed, so they are referenced by the id they
+// had when they were last listed or by their Todoist id.
+func (s *taskService) UncompleteTasks(references []types.TaskReference) error {
+	if len(references) == 0 {
+		return failures.New(failures.Validation, errorNoTasksProvided)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
+
+	for _, reference := range references {
+		var task *types.Task
+		switch {
+		case reference.ID != 0:
+			task, err = s.taskRepository.Get(reference.ID)
+			if repositories.IsRetiredTask(err) {
+				task, err = err.(*repositories.RetiredTaskError).Task(), nil
+			}
+			if err != nil {
+				return failures.Newf(failures.NotFound, errorNoTaskWithID, reference.ID)
+			}
+		case reference.TodoistID != 0:
+			task = &types.Task{TodoistID: reference.TodoistID}
+		default:
+			return failures.Newf(failures.Validation, errorUncompleteByContent, reference.Content)
+		}
+
+		addCompletionCommand(builder, commands.ItemUncomplete, *task)
+	}
+
+	_, err = s.replicaService.ExecuteCommand(builder.Build())
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUncompleteTask)
+	}
+
+	return warning
+}
+
+// UpdateTask updates the task with the provided id, only the properties that have changed are sent to Todoist
+func (s *taskService) UpdateTask(taskID uint32, changes types.TaskChanges) error {
+	if !changes.HasChanges() {
+		return failures.New(failures.Validation, errorNoChangesToTask)
+	}
+
+	if changes.Content != nil && *changes.Content == "" {
+		return failures.New(failures.Validation, errorNoContent)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
+
+	taskToUpdate, err := s.taskRepository.Get(taskID)
+	if repositories.IsRetiredTask(err) {
+		return err
+	}
+	if err != nil {
+		return failures.New(failures.NotFound, errorNoTaskToUpdate)
+	}
+
+	arguments := make(map[string]interface{})
+	arguments["id"] = taskToUpdate.TodoistReference()
+
+	if changes.Content != nil {
+		arguments["content"] = *changes.Content
+	}
+	if changes.Due != nil {
+		arguments["due"] = requests.NewDue(*changes.Due)
+	}
+	if changes.Deadline != nil {
+		deadline, err := requests.NewDeadline(*changes.Deadline)
+		if err != nil {
+			return failures.Newf(failures.Validation, errorInvalidDeadline, *changes.Deadline)
+		}
+		arguments["deadline"] = deadline
+	}
+	if changes.Priority != nil {
+		arguments["priority"] = *changes.Priority
+	}
+	if changes.Description != nil {
+		arguments["description"] = *changes.Description
+	}
+	if changes.Labels != nil {
+		replica, err := s.replicaService.Sync()
+		if err != nil {
+			return failures.Wrap(err, errorOccurredDuringSyncOperation)
+		}
+		arguments["labels"] = resolveLabels(builder, replica, labelTypes.UniqueLabelNames(*changes.Labels))
+	}
+
+	builder.Add(commands.ItemUpdate, arguments)
+	_, err = s.replicaService.ExecuteCommand(builder.Build())
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToUpdateTask)
+	}
+
+	return warning
+}
+
+// DeleteTasks deletes the tasks with the provided ids, Todoist deletes the sub-tasks of the tasks along with them
+func (s *taskService) DeleteTasks(taskIDs []uint32) error {
+	if len(taskIDs) == 0 {
+		return failures.New(failures.Validation, errorNoTasksProvided)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
+
+	isDeleted := make(map[uint32]bool)
+	for _, taskID := range taskIDs {
+		if isDeleted[taskID] {
+			continue
+		}
+
+		taskToDelete, err := s.taskRepository.Get(taskID)
+		if repositories.IsRetiredTask(err) {
+			return err
+		}
+		if err != nil {
+			return failures.Newf(failures.NotFound, errorNoTaskToDelete, taskID)
+		}
+
+		arguments := make(map[string]interface{})
+		arguments["id"] = taskToDelete.TodoistReference()
+		builder.Add(commands.ItemDelete, arguments)
+		isDeleted[taskID] = true
+	}
+
+	_, err = s.replicaService.ExecuteCommand(builder.Build())
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToDeleteTask)
+	}
+
+	return warning
+}
+
+// MoveTask moves the task with the provided id below another task or to a project and section, Todoist moves the sub-tasks of the task
+// along with it
+func (s *taskService) MoveTask(taskID uint32, options types.MoveTaskOptions) error {
+	if !options.HasDestination() {
+		return failures.New(failures.Validation, errorNoMoveDestination)
+	}
+
+	if options.ParentID != 0 && (options.Project != "" || options.Section != "") {
+		return failures.New(failures.Validation, errorAmbiguousMoveDestination)
+	}
+
+	if options.ParentID == taskID {
+		return failures.Newf(failures.Validation, errorMoveBelowItself, taskID)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
+
+	taskToMove, err := s.taskRepository.Get(taskID)
+	if repositories.IsRetiredTask(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Newf(failures.NotFound, errorNoTaskToMove, taskID)
+	}
+
+	arguments := make(map[string]interface{})
+	if options.ParentID != 0 {
+		parentTask, err := s.taskRepository.Get(options.ParentID)
+		if repositories.IsRetiredTask(err) {
+			return err
+		}
+		if err != nil {
+			return failures.New(failures.NotFound, errorParentTaskNotFound)
+		}
+
+		listedTasks, err := s.taskRepository.GetAll()
+		if err != nil {
+			return err
+		}
+		for _, subTask := range listedTasks.SubTasksOf(taskToMove.TodoistID) {
+			if subTask.ID == parentTask.ID {
+				return failures.Newf(failures.Validation, errorMoveBelowSubTask, taskID, parentTask.ID)
+			}
+		}
+
+		arguments["parent_id"] = parentTask.TodoistReference()
+	} else {
+		replica, err := s.replicaService.Sync()
+		if err != nil {
+			return failures.Wrap(err, errorOccurredDuringSyncOperation)
+		}
+
+		err = resolveProjectAndSection(replica, types.AddTaskOptions{Project: options.Project, Section: options.Section}, arguments)
+		if err != nil {
+			return err
+		}
+
+		// item_move accepts a single destination, the section already determines the project
+		if _, ok := arguments["section_id"]; ok {
+			delete(arguments, "project_id")
+		}
+	}
+	arguments["id"] = taskToMove.TodoistReference()
+
+	builder.Add(commands.ItemMove, arguments)
+	_, err = s.replicaService.ExecuteCommand(builder.Build())
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToMoveTask)
+	}
+
+	return warning
+}
+
+// ReorderTasks puts the tasks with the provided ids in the order they are provided in. The tasks take the positions the tasks held
+// between them, so that tasks that are not provided keep their position. Tasks are reordered among their siblings, which must share
+// their parent task, project and section, unless options.DayOrder is true in which case their order in the Today and Next 7 days views is changed.
+func (s *taskService) ReorderTasks(taskIDs []uint32, options types.ReorderTaskOptions) error {
+	if len(taskIDs) == 0 {
+		return failures.New(failures.Validation, errorNoTasksProvided)
+	}
+
+	isAuthenticated, err := s.authenticationService.IsAuthenticated()
+	if err != nil || !isAuthenticated {
+		return failures.New(failures.NotAuthenticated, errorNotCurrentlyAuthenticated)
+	}
+
+	accessToken, _ := s.authenticationService.GetAccessToken()
+	builder := requests.NewCommandBuilder(accessToken.AccessToken)
+
+	var tasksToReorder types.TaskList
+	isListed := make(map[uint32]bool)
+	for _, taskID := range taskIDs {
+		if isListed[taskID] {
+			return failures.Newf(failures.Validation, errorTaskListedTwice, taskID)
+		}
+		isListed[taskID] = true
+
+		taskToReorder, err := s.taskRepository.Get(taskID)
+		if repositories.IsRetiredTask(err) {
+			return err
+		}
+		if err != nil {
+			return failures.Newf(failures.NotFound, errorNoTaskToReorder, taskID)
+		}
+
+		if !options.DayOrder && len(tasksToReorder) > 0 {
+			firstTask := tasksToReorder[0]
+			if firstTask.ProjectID != taskToReorder.ProjectID || firstTask.SectionID != taskToReorder.SectionID || firstTask.ParentID != taskToReorder.ParentID {
+				return failures.Newf(failures.Validation, errorTasksNotSiblings, firstTask.ID, taskToReorder.ID)
+			}
+		}
+
+		tasksToReorder = append(tasksToReorder, *taskToReorder)
+	}
+
+	currentOrders := make([]int32, 0, len(tasksToReorder))
+	for _, task := range tasksToReorder {
+		if options.DayOrder {
+			currentOrders = append(currentOrders, task.DayOrder)
+		} else {
+			currentOrders = append(currentOrders, task.ChildOrder)
+		}
+	}
+	orders := positions(currentOrders)
+
+	if options.DayOrder {
+		idsToOrders := make(map[string]interface{})
+		for index, task := range tasksToReorder {
+			idsToOrders[fmt.Sprint(task.TodoistReference())] = orders[index]
+		}
+
+		arguments := make(map[string]interface{})
+		arguments["ids_to_orders"] = idsToOrders
+		builder.Add(commands.ItemUpdateDayOrders, arguments)
+	} else {
+		var items []interface{}
+		for index, task := range tasksToReorder {
+			items = append(items, map[string]interface{}{
+				"id":          task.TodoistReference(),
+				"child_order": orders[index],
+			})
+		}
+
+		arguments := make(map[string]interface{})
+		arguments["items"] = items
+		builder.Add(commands.ItemReorder, arguments)
+	}
+
+	_, err = s.replicaService.ExecuteCommand(builder.Build())
+	warning, err := failures.SeparateWarning(err)
+	if todoist.IsCommandRejection(err) {
+		return err
+	}
+	if err != nil {
+		return failures.Wrap(err, errorFailedToReorderTasks)
+	}
+
+	return warning
+}
+
+// positions returns the orders held by the tasks from first to last, orders that are shared by several tasks, such as those of
+// tasks created while offline, are moved up so that every task has a position of its own
+func positions(orders []int32) []int32 {
+	sortedOrders := append([]int32(nil), orders...)
+	sort.Slice(sortedOrders, func(i, j int) bool { return sortedOrders[i] < sortedOrders[j] })
+
+	for index := 1; index < len(sortedOrders); index++ {
+		if sortedOrders[index] <= sortedOrders[index-1] {
+			sortedOrders[index] = sortedOrders[index-1] + 1
+		}
+	}
+	return sortedOrders
 }
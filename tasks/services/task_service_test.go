@@ -2,12 +2,22 @@ package services
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/kpdowns/todoist-cli/authentication"
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/kpdowns/todoist-cli/journal"
 	"github.com/kpdowns/todoist-cli/mocks"
+	"github.com/kpdowns/todoist-cli/queue"
+	"github.com/kpdowns/todoist-cli/replica"
+	replicaTypes "github.com/kpdowns/todoist-cli/replica/types"
 	"github.com/kpdowns/todoist-cli/tasks/repositories"
 	"github.com/kpdowns/todoist-cli/tasks/types"
+	"github.com/kpdowns/todoist-cli/todoist"
 	"github.com/kpdowns/todoist-cli/todoist/requests"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
 	"github.com/kpdowns/todoist-cli/todoist/responses"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,11 +31,12 @@ func TestGettingAllTasks(t *testing.T) {
 		}
 		mockAPI := &mocks.MockAPI{}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, nil)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
 		_, err := taskService.GetAllTasks()
 		assert.NotNil(t, err)
 		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+		assert.Equal(t, failures.NotAuthenticated, failures.KindOf(err))
 
 	})
 
@@ -40,7 +51,7 @@ func TestGettingAllTasks(t *testing.T) {
 			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, nil)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
 		_, err := taskService.GetAllTasks()
 
@@ -62,13 +73,13 @@ func TestGettingAllTasks(t *testing.T) {
 			},
 		}
 		mockRepository := &mocks.MockTaskRepository{
-			CreateAllFunc: func(types.TaskList) (types.TaskList, error) {
+			CreateAllFunc: func(types.TaskList, map[string]int64) (types.TaskList, error) {
 				wasCreateAllCalled = true
 				return nil, nil
 			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, mockRepository)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
 
 		_, err := taskService.GetAllTasks()
 
@@ -111,7 +122,7 @@ func TestGettingAllTasks(t *testing.T) {
 			},
 		}
 		repository := repositories.NewTaskRepository(&mocks.MockFile{})
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, repository)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), repository)
 
 		returnedTasks, err := taskService.GetAllTasks()
 
@@ -126,6 +137,45 @@ func TestGettingAllTasks(t *testing.T) {
 
 	})
 
+	t.Run("When getting all tasks and and no error occurs, then the project names are resolved from the projects in the sync response", func(t *testing.T) {
+
+		var requestedResourceTypes requests.ResourceTypes
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				requestedResourceTypes = query.ResourceTypes
+				return &responses.Query{
+					Items: []responses.Item{
+						{
+							TodoistID: 1,
+							ProjectID: 100,
+							Due:       &responses.Due{},
+						},
+					},
+					Projects: []responses.Project{
+						{
+							TodoistID: 100,
+							Name:      "Work",
+						},
+					},
+				}, nil
+			},
+		}
+		repository := repositories.NewTaskRepository(&mocks.MockFile{})
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), repository)
+
+		returnedTasks, err := taskService.GetAllTasks()
+
+		assert.Nil(t, err)
+		assert.Contains(t, requestedResourceTypes, requests.ResourceType("projects"))
+		assert.Equal(t, int64(100), returnedTasks[0].ProjectID)
+		assert.Equal(t, "Work", returnedTasks[0].ProjectName)
+
+	})
+
 	t.Run("When getting all tasks and and no error occurs, and an error occurs while persisting the tasks, then an error is returned", func(t *testing.T) {
 
 		expectedError := errors.New("Error")
@@ -139,12 +189,12 @@ func TestGettingAllTasks(t *testing.T) {
 			},
 		}
 		mockRepository := &mocks.MockTaskRepository{
-			CreateAllFunc: func(types.TaskList) (types.TaskList, error) {
+			CreateAllFunc: func(types.TaskList, map[string]int64) (types.TaskList, error) {
 				return nil, expectedError
 			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, mockRepository)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
 
 		_, err := taskService.GetAllTasks()
 
@@ -153,6 +203,271 @@ func TestGettingAllTasks(t *testing.T) {
 
 	})
 
+	t.Run("When getting all tasks with fixed due dates, then the due dates are converted to the timezone of the user", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					User: &responses.User{TzInfo: responses.TzInfo{Timezone: "Asia/Tokyo"}},
+					Items: []responses.Item{
+						{TodoistID: 1, Due: &responses.Due{DateString: "2020-04-10T15:00:00Z", Timezone: "Europe/Madrid", String: "every day at 17:00", IsRecurring: true}},
+					},
+				}, nil
+			},
+		}
+		repository := repositories.NewTaskRepository(&mocks.MockFile{})
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), repository)
+
+		returnedTasks, err := taskService.GetAllTasks()
+
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2020, 4, 11, 0, 0, 0, 0, time.UTC), returnedTasks[0].DueDate)
+		assert.True(t, returnedTasks[0].DueHasTime)
+		assert.Equal(t, "Europe/Madrid", returnedTasks[0].DueTimezone)
+		assert.True(t, returnedTasks[0].IsRecurring)
+
+	})
+
+}
+
+func TestGettingCachedTasks(t *testing.T) {
+
+	t.Run("When getting cached tasks, then the replica is read without syncing and label names are resolved", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			HistoryFunc: func() (*journal.Journal, error) {
+				return &journal.Journal{}, nil
+			},
+			CachedFunc: func() (*replicaTypes.Replica, error) {
+				return &replicaTypes.Replica{
+					SyncToken: "token",
+					Items:     []responses.Item{{TodoistID: 1, Content: "buy milk", ProjectID: 10, Labels: []int64{100, 200}}},
+					Projects:  []responses.Project{{TodoistID: 10, Name: "Personal"}},
+					Labels:    []responses.Label{{TodoistID: 100, Name: "errand"}},
+				}, nil
+			},
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			CreateAllFunc: func(tasks types.TaskList, _ map[string]int64) (types.TaskList, error) {
+				return tasks, nil
+			},
+		}
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, mockRepository)
+
+		tasks, err := taskService.GetCachedTasks()
+
+		assert.Nil(t, err)
+		if assert.Len(t, tasks, 1) {
+			assert.Equal(t, "Personal", tasks[0].ProjectName)
+			assert.Equal(t, []string{"errand"}, tasks[0].Labels)
+		}
+
+	})
+
+	t.Run("When getting cached tasks, then the collaborators tasks are assigned to are resolved", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			HistoryFunc: func() (*journal.Journal, error) {
+				return &journal.Journal{}, nil
+			},
+			CachedFunc: func() (*replicaTypes.Replica, error) {
+				return &replicaTypes.Replica{
+					SyncToken: "token",
+					Items: []responses.Item{
+						{TodoistID: 1, ResponsibleUID: 1000},
+						{TodoistID: 2, ResponsibleUID: 2000},
+						{TodoistID: 3},
+					},
+					User:          &responses.User{TodoistID: 1000, FullName: "Sam"},
+					Collaborators: []responses.Collaborator{{TodoistID: 2000, FullName: "Alex"}},
+				}, nil
+			},
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			CreateAllFunc: func(tasks types.TaskList, _ map[string]int64) (types.TaskList, error) {
+				return tasks, nil
+			},
+		}
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, mockRepository)
+
+		tasks, err := taskService.GetCachedTasks()
+
+		assert.Nil(t, err)
+		if assert.Len(t, tasks, 3) {
+			assert.Equal(t, "Sam", tasks[0].ResponsibleName)
+			assert.True(t, tasks[0].AssignedToMe)
+			assert.Equal(t, "Alex", tasks[1].ResponsibleName)
+			assert.False(t, tasks[1].AssignedToMe)
+			assert.False(t, tasks[2].IsAssigned())
+			assert.False(t, tasks[2].AssignedToMe)
+		}
+
+	})
+
+	t.Run("When getting cached tasks and nothing has been synced yet, then the error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockReplicaService := &mocks.MockReplicaService{
+			CachedFunc: func() (*replicaTypes.Replica, error) {
+				return nil, failures.New(failures.NotFound, "nothing cached")
+			},
+		}
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, mockReplicaService, nil)
+
+		_, err := taskService.GetCachedTasks()
+
+		assert.Equal(t, "nothing cached", err.Error())
+
+	})
+
+}
+
+func TestResolvingATask(t *testing.T) {
+
+	mockAuthenticationService := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	listedTasks := types.TaskList{
+		{ID: 1, TodoistID: 100, Content: "Buy milk"},
+		{ID: 2, TodoistID: 200, Content: "Buy oat milk"},
+		{ID: 3, TodoistID: 300, Content: "Write report"},
+	}
+	newRepository := func() repositories.TaskRepository {
+		repository := repositories.NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(listedTasks, nil)
+		return repository
+	}
+	syncedReplicaService := &mocks.MockReplicaService{
+		HistoryFunc: func() (*journal.Journal, error) {
+			return &journal.Journal{}, nil
+		},
+		SyncFunc: func() (*replicaTypes.Replica, error) {
+			return &replicaTypes.Replica{
+				Items: []responses.Item{
+					{TodoistID: 100, Content: "Buy milk"},
+					{TodoistID: 400, Content: "Plan holiday"},
+				},
+			}, nil
+		},
+	}
+
+	t.Run("When resolving a task and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}, nil, nil)
+
+		_, err := taskService.ResolveTask(types.TaskReference{ID: 1})
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	var referencesToTest = []struct {
+		description  string
+		reference    types.TaskReference
+		expectedTask uint32
+	}{
+		{"by id", types.TaskReference{ID: 3}, 3},
+		{"by Todoist id", types.TaskReference{TodoistID: 200}, 2},
+		{"by part of its content", types.TaskReference{Content: "REPORT"}, 3},
+	}
+
+	for _, referenceToTest := range referencesToTest {
+		referenceToTest := referenceToTest
+
+		t.Run("When resolving a listed task "+referenceToTest.description+", then the task is returned without syncing", func(t *testing.T) {
+
+			taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, newRepository())
+
+			task, err := taskService.ResolveTask(referenceToTest.reference)
+			assert.Nil(t, err)
+			assert.Equal(t, referenceToTest.expectedTask, task.ID)
+
+		})
+	}
+
+	t.Run("When resolving a task by id and the id has been retired, then the stale reference is refused", func(t *testing.T) {
+
+		repository := newRepository()
+		repository.CreateAll(listedTasks[1:], nil)
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, repository)
+
+		_, err := taskService.ResolveTask(types.TaskReference{ID: 1})
+		assert.True(t, repositories.IsRetiredTask(err))
+
+	})
+
+	t.Run("When resolving a task by id and the id does not exist, then a not found error is returned", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, newRepository())
+
+		_, err := taskService.ResolveTask(types.TaskReference{ID: 9})
+		assert.Equal(t, fmt.Sprintf(errorNoTaskWithID, 9), err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When resolving a task by content that matches several tasks, then an error listing the matching tasks is returned", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, &mocks.MockReplicaService{}, newRepository())
+
+		_, err := taskService.ResolveTask(types.TaskReference{Content: "buy"})
+		if assert.True(t, types.IsAmbiguousTask(err)) {
+			assert.Len(t, err.(*types.AmbiguousTaskError).Matches, 2)
+		}
+
+	})
+
+	t.Run("When resolving a task that has not been listed, then the tasks are synced and the task is looked up again", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, syncedReplicaService, newRepository())
+
+		task, err := taskService.ResolveTask(types.TaskReference{TodoistID: 400})
+		assert.Nil(t, err)
+		assert.Equal(t, "Plan holiday", task.Content)
+		assert.NotZero(t, task.ID)
+
+		task, err = taskService.ResolveTask(types.TaskReference{Content: "holiday"})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(400), task.TodoistID)
+
+	})
+
+	var missingReferencesToTest = []struct {
+		reference     types.TaskReference
+		expectedError string
+	}{
+		{types.TaskReference{TodoistID: 900}, fmt.Sprintf(errorNoTaskWithTodoistID, 900)},
+		{types.TaskReference{Content: "dentist"}, fmt.Sprintf(errorNoTaskMatchesContent, "dentist")},
+	}
+
+	for _, referenceToTest := range missingReferencesToTest {
+		referenceToTest := referenceToTest
+
+		t.Run("When resolving "+referenceToTest.reference.String()+" and no synced task matches, then a not found error is returned", func(t *testing.T) {
+
+			taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, syncedReplicaService, newRepository())
+
+			_, err := taskService.ResolveTask(referenceToTest.reference)
+			assert.Equal(t, referenceToTest.expectedError, err.Error())
+			assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+		})
+	}
+
 }
 
 func TestAddingANewTask(t *testing.T) {
@@ -164,9 +479,9 @@ func TestAddingANewTask(t *testing.T) {
 		}
 		mockAPI := &mocks.MockAPI{}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, nil)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
-		err := taskService.AddTask("content", "today", 1)
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Due: "today", Priority: 1})
 		assert.NotNil(t, err)
 		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
 
@@ -178,14 +493,14 @@ func TestAddingANewTask(t *testing.T) {
 			AuthenticatedStateToReturn: true,
 		}
 		mockAPI := &mocks.MockAPI{
-			ExecuteSyncCommandFunction: func(command requests.Command) error {
-				return nil
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{}, nil
 			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, nil)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
-		err := taskService.AddTask("", "today", 1)
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "", Due: "today", Priority: 1})
 		assert.NotNil(t, err)
 		assert.Equal(t, errorNoContent, err.Error())
 
@@ -197,14 +512,14 @@ func TestAddingANewTask(t *testing.T) {
 			AuthenticatedStateToReturn: true,
 		}
 		mockAPI := &mocks.MockAPI{
-			ExecuteSyncCommandFunction: func(command requests.Command) error {
-				return errors.New("test error")
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
 			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, nil)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
-		err := taskService.AddTask("content", "today", 1)
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Due: "today", Priority: 1})
 		assert.NotNil(t, err)
 		assert.Equal(t, errorOccurredDuringSyncOperation, err.Error())
 
@@ -216,108 +531,1569 @@ func TestAddingANewTask(t *testing.T) {
 			AuthenticatedStateToReturn: true,
 		}
 		mockAPI := &mocks.MockAPI{
-			ExecuteSyncCommandFunction: func(command requests.Command) error {
-				return nil
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{}, nil
 			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, nil)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
-		err := taskService.AddTask("content", "today", 1)
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Due: "today", Priority: 1})
 
 		assert.Nil(t, err)
 
 	})
 
-}
-
-func TestCompletingATask(t *testing.T) {
-
-	t.Run("When completing a task, and the client is not authenticated, then an error is returned", func(t *testing.T) {
+	t.Run("When adding a task and Todoist creates it, then the Todoist id of the task is returned", func(t *testing.T) {
 
-		mockAPI := &mocks.MockAPI{}
-		mockRepository := &mocks.MockTaskRepository{}
 		mockAuthenticationService := &mocks.MockAuthenticationService{
-			AuthenticatedStateToReturn: false,
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{
+					TempIDMapping: map[string]int64{command.Commands[0].TemporaryID: 12345},
+				}, nil
+			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, mockRepository)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
-		err := taskService.CompleteTask(1)
-		assert.NotNil(t, err)
-		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+		todoistID, err := taskService.AddTask(types.AddTaskOptions{Content: "content"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(12345), todoistID)
 
 	})
 
-	t.Run("When completing a task, and the task does not exist, then an error is returned", func(t *testing.T) {
+	t.Run("When adding a task with a deadline, then the deadline is sent to Todoist", func(t *testing.T) {
 
-		mockAPI := &mocks.MockAPI{}
-		mockRepository := &mocks.MockTaskRepository{
-			GetFunc: func(uint32) (*types.Task, error) {
-				return nil, errors.New("Test error")
+		var executedCommand requests.Command
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
 			},
 		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "File taxes", Deadline: "2020-05-15"})
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, &requests.Deadline{Date: "2020-05-15"}, executedCommand.Commands[0].Arguments["deadline"])
+		}
+
+	})
+
+	t.Run("When adding a task with a deadline that is not a date, then a validation error is returned", func(t *testing.T) {
+
 		mockAuthenticationService := &mocks.MockAuthenticationService{
 			AuthenticatedStateToReturn: true,
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, mockRepository)
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, nil, nil)
 
-		err := taskService.CompleteTask(1)
-		assert.NotNil(t, err)
-		assert.Equal(t, errorNoTaskToComplete, err.Error())
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "File taxes", Deadline: "next friday"})
+		assert.Equal(t, fmt.Sprintf(errorInvalidDeadline, "next friday"), err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
 
 	})
 
-	t.Run("When completing a task, and the task exists, if when calling the API to complete the task an error occurs then an error is returned", func(t *testing.T) {
+	t.Run("When adding a task and Todoist rejects it, then the rejection is returned", func(t *testing.T) {
 
-		mockRepository := &mocks.MockTaskRepository{
-			GetFunc: func(uint32) (*types.Task, error) {
-				return &types.Task{
-					ID: 1,
-				}, nil
-			},
-		}
+		var rejection todoist.CommandErrors
 		mockAuthenticationService := &mocks.MockAuthenticationService{
 			AuthenticatedStateToReturn: true,
 		}
 		mockAPI := &mocks.MockAPI{
-			ExecuteSyncCommandFunction: func(command requests.Command) error {
-				return errors.New("Test error")
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				rejection = todoist.CommandErrors{{UUID: command.Commands[0].UUID, Code: 36, Message: "Date is invalid"}}
+				return &responses.Command{}, rejection
 			},
 		}
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, mockRepository)
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
 
-		err := taskService.CompleteTask(1)
-		assert.NotNil(t, err)
-		assert.Equal(t, errorFailedToCompleteTask, err.Error())
+		todoistID, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Due: "someday"})
+
+		assert.Equal(t, rejection, err)
+		assert.False(t, failures.IsWarning(err))
+		assert.Equal(t, int64(0), todoistID)
 
 	})
 
-	t.Run("When completing a task, and the task exists, no error occurs while calling the Todoist API, then no error is returned", func(t *testing.T) {
+	t.Run("When adding a task succeeds but it cannot be journaled, then the Todoist id is returned along with a warning", func(t *testing.T) {
 
-		mockRepository := &mocks.MockTaskRepository{
-			GetFunc: func(uint32) (*types.Task, error) {
-				return &types.Task{
-					ID: 1,
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{
+					TempIDMapping: map[string]int64{command.Commands[0].TemporaryID: 12345},
 				}, nil
 			},
 		}
+		journalRepository := journal.NewJournalRepository(&mocks.MockFile{OverwriteError: errors.New("test error")})
+		replicaService := replica.NewReplicaService(mockAPI, mockAuthenticationService, replica.NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journalRepository)
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, replicaService, nil)
+
+		todoistID, err := taskService.AddTask(types.AddTaskOptions{Content: "content"})
+
+		assert.True(t, failures.IsWarning(err))
+		assert.Equal(t, int64(12345), todoistID)
+
+	})
+
+	t.Run("When adding a task succeeds but Todoist rejects a command queued while offline, then the Todoist id is returned along with the rejection as a warning", func(t *testing.T) {
+
 		mockAuthenticationService := &mocks.MockAuthenticationService{
 			AuthenticatedStateToReturn: true,
 		}
 		mockAPI := &mocks.MockAPI{
-			ExecuteSyncCommandFunction: func(command requests.Command) error {
-				return nil
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{
+					TempIDMapping: map[string]int64{command.Commands[1].TemporaryID: 12345},
+				}, todoist.CommandErrors{{UUID: "queued", Code: 22, Message: "Item not found"}}
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaServiceWithQueuedCommand(mockAPI, mockAuthenticationService), nil)
+
+		todoistID, err := taskService.AddTask(types.AddTaskOptions{Content: "content"})
+
+		assert.True(t, failures.IsWarning(err))
+		assert.Equal(t, todoist.CommandErrors{{UUID: "queued", Code: 22, Message: "Item not found", Queued: true}}, errors.Unwrap(err))
+		assert.Equal(t, int64(12345), todoistID)
+
+	})
+
+}
+
+func TestQuickAddingATask(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+		AccessTokenToReturn:        "access-token",
+	}
+
+	t.Run("When quick adding a task without any text, then a validation error is returned", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, authenticated, nil, nil)
+
+		_, err := taskService.QuickAddTask("  ")
+		assert.Equal(t, errorNoContent, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When quick adding a task and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, nil, nil)
+
+		_, err := taskService.QuickAddTask("Buy milk")
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When quick adding a task, then the text is sent to Todoist's quick add and the Todoist id of the added task is returned", func(t *testing.T) {
+
+		var sentQuickAdd requests.QuickAdd
+		mockAPI := &mocks.MockAPI{
+			QuickAddFunction: func(quickAdd requests.QuickAdd) (*responses.Item, error) {
+				sentQuickAdd = quickAdd
+				return &responses.Item{TodoistID: 12345, Content: "Buy milk"}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), nil)
+
+		todoistID, err := taskService.QuickAddTask(" Buy milk tomorrow 5pm #Groceries @errand p2 ")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(12345), todoistID)
+		assert.Equal(t, requests.NewQuickAdd("access-token", "Buy milk tomorrow 5pm #Groceries @errand p2"), sentQuickAdd)
+
+	})
+
+	t.Run("When quick adding a task and then undoing, then the added task is deleted", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		mockAPI := &mocks.MockAPI{
+			QuickAddFunction: func(quickAdd requests.QuickAdd) (*responses.Item, error) {
+				return &responses.Item{TodoistID: 12345, Content: "Buy milk"}, nil
+			},
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
 			},
 		}
+		replicaService := newReplicaService(mockAPI, authenticated)
 
-		taskService := NewTaskService(mockAPI, mockAuthenticationService, mockRepository)
+		taskService := NewTaskService(mockAPI, authenticated, replicaService, nil)
 
-		err := taskService.CompleteTask(1)
+		_, err := taskService.QuickAddTask("Buy milk tomorrow")
 		assert.Nil(t, err)
 
+		undoneOperations, err := replicaService.Undo(1, false)
+		assert.Nil(t, err)
+		if assert.Len(t, undoneOperations, 1) {
+			assert.Equal(t, "quick add task 'Buy milk'", undoneOperations[0].Description)
+		}
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, commands.ItemDelete, executedCommand.Commands[0].Type)
+			assert.Equal(t, float64(12345), executedCommand.Commands[0].Arguments["id"])
+		}
+
 	})
 
+	t.Run("When quick adding a task succeeds but it cannot be journaled, then the Todoist id is returned along with a warning", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			QuickAddFunction: func(quickAdd requests.QuickAdd) (*responses.Item, error) {
+				return &responses.Item{TodoistID: 12345, Content: "Buy milk"}, nil
+			},
+		}
+		journalRepository := journal.NewJournalRepository(&mocks.MockFile{OverwriteError: errors.New("test error")})
+		replicaService := replica.NewReplicaService(mockAPI, authenticated, replica.NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journalRepository)
+
+		taskService := NewTaskService(mockAPI, authenticated, replicaService, nil)
+
+		todoistID, err := taskService.QuickAddTask("Buy milk")
+		assert.Equal(t, int64(12345), todoistID)
+		assert.True(t, failures.IsWarning(err))
+
+	})
+
+	t.Run("When quick adding a task and Todoist rejects it, then an error of the same kind is returned", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			QuickAddFunction: func(quickAdd requests.QuickAdd) (*responses.Item, error) {
+				return nil, failures.New(failures.Rejected, "test error")
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), nil)
+
+		_, err := taskService.QuickAddTask("Buy milk")
+		assert.Equal(t, errorFailedToQuickAddTask, err.Error())
+		assert.Equal(t, failures.Rejected, failures.KindOf(err))
+
+	})
+
+	t.Run("When quick adding a task and no response arrives, then a network error is returned and nothing is queued", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			QuickAddFunction: func(quickAdd requests.QuickAdd) (*responses.Item, error) {
+				return nil, todoist.ErrNoResponse
+			},
+		}
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		replicaService := replica.NewReplicaService(mockAPI, authenticated, replica.NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		taskService := NewTaskService(mockAPI, authenticated, replicaService, nil)
+
+		_, err := taskService.QuickAddTask("Buy milk")
+		assert.Equal(t, errorQuickAddWithoutResponse, err.Error())
+		assert.Equal(t, failures.Network, failures.KindOf(err))
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Empty(t, queuedCommands)
+
+	})
+
+	t.Run("When quick adding a task and Todoist cannot be reached, then the text is parsed locally and the task is queued", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			QuickAddFunction: func(quickAdd requests.QuickAdd) (*responses.Item, error) {
+				return nil, todoist.ErrUnreachable
+			},
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return nil, todoist.ErrUnreachable
+			},
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, todoist.ErrUnreachable
+			},
+		}
+		replicaRepository := replica.NewReplicaRepository(&mocks.MockFile{})
+		replicaRepository.Update(&replicaTypes.Replica{
+			SyncToken: "sync-token",
+			Projects:  []responses.Project{{TodoistID: 100, Name: "Groceries"}},
+			Labels:    []responses.Label{{TodoistID: 200, Name: "errand"}},
+		})
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		replicaService := replica.NewReplicaService(mockAPI, authenticated, replicaRepository, queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		taskService := NewTaskService(mockAPI, authenticated, replicaService, nil)
+
+		todoistID, err := taskService.QuickAddTask("Buy milk tomorrow 5pm #Groceries @errand p2")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), todoistID)
+
+		queuedCommands, _ := queueRepository.GetAll()
+		if assert.Len(t, queuedCommands, 1) {
+			arguments := queuedCommands[0].Arguments
+			assert.Equal(t, commands.ItemAdd, queuedCommands[0].Type)
+			assert.Equal(t, "Buy milk", arguments["content"])
+			assert.Equal(t, map[string]interface{}{"string": "tomorrow 5pm"}, arguments["due"])
+			assert.Equal(t, float64(3), arguments["priority"])
+			assert.Equal(t, float64(100), arguments["project_id"])
+			assert.Equal(t, []interface{}{float64(200)}, arguments["labels"])
+		}
+
+	})
+
+}
+
+func TestAddingATaskToAProjectSectionOrParent(t *testing.T) {
+
+	syncResponse := &responses.Query{
+		Projects: []responses.Project{
+			{TodoistID: 100, Name: "Work"},
+			{TodoistID: 200, Name: "Personal"},
+		},
+		Sections: []responses.Section{
+			{TodoistID: 1000, Name: "Meetings", ProjectID: 100},
+			{TodoistID: 2000, Name: "Meetings", ProjectID: 200},
+		},
+	}
+
+	newTaskService := func(executedCommand *requests.Command) TaskService {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return syncResponse, nil
+			},
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				*executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(taskID uint32) (*types.Task, error) {
+				if taskID == 3 {
+					return &types.Task{ID: 3, TodoistID: 300}, nil
+				}
+				return nil, errors.New("test error")
+			},
+		}
+
+		return NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+	}
+
+	t.Run("When adding a task to a project by name, then the Todoist id of the project is provided", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Project: "work"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(100), executedCommand.Commands[0].Arguments["project_id"])
+
+	})
+
+	t.Run("When adding a task to a project that does not exist, then an error is returned", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Project: "Groceries"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Sprintf(errorProjectNotFound, "Groceries"), err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When adding a task to a section, then the section is resolved within the provided project", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Project: "Personal", Section: "Meetings"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(200), executedCommand.Commands[0].Arguments["project_id"])
+		assert.Equal(t, int64(2000), executedCommand.Commands[0].Arguments["section_id"])
+
+	})
+
+	t.Run("When adding a task to a section that does not exist, then an error is returned", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", Section: "Errands"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Sprintf(errorSectionNotFound, "Errands"), err.Error())
+
+	})
+
+	t.Run("When adding a task underneath a parent task, then the Todoist id of the parent is provided", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", ParentID: 3})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(300), executedCommand.Commands[0].Arguments["parent_id"])
+
+	})
+
+	t.Run("When adding a task underneath a parent task that does not exist, then an error is returned", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "content", ParentID: 4})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, errorParentTaskNotFound, err.Error())
+
+	})
+
+}
+
+func TestAddingATaskWithLabels(t *testing.T) {
+
+	newTaskService := func(executedCommand *requests.Command) TaskService {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					Labels: []responses.Label{
+						{TodoistID: 100, Name: "errand"},
+						{TodoistID: 200, Name: "Focus"},
+					},
+				}, nil
+			},
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				*executedCommand = command
+				return &responses.Command{
+					TempIDMapping: map[string]int64{command.Commands[len(command.Commands)-1].TemporaryID: 12345},
+				}, nil
+			},
+		}
+
+		return NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), nil)
+	}
+
+	t.Run("When adding a task with existing labels, then the Todoist ids of the labels are provided", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		todoistID, err := taskService.AddTask(types.AddTaskOptions{Content: "buy milk", Labels: []string{"Errand", "@focus"}})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(12345), todoistID)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, []interface{}{int64(100), int64(200)}, executedCommand.Commands[0].Arguments["labels"])
+		}
+
+	})
+
+	t.Run("When adding a task with @label tokens in the content, then the tokens are removed from the content and attached as labels", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "buy milk @errand", Labels: []string{"errand"}})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "buy milk", executedCommand.Commands[0].Arguments["content"])
+		assert.Equal(t, []interface{}{int64(100)}, executedCommand.Commands[0].Arguments["labels"])
+
+	})
+
+	t.Run("When adding a task with a label that does not exist, then the label is created in the same request and referenced by its temporary id", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		todoistID, err := taskService.AddTask(types.AddTaskOptions{Content: "call the plumber @home"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(12345), todoistID)
+		if assert.Len(t, executedCommand.Commands, 2) {
+			labelAdd := executedCommand.Commands[0]
+			assert.Equal(t, commands.LabelAdd, labelAdd.Type)
+			assert.Equal(t, "home", labelAdd.Arguments["name"])
+			assert.Equal(t, commands.ItemAdd, executedCommand.Commands[1].Type)
+			assert.Equal(t, []interface{}{labelAdd.TemporaryID}, executedCommand.Commands[1].Arguments["labels"])
+		}
+
+	})
+
+	t.Run("When adding a task whose content is only labels, then an error is returned", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "@errand"})
+
+		assert.Equal(t, errorNoContent, err.Error())
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+}
+
+func TestCompletingATask(t *testing.T) {
+
+	t.Run("When completing a task, and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{}
+		mockRepository := &mocks.MockTaskRepository{}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When completing a task, and the task does not exist, then an error is returned", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(uint32) (*types.Task, error) {
+				return nil, errors.New("Test error")
+			},
+		}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.NotNil(t, err)
+		assert.Equal(t, fmt.Sprintf(errorNoTaskToComplete, 1), err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When completing a task, and the task exists, if when calling the API to complete the task an error occurs then an error is returned", func(t *testing.T) {
+
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(uint32) (*types.Task, error) {
+				return &types.Task{
+					ID: 1,
+				}, nil
+			},
+			GetAllFunc: func() (types.TaskList, error) {
+				return nil, nil
+			},
+		}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("Test error")
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.NotNil(t, err)
+		assert.Equal(t, errorFailedToCompleteTask, err.Error())
+
+	})
+
+	t.Run("When completing a task, and the task exists, no error occurs while calling the Todoist API, then no error is returned", func(t *testing.T) {
+
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(uint32) (*types.Task, error) {
+				return &types.Task{
+					ID: 1,
+				}, nil
+			},
+			GetAllFunc: func() (types.TaskList, error) {
+				return nil, nil
+			},
+		}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.Nil(t, err)
+
+	})
+
+	t.Run("When completing a task succeeds but Todoist rejects a command queued while offline, then the rejection is returned as a warning", func(t *testing.T) {
+
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(uint32) (*types.Task, error) {
+				return &types.Task{ID: 1, TodoistID: 100}, nil
+			},
+			GetAllFunc: func() (types.TaskList, error) {
+				return nil, nil
+			},
+		}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{}, todoist.CommandErrors{{UUID: "queued", Code: 22, Message: "Item not found"}}
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaServiceWithQueuedCommand(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.True(t, failures.IsWarning(err))
+		assert.Equal(t, failures.Rejected, failures.KindOf(err))
+
+	})
+
+	t.Run("When completing several tasks, then they are all completed in a single sync request", func(t *testing.T) {
+
+		var executedCommands []requests.Command
+
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(taskID uint32) (*types.Task, error) {
+				return &types.Task{
+					ID:        taskID,
+					TodoistID: int64(taskID) * 100,
+				}, nil
+			},
+			GetAllFunc: func() (types.TaskList, error) {
+				return nil, nil
+			},
+		}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommands = append(executedCommands, command)
+				return &responses.Command{}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1, 3, 7}, types.CompleteTaskOptions{})
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommands, 1) && assert.Len(t, executedCommands[0].Commands, 3) {
+			for index, expectedTodoistID := range []int64{100, 300, 700} {
+				assert.Equal(t, commands.ItemClose, executedCommands[0].Commands[index].Type)
+				assert.Equal(t, expectedTodoistID, executedCommands[0].Commands[index].Arguments["id"])
+			}
+		}
+
+	})
+
+	t.Run("When completing several tasks and one of them does not exist, then an error is returned and nothing is completed", func(t *testing.T) {
+
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(taskID uint32) (*types.Task, error) {
+				if taskID == 3 {
+					return nil, errors.New("Test error")
+				}
+				return &types.Task{ID: taskID}, nil
+			},
+		}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1, 3, 7}, types.CompleteTaskOptions{})
+		if assert.NotNil(t, err) {
+			assert.Equal(t, fmt.Sprintf(errorNoTaskToComplete, 3), err.Error())
+		}
+
+	})
+
+	tasksWithSubTasks := types.TaskList{
+		{ID: 1, TodoistID: 100},
+		{ID: 2, TodoistID: 200, ParentID: 100},
+		{ID: 3, TodoistID: 300, ParentID: 200},
+		{ID: 4, TodoistID: 400},
+	}
+	taskRepositoryWithSubTasks := &mocks.MockTaskRepository{
+		GetFunc: func(taskID uint32) (*types.Task, error) {
+			for _, task := range tasksWithSubTasks {
+				if task.ID == taskID {
+					return &task, nil
+				}
+			}
+			return nil, errors.New("Test error")
+		},
+		GetAllFunc: func() (types.TaskList, error) {
+			return tasksWithSubTasks, nil
+		},
+	}
+
+	t.Run("When completing a task with uncompleted sub-tasks without completing the sub-tasks, then a validation error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), taskRepositoryWithSubTasks)
+
+		_, err := taskService.CompleteTasks([]uint32{1, 4}, types.CompleteTaskOptions{})
+		if assert.NotNil(t, err) {
+			assert.Equal(t, fmt.Sprintf(errorTaskHasSubTasks, 1, 2), err.Error())
+			assert.Equal(t, failures.Validation, failures.KindOf(err))
+		}
+
+	})
+
+	var subTasksToTest = []struct {
+		description        string
+		taskIDs            []uint32
+		withSubTasks       bool
+		expectedTodoistIDs []int64
+	}{
+		{"completing a task with its sub-tasks", []uint32{1}, true, []int64{300, 200, 100}},
+		{"completing a task along with all of its sub-tasks", []uint32{1, 2, 3}, false, []int64{300, 200, 100}},
+		{"completing a sub-task", []uint32{3}, false, []int64{300}},
+		{"completing a sub-task before its parent with its sub-tasks", []uint32{2, 1}, true, []int64{300, 200, 100}},
+	}
+
+	for _, subTaskToTest := range subTasksToTest {
+		subTaskToTest := subTaskToTest
+
+		t.Run("When "+subTaskToTest.description+", then sub-tasks are completed once and before their parent", func(t *testing.T) {
+
+			var executedCommand requests.Command
+
+			mockAuthenticationService := &mocks.MockAuthenticationService{
+				AuthenticatedStateToReturn: true,
+			}
+			mockAPI := &mocks.MockAPI{
+				ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+					executedCommand = command
+					return &responses.Command{}, nil
+				},
+			}
+
+			taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), taskRepositoryWithSubTasks)
+
+			_, err := taskService.CompleteTasks(subTaskToTest.taskIDs, types.CompleteTaskOptions{WithSubTasks: subTaskToTest.withSubTasks})
+			assert.Nil(t, err)
+
+			var completedTodoistIDs []int64
+			for _, commandDetail := range executedCommand.Commands {
+				assert.Equal(t, commands.ItemClose, commandDetail.Type)
+				completedTodoistIDs = append(completedTodoistIDs, commandDetail.Arguments["id"].(int64))
+			}
+			assert.Equal(t, subTaskToTest.expectedTodoistIDs, completedTodoistIDs)
+
+		})
+	}
+
+	t.Run("When completing a task whose id has been retired, then the stale reference is refused", func(t *testing.T) {
+
+		retiredTaskError := &repositories.RetiredTaskError{ID: 1, Content: "buy milk"}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(uint32) (*types.Task, error) {
+				return nil, retiredTaskError
+			},
+		}
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		_, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.Equal(t, retiredTaskError, err)
+
+	})
+
+	t.Run("When completing a task added while offline after it has been synced, then the task keeps its id and the Todoist id is completed", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		isOnline := false
+		var executedCommand requests.Command
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				if !isOnline {
+					return nil, todoist.ErrUnreachable
+				}
+				executedCommand = command
+				temporaryIDMapping := make(map[string]int64)
+				for _, commandDetail := range command.Commands {
+					if commandDetail.TemporaryID != "" {
+						temporaryIDMapping[commandDetail.TemporaryID] = 500
+					}
+				}
+				return &responses.Command{TempIDMapping: temporaryIDMapping}, nil
+			},
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					SyncToken: "synced-token",
+					Items:     []responses.Item{{TodoistID: 500, Content: "Buy milk"}},
+				}, nil
+			},
+		}
+		replicaRepository := replica.NewReplicaRepository(&mocks.MockFile{})
+		replicaRepository.Update(&replicaTypes.Replica{SyncToken: "sync-token"})
+		replicaService := replica.NewReplicaService(mockAPI, mockAuthenticationService, replicaRepository, queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, replicaService, repositories.NewTaskRepository(&mocks.MockFile{}))
+
+		_, err := taskService.AddTask(types.AddTaskOptions{Content: "Buy milk"})
+		assert.Nil(t, err)
+		offlineTasks, err := taskService.GetCachedTasks()
+		assert.Nil(t, err)
+		if !assert.Len(t, offlineTasks, 1) {
+			return
+		}
+
+		isOnline = true
+		syncedTasks, err := taskService.GetAllTasks()
+		assert.Nil(t, err)
+		if assert.Len(t, syncedTasks, 1) {
+			assert.Equal(t, offlineTasks[0].ID, syncedTasks[0].ID)
+			assert.Equal(t, int64(500), syncedTasks[0].TodoistID)
+		}
+
+		_, err = taskService.CompleteTasks([]uint32{offlineTasks[0].ID}, types.CompleteTaskOptions{})
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, commands.ItemClose, executedCommand.Commands[0].Type)
+			assert.Equal(t, int64(500), executedCommand.Commands[0].Arguments["id"])
+		}
+
+	})
+
+	t.Run("When completing tasks and no ids are provided, then an error is returned", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}, nil, nil)
+
+		_, err := taskService.CompleteTasks(nil, types.CompleteTaskOptions{})
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorNoTasksProvided, err.Error())
+		}
+
+	})
+
+}
+
+func TestCompletingRecurringTasks(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	newRepositoryWithRecurringTask := func() repositories.TaskRepository {
+		repository := repositories.NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(types.TaskList{
+			{TodoistID: 100, Content: "Water plants", DueDate: time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC), IsRecurring: true},
+		}, nil)
+		return repository
+	}
+
+	t.Run("When completing a recurring task, then it is closed and its next occurrence is returned after syncing", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					Items: []responses.Item{
+						{TodoistID: 100, Content: "Water plants", Due: &responses.Due{DateString: "2020-04-17", String: "every week", IsRecurring: true}},
+					},
+				}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), newRepositoryWithRecurringTask())
+
+		rescheduledTasks, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.Nil(t, err)
+		assert.Equal(t, commands.ItemClose, executedCommand.Commands[0].Type)
+		if assert.Len(t, rescheduledTasks, 1) {
+			assert.Equal(t, uint32(1), rescheduledTasks[0].ID)
+			assert.Equal(t, time.Date(2020, 4, 17, 0, 0, 0, 0, time.UTC), rescheduledTasks[0].DueDate)
+		}
+
+	})
+
+	t.Run("When completing a recurring task forever, then it is completed with item_complete and nothing is rescheduled", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), newRepositoryWithRecurringTask())
+
+		rescheduledTasks, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{Forever: true})
+		assert.Nil(t, err)
+		assert.Nil(t, rescheduledTasks)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, commands.ItemComplete, executedCommand.Commands[0].Type)
+			assert.Equal(t, int64(100), executedCommand.Commands[0].Arguments["id"])
+		}
+
+	})
+
+	t.Run("When completing a recurring task while offline, then the command is queued and nothing is reported as rescheduled", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, todoist.ErrUnreachable
+			},
+		}
+		replicaRepository := replica.NewReplicaRepository(&mocks.MockFile{})
+		replicaRepository.Update(&replicaTypes.Replica{SyncToken: "sync-token"})
+		queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+		replicaService := replica.NewReplicaService(mockAPI, authenticated, replicaRepository, queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
+
+		taskService := NewTaskService(mockAPI, authenticated, replicaService, newRepositoryWithRecurringTask())
+
+		rescheduledTasks, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.Nil(t, err)
+		assert.Nil(t, rescheduledTasks)
+
+		queuedCommands, _ := queueRepository.GetAll()
+		assert.Len(t, queuedCommands, 1)
+
+	})
+
+	t.Run("When completing a recurring task and the tasks cannot be synced afterwards, then the task is still completed", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return &responses.Command{}, nil
+			},
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), newRepositoryWithRecurringTask())
+
+		rescheduledTasks, err := taskService.CompleteTasks([]uint32{1}, types.CompleteTaskOptions{})
+		assert.Nil(t, err)
+		assert.Nil(t, rescheduledTasks)
+
+	})
+
+}
+
+func TestUncompletingTasks(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+
+	newRepositoryWithCompletedTask := func() repositories.TaskRepository {
+		repository := repositories.NewTaskRepository(&mocks.MockFile{})
+		repository.CreateAll(types.TaskList{{TodoistID: 100, Content: "Buy milk"}}, nil)
+		repository.CreateAll(types.TaskList{{TodoistID: 200, Content: "Call the bank"}}, nil)
+		return repository
+	}
+
+	t.Run("When uncompleting tasks by the id they had and by their Todoist id, then item_uncomplete commands are sent for each task", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), newRepositoryWithCompletedTask())
+
+		err := taskService.UncompleteTasks([]types.TaskReference{{ID: 1}, {TodoistID: 300}})
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 2) {
+			for index, expectedTodoistID := range []int64{100, 300} {
+				assert.Equal(t, commands.ItemUncomplete, executedCommand.Commands[index].Type)
+				assert.Equal(t, expectedTodoistID, executedCommand.Commands[index].Arguments["id"])
+			}
+		}
+
+	})
+
+	var invalidReferencesToTest = []struct {
+		description   string
+		references    []types.TaskReference
+		expectedError string
+		expectedKind  failures.Kind
+	}{
+		{"no tasks", nil, errorNoTasksProvided, failures.Validation},
+		{"a task by its content", []types.TaskReference{{Content: "milk"}}, fmt.Sprintf(errorUncompleteByContent, "milk"), failures.Validation},
+		{"an id that was never used", []types.TaskReference{{ID: 9}}, fmt.Sprintf(errorNoTaskWithID, 9), failures.NotFound},
+	}
+
+	for _, referencesToTest := range invalidReferencesToTest {
+		referencesToTest := referencesToTest
+
+		t.Run("When uncompleting "+referencesToTest.description+", then an error is returned", func(t *testing.T) {
+
+			taskService := NewTaskService(&mocks.MockAPI{}, authenticated, newReplicaService(&mocks.MockAPI{}, authenticated), newRepositoryWithCompletedTask())
+
+			err := taskService.UncompleteTasks(referencesToTest.references)
+			assert.Equal(t, referencesToTest.expectedError, err.Error())
+			assert.Equal(t, referencesToTest.expectedKind, failures.KindOf(err))
+
+		})
+	}
+
+	t.Run("When uncompleting a task and the api returns an error, then an error is returned", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), newRepositoryWithCompletedTask())
+
+		err := taskService.UncompleteTasks([]types.TaskReference{{ID: 1}})
+		assert.Equal(t, errorFailedToUncompleteTask, err.Error())
+
+	})
+
+}
+
+func TestUpdatingATask(t *testing.T) {
+
+	existingTask := func(uint32) (*types.Task, error) {
+		return &types.Task{
+			ID:        1,
+			TodoistID: 100,
+		}, nil
+	}
+
+	t.Run("When updating a task and no changes are provided, then an error is returned", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}, newReplicaService(&mocks.MockAPI{}, &mocks.MockAuthenticationService{}), nil)
+
+		err := taskService.UpdateTask(1, types.TaskChanges{})
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNoChangesToTask, err.Error())
+
+	})
+
+	t.Run("When updating a task and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: false,
+		}
+		priority := 4
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), &mocks.MockTaskRepository{})
+
+		err := taskService.UpdateTask(1, types.TaskChanges{Priority: &priority})
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+	t.Run("When updating a task and the task does not exist, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: func(uint32) (*types.Task, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		priority := 4
+
+		taskService := NewTaskService(&mocks.MockAPI{}, mockAuthenticationService, newReplicaService(&mocks.MockAPI{}, mockAuthenticationService), mockRepository)
+
+		err := taskService.UpdateTask(1, types.TaskChanges{Priority: &priority})
+		assert.NotNil(t, err)
+		assert.Equal(t, errorNoTaskToUpdate, err.Error())
+
+	})
+
+	t.Run("When updating a task and the api returns an error, then an error is returned", func(t *testing.T) {
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: existingTask,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+		priority := 4
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := taskService.UpdateTask(1, types.TaskChanges{Priority: &priority})
+		assert.NotNil(t, err)
+		assert.Equal(t, errorFailedToUpdateTask, err.Error())
+
+	})
+
+	t.Run("When updating a task, then only the changed properties are sent to Todoist", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: existingTask,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+		due := "next monday"
+		description := "agenda attached"
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := taskService.UpdateTask(1, types.TaskChanges{Due: &due, Description: &description})
+		assert.Nil(t, err)
+
+		arguments := executedCommand.Commands[0].Arguments
+		assert.Equal(t, commands.ItemUpdate, executedCommand.Commands[0].Type)
+		assert.Equal(t, int64(100), arguments["id"])
+		assert.Equal(t, &requests.Due{Value: "next monday"}, arguments["due"])
+		assert.Equal(t, "agenda attached", arguments["description"])
+		assert.NotContains(t, arguments, "content")
+		assert.NotContains(t, arguments, "priority")
+		assert.NotContains(t, arguments, "deadline")
+
+	})
+
+	t.Run("When updating the deadline of a task, then the deadline is sent to Todoist and an empty deadline removes it", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: existingTask,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		deadline := "2020-05-15"
+		err := taskService.UpdateTask(1, types.TaskChanges{Deadline: &deadline})
+		assert.Nil(t, err)
+		assert.Equal(t, &requests.Deadline{Date: "2020-05-15"}, executedCommand.Commands[0].Arguments["deadline"])
+
+		noDeadline := ""
+		err = taskService.UpdateTask(1, types.TaskChanges{Deadline: &noDeadline})
+		assert.Nil(t, err)
+		assert.Contains(t, executedCommand.Commands[0].Arguments, "deadline")
+		assert.Nil(t, executedCommand.Commands[0].Arguments["deadline"])
+
+		invalidDeadline := "someday"
+		err = taskService.UpdateTask(1, types.TaskChanges{Deadline: &invalidDeadline})
+		assert.Equal(t, failures.Validation, failures.KindOf(err))
+
+	})
+
+	t.Run("When updating the labels of a task, then the labels are replaced and labels that do not exist are created", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: existingTask,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{Labels: []responses.Label{{TodoistID: 1000, Name: "errand"}}}, nil
+			},
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+		labels := []string{"errand", "home"}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := taskService.UpdateTask(1, types.TaskChanges{Labels: &labels})
+		assert.Nil(t, err)
+
+		if assert.Len(t, executedCommand.Commands, 2) {
+			assert.Equal(t, commands.LabelAdd, executedCommand.Commands[0].Type)
+			assert.Equal(t, commands.ItemUpdate, executedCommand.Commands[1].Type)
+			assert.Equal(t, []interface{}{int64(1000), executedCommand.Commands[0].TemporaryID}, executedCommand.Commands[1].Arguments["labels"])
+		}
+
+	})
+
+	t.Run("When removing all labels of a task, then an empty list of labels is sent to Todoist", func(t *testing.T) {
+
+		var executedCommand requests.Command
+
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetFunc: existingTask,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{}, nil
+			},
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+		labels := []string{""}
+
+		taskService := NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+
+		err := taskService.UpdateTask(1, types.TaskChanges{Labels: &labels})
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{}, executedCommand.Commands[0].Arguments["labels"])
+
+	})
+
+}
+
+func TestDeletingTasks(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	mockRepository := &mocks.MockTaskRepository{
+		GetFunc: func(taskID uint32) (*types.Task, error) {
+			if taskID == 3 {
+				return nil, errors.New("test error")
+			}
+			return &types.Task{ID: taskID, TodoistID: int64(taskID) * 100}, nil
+		},
+	}
+
+	t.Run("When deleting tasks, then an item_delete command is sent once for each task", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), mockRepository)
+
+		err := taskService.DeleteTasks([]uint32{1, 2, 1})
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 2) {
+			for index, expectedTodoistID := range []int64{100, 200} {
+				assert.Equal(t, commands.ItemDelete, executedCommand.Commands[index].Type)
+				assert.Equal(t, expectedTodoistID, executedCommand.Commands[index].Arguments["id"])
+			}
+		}
+
+	})
+
+	t.Run("When deleting a task that does not exist, then a not found error is returned", func(t *testing.T) {
+
+		taskService := NewTaskService(&mocks.MockAPI{}, authenticated, newReplicaService(&mocks.MockAPI{}, authenticated), mockRepository)
+
+		err := taskService.DeleteTasks([]uint32{1, 3})
+		assert.Equal(t, fmt.Sprintf(errorNoTaskToDelete, 3), err.Error())
+		assert.Equal(t, failures.NotFound, failures.KindOf(err))
+
+	})
+
+	t.Run("When deleting tasks and the api returns an error, then an error is returned", func(t *testing.T) {
+
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				return nil, errors.New("test error")
+			},
+		}
+
+		taskService := NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), mockRepository)
+
+		err := taskService.DeleteTasks([]uint32{1})
+		assert.Equal(t, errorFailedToDeleteTask, err.Error())
+
+	})
+
+	t.Run("When deleting tasks and the client is not authenticated, then an error is returned", func(t *testing.T) {
+
+		notAuthenticated := &mocks.MockAuthenticationService{}
+		taskService := NewTaskService(&mocks.MockAPI{}, notAuthenticated, newReplicaService(&mocks.MockAPI{}, notAuthenticated), mockRepository)
+
+		err := taskService.DeleteTasks([]uint32{1})
+		assert.Equal(t, errorNotCurrentlyAuthenticated, err.Error())
+
+	})
+
+}
+
+func TestMovingATask(t *testing.T) {
+
+	newTaskService := func(executedCommand *requests.Command) TaskService {
+		mockAuthenticationService := &mocks.MockAuthenticationService{
+			AuthenticatedStateToReturn: true,
+		}
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncQueryFunction: func(query requests.Query) (*responses.Query, error) {
+				return &responses.Query{
+					Projects: []responses.Project{{TodoistID: 100, Name: "Work"}, {TodoistID: 200, Name: "Personal"}},
+					Sections: []responses.Section{{TodoistID: 1000, Name: "Meetings", ProjectID: 100}, {TodoistID: 2000, Name: "Meetings", ProjectID: 200}},
+				}, nil
+			},
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				*executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+		listedTasks := types.TaskList{
+			{ID: 1, TodoistID: 10},
+			{ID: 2, TodoistID: 20},
+			{ID: 3, TodoistID: 30, ParentID: 10},
+			{ID: 4, TodoistID: 40, ParentID: 30},
+		}
+		mockRepository := &mocks.MockTaskRepository{
+			GetAllFunc: func() (types.TaskList, error) {
+				return listedTasks, nil
+			},
+			GetFunc: func(taskID uint32) (*types.Task, error) {
+				if taskID > uint32(len(listedTasks)) {
+					return nil, errors.New("test error")
+				}
+				task := listedTasks[taskID-1]
+				return &task, nil
+			},
+		}
+
+		return NewTaskService(mockAPI, mockAuthenticationService, newReplicaService(mockAPI, mockAuthenticationService), mockRepository)
+	}
+
+	var destinationsToTest = []struct {
+		description       string
+		options           types.MoveTaskOptions
+		expectedArguments map[string]interface{}
+	}{
+		{"a project", types.MoveTaskOptions{Project: "work"}, map[string]interface{}{"id": int64(10), "project_id": int64(100)}},
+		{"a section of a project", types.MoveTaskOptions{Project: "Personal", Section: "meetings"}, map[string]interface{}{"id": int64(10), "section_id": int64(2000)}},
+		{"a parent task", types.MoveTaskOptions{ParentID: 2}, map[string]interface{}{"id": int64(10), "parent_id": int64(20)}},
+	}
+
+	for _, destinationToTest := range destinationsToTest {
+		destinationToTest := destinationToTest
+
+		t.Run("When moving a task to "+destinationToTest.description+", then an item_move command with only that destination is sent", func(t *testing.T) {
+
+			var executedCommand requests.Command
+			taskService := newTaskService(&executedCommand)
+
+			err := taskService.MoveTask(1, destinationToTest.options)
+			assert.Nil(t, err)
+			if assert.Len(t, executedCommand.Commands, 1) {
+				assert.Equal(t, commands.ItemMove, executedCommand.Commands[0].Type)
+				assert.Equal(t, destinationToTest.expectedArguments, executedCommand.Commands[0].Arguments)
+			}
+
+		})
+	}
+
+	var invalidMovesToTest = []struct {
+		description   string
+		taskID        uint32
+		options       types.MoveTaskOptions
+		expectedError string
+		expectedKind  failures.Kind
+	}{
+		{"no destination", 1, types.MoveTaskOptions{}, errorNoMoveDestination, failures.Validation},
+		{"both a parent task and a project", 1, types.MoveTaskOptions{Project: "Work", ParentID: 2}, errorAmbiguousMoveDestination, failures.Validation},
+		{"the task as its own parent", 1, types.MoveTaskOptions{ParentID: 1}, fmt.Sprintf(errorMoveBelowItself, 1), failures.Validation},
+		{"a sub-task of the task as its parent", 1, types.MoveTaskOptions{ParentID: 4}, fmt.Sprintf(errorMoveBelowSubTask, 1, 4), failures.Validation},
+		{"a task that does not exist", 5, types.MoveTaskOptions{Project: "Work"}, fmt.Sprintf(errorNoTaskToMove, 5), failures.NotFound},
+		{"a parent task that does not exist", 1, types.MoveTaskOptions{ParentID: 5}, errorParentTaskNotFound, failures.NotFound},
+		{"a project that does not exist", 1, types.MoveTaskOptions{Project: "Groceries"}, fmt.Sprintf(errorProjectNotFound, "Groceries"), failures.NotFound},
+		{"a section that does not exist", 1, types.MoveTaskOptions{Section: "Errands"}, fmt.Sprintf(errorSectionNotFound, "Errands"), failures.NotFound},
+	}
+
+	for _, moveToTest := range invalidMovesToTest {
+		moveToTest := moveToTest
+
+		t.Run("When moving a task given "+moveToTest.description+", then an error is returned", func(t *testing.T) {
+
+			var executedCommand requests.Command
+			taskService := newTaskService(&executedCommand)
+
+			err := taskService.MoveTask(moveToTest.taskID, moveToTest.options)
+			assert.Equal(t, moveToTest.expectedError, err.Error())
+			assert.Equal(t, moveToTest.expectedKind, failures.KindOf(err))
+			assert.Empty(t, executedCommand.Commands)
+
+		})
+	}
+
+}
+
+func TestReorderingTasks(t *testing.T) {
+
+	authenticated := &mocks.MockAuthenticationService{
+		AuthenticatedStateToReturn: true,
+	}
+	listedTasks := map[uint32]*types.Task{
+		1: {ID: 1, TodoistID: 100, ProjectID: 10, ChildOrder: 2, DayOrder: 5},
+		2: {ID: 2, TodoistID: 200, ProjectID: 10, ChildOrder: 4, DayOrder: 1},
+		3: {ID: 3, TodoistID: 300, ProjectID: 10, ChildOrder: 7, DayOrder: 3},
+		4: {ID: 4, TodoistID: 400, ProjectID: 20, ChildOrder: 1, DayOrder: 2},
+		5: {ID: 5, TodoistID: 500, ProjectID: 10, SectionID: 1000, ChildOrder: 3, DayOrder: 4},
+	}
+	mockRepository := &mocks.MockTaskRepository{
+		GetFunc: func(taskID uint32) (*types.Task, error) {
+			if task, ok := listedTasks[taskID]; ok {
+				return task, nil
+			}
+			return nil, errors.New("test error")
+		},
+	}
+
+	newTaskService := func(executedCommand *requests.Command) TaskService {
+		mockAPI := &mocks.MockAPI{
+			ExecuteSyncCommandFunction: func(command requests.Command) (*responses.Command, error) {
+				*executedCommand = command
+				return &responses.Command{}, nil
+			},
+		}
+		return NewTaskService(mockAPI, authenticated, newReplicaService(mockAPI, authenticated), mockRepository)
+	}
+
+	t.Run("When reordering sibling tasks, then the tasks take the positions held between them in the provided order", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		err := taskService.ReorderTasks([]uint32{3, 1, 2}, types.ReorderTaskOptions{})
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, commands.ItemReorder, executedCommand.Commands[0].Type)
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"id": int64(300), "child_order": int32(2)},
+				map[string]interface{}{"id": int64(100), "child_order": int32(4)},
+				map[string]interface{}{"id": int64(200), "child_order": int32(7)},
+			}, executedCommand.Commands[0].Arguments["items"])
+		}
+
+	})
+
+	t.Run("When reordering tasks by day, then the day orders of the tasks are updated even when they are not siblings", func(t *testing.T) {
+
+		var executedCommand requests.Command
+		taskService := newTaskService(&executedCommand)
+
+		err := taskService.ReorderTasks([]uint32{1, 4}, types.ReorderTaskOptions{DayOrder: true})
+		assert.Nil(t, err)
+		if assert.Len(t, executedCommand.Commands, 1) {
+			assert.Equal(t, commands.ItemUpdateDayOrders, executedCommand.Commands[0].Type)
+			assert.Equal(t, map[string]interface{}{"100": int32(2), "400": int32(5)}, executedCommand.Commands[0].Arguments["ids_to_orders"])
+		}
+
+	})
+
+	var invalidOrdersToTest = []struct {
+		description   string
+		taskIDs       []uint32
+		expectedError string
+		expectedKind  failures.Kind
+	}{
+		{"no tasks", nil, errorNoTasksProvided, failures.Validation},
+		{"a task listed twice", []uint32{1, 2, 1}, fmt.Sprintf(errorTaskListedTwice, 1), failures.Validation},
+		{"tasks of different projects", []uint32{1, 4}, fmt.Sprintf(errorTasksNotSiblings, 1, 4), failures.Validation},
+		{"tasks of different sections", []uint32{1, 5}, fmt.Sprintf(errorTasksNotSiblings, 1, 5), failures.Validation},
+		{"a task that does not exist", []uint32{1, 9}, fmt.Sprintf(errorNoTaskToReorder, 9), failures.NotFound},
+	}
+
+	for _, orderToTest := range invalidOrdersToTest {
+		orderToTest := orderToTest
+
+		t.Run("When reordering "+orderToTest.description+", then an error is returned", func(t *testing.T) {
+
+			var executedCommand requests.Command
+			taskService := newTaskService(&executedCommand)
+
+			err := taskService.ReorderTasks(orderToTest.taskIDs, types.ReorderTaskOptions{})
+			assert.Equal(t, orderToTest.expectedError, err.Error())
+			assert.Equal(t, orderToTest.expectedKind, failures.KindOf(err))
+
+		})
+	}
+
+}
+
+func TestPositions(t *testing.T) {
+	assert.Equal(t, []int32{1, 3, 5}, positions([]int32{5, 1, 3}))
+	assert.Equal(t, []int32{0, 1, 2, 4}, positions([]int32{0, 4, 0, 0}))
+}
+
+func newReplicaService(api todoist.API, authenticationService authentication.Service) replica.Service {
+	return replica.NewReplicaService(api, authenticationService, replica.NewReplicaRepository(&mocks.MockFile{}), queue.NewQueueRepository(&mocks.MockFile{}), journal.NewJournalRepository(&mocks.MockFile{}))
+}
+
+// newReplicaServiceWithQueuedCommand creates a replica service with an item_close command queued while offline, its uuid is "queued"
+func newReplicaServiceWithQueuedCommand(api todoist.API, authenticationService authentication.Service) replica.Service {
+	queueRepository := queue.NewQueueRepository(&mocks.MockFile{})
+	queueRepository.Add(requests.CommandDetail{Type: commands.ItemClose, UUID: "queued", Arguments: map[string]interface{}{"id": int64(999)}})
+	return replica.NewReplicaService(api, authenticationService, replica.NewReplicaRepository(&mocks.MockFile{}), queueRepository, journal.NewJournalRepository(&mocks.MockFile{}))
 }
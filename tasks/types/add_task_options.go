@@ -0,0 +1,23 @@
+package types
+
+// AddTaskOptions are the properties of a task that is to be created on Todoist
+type AddTaskOptions struct {
+	Content  string
+	Due      string
+	Priority int
+
+	// Project is the name or Todoist id of the project to create the task in, the Inbox is used when empty
+	Project string
+
+	// Section is the name or Todoist id of the section to create the task in
+	Section string
+
+	// Labels are the names of the labels to attach to the task, labels that do not exist are created
+	Labels []string
+
+	// Deadline is the date the task has to be done by as YYYY-MM-DD, the task has no deadline when empty
+	Deadline string
+
+	// ParentID is the cli's identifier of the task to create the task underneath
+	ParentID uint32
+}
@@ -0,0 +1,10 @@
+package types
+
+// CompleteTaskOptions describe how tasks are to be completed on Todoist
+type CompleteTaskOptions struct {
+	// WithSubTasks completes the uncompleted sub-tasks of the tasks as well, Todoist completes them along with their parent
+	WithSubTasks bool
+
+	// Forever completes recurring tasks instead of rescheduling them to their next occurrence
+	Forever bool
+}
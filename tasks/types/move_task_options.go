@@ -0,0 +1,18 @@
+package types
+
+// MoveTaskOptions describe where a task is to be moved to on Todoist, the sub-tasks of the task are moved along with it
+type MoveTaskOptions struct {
+	// Project is the name or Todoist id of the project the task is moved to
+	Project string
+
+	// Section is the name or Todoist id of the section the task is moved to, within Project when a project is provided
+	Section string
+
+	// ParentID is the id of the task the task is moved below, the task is moved to the project and section of its new parent
+	ParentID uint32
+}
+
+// HasDestination returns true if a project, section or parent task to move the task to is provided
+func (o *MoveTaskOptions) HasDestination() bool {
+	return o.Project != "" || o.Section != "" || o.ParentID != 0
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	labelTypes "github.com/kpdowns/todoist-cli/labels/types"
+)
+
+const (
+	weekdayNames = `monday|tuesday|wednesday|thursday|friday|saturday|sunday`
+	monthNames   = `january|february|march|april|may|june|july|august|september|october|november|december|jan|feb|mar|apr|jun|jul|aug|sept|sep|oct|nov|dec`
+	datePhrase   = `today|tonight|tomorrow` +
+		`|next\s+(?:week|month|year|` + weekdayNames + `)` +
+		`|in\s+\d+\s+(?:days?|weeks?|months?|years?)` +
+		`|every\s+(?:other\s+)?(?:day|weekday|week|month|year|` + weekdayNames + `|\d+\s+(?:days|weeks|months|years))` +
+		`|` + weekdayNames +
+		`|\d{4}-\d{2}-\d{2}` +
+		`|(?:` + monthNames + `)\s+\d{1,2}(?:st|nd|rd|th)?` +
+		`|\d{1,2}(?:st|nd|rd|th)?\s+(?:` + monthNames + `)`
+	timePhrase = `(?:at\s+)?(?:\d{1,2}(?::\d{2})?\s?(?:am|pm)|\d{1,2}:\d{2}|noon|midnight)`
+)
+
+var (
+	// quickAddProjectPattern, quickAddPriorityPattern and quickAddDuePattern match whole words only, so that "C#" or "top10" are left in the content
+	quickAddProjectPattern  = regexp.MustCompile(`(?:^|\s)(#(\S+))(?:\s|$)`)
+	quickAddPriorityPattern = regexp.MustCompile(`(?i)(?:^|\s)(p([1-4]))(?:\s|$)`)
+	quickAddDuePattern      = regexp.MustCompile(`(?i)(?:^|\s)((?:on\s+)?(?:` + datePhrase + `)(?:\s+` + timePhrase + `)?|` + timePhrase + `(?:\s+(?:` + datePhrase + `))?)(?:\s|$)`)
+	leadingOnPattern        = regexp.MustCompile(`(?i)^on\s+`)
+)
+
+// ParseQuickAdd reads the properties of a task out of a line of text the way Todoist's quick add does, e.g. "Buy milk tomorrow 5pm #Groceries @errand p2".
+// The first #project, priority (p1 being the most urgent) and date phrase are taken out of the content along with every @label. Date phrases are passed on
+// to Todoist as they were written for Todoist to interpret, only the common phrases are recognized.
+func ParseQuickAdd(text string) AddTaskOptions {
+	var options AddTaskOptions
+
+	text, project := extractFirst(quickAddProjectPattern, text)
+	if project != nil {
+		options.Project = project[1]
+	}
+
+	text, priority := extractFirst(quickAddPriorityPattern, text)
+	if priority != nil {
+		level, _ := strconv.Atoi(priority[1])
+		options.Priority = 5 - level
+	}
+
+	text, options.Labels = labelTypes.ExtractLabelNames(text)
+
+	text, due := extractFirst(quickAddDuePattern, text)
+	if due != nil {
+		options.Due = strings.Join(strings.Fields(leadingOnPattern.ReplaceAllString(due[0], "")), " ")
+	}
+
+	options.Content = strings.Join(strings.Fields(text), " ")
+	return options
+}
+
+// extractFirst removes the first match of the pattern from the text, returning the remaining text and the submatches of the match starting with the removed token
+func extractFirst(pattern *regexp.Regexp, text string) (string, []string) {
+	location := pattern.FindStringSubmatchIndex(text)
+	if location == nil {
+		return text, nil
+	}
+
+	var submatches []string
+	for i := 2; i < len(location); i += 2 {
+		submatches = append(submatches, text[location[i]:location[i+1]])
+	}
+
+	return text[:location[2]] + " " + text[location[3]:], submatches
+}
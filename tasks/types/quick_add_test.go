@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingQuickAddText(t *testing.T) {
+
+	var textsToTest = []struct {
+		text            string
+		expectedOptions AddTaskOptions
+	}{
+		{"Buy milk", AddTaskOptions{Content: "Buy milk"}},
+		{
+			"Buy milk tomorrow 5pm #Groceries @errand p2",
+			AddTaskOptions{Content: "Buy milk", Due: "tomorrow 5pm", Project: "Groceries", Labels: []string{"errand"}, Priority: 3},
+		},
+		{"p1 Call the bank", AddTaskOptions{Content: "Call the bank", Priority: 4}},
+		{"Submit report tomorrow #Work at 9:30", AddTaskOptions{Content: "Submit report", Due: "tomorrow at 9:30", Project: "Work"}},
+		{"Pay rent every month", AddTaskOptions{Content: "Pay rent", Due: "every month"}},
+		{"Dentist on Monday", AddTaskOptions{Content: "Dentist", Due: "Monday"}},
+		{"Book flights next week", AddTaskOptions{Content: "Book flights", Due: "next week"}},
+		{"Renew passport May 5th", AddTaskOptions{Content: "Renew passport", Due: "May 5th"}},
+		{"Renew passport 2020-05-17", AddTaskOptions{Content: "Renew passport", Due: "2020-05-17"}},
+		{"Water plants in 3 days", AddTaskOptions{Content: "Water plants", Due: "in 3 days"}},
+		{"Stand-up at 10am", AddTaskOptions{Content: "Stand-up", Due: "at 10am"}},
+		{"Learn C# @focus @reading", AddTaskOptions{Content: "Learn C#", Labels: []string{"focus", "reading"}}},
+		{"Read top10 list p5", AddTaskOptions{Content: "Read top10 list p5"}},
+		{"You may need this", AddTaskOptions{Content: "You may need this"}},
+		{"Email tom@example.com today", AddTaskOptions{Content: "Email tom@example.com", Due: "today"}},
+	}
+
+	for _, textToTest := range textsToTest {
+		textToTest := textToTest
+
+		t.Run("Given '"+textToTest.text+"', when parsing it, then the project, priority, labels and due date are taken out of the content", func(t *testing.T) {
+			assert.Equal(t, textToTest.expectedOptions, ParseQuickAdd(textToTest.text))
+		})
+	}
+
+}
@@ -0,0 +1,7 @@
+package types
+
+// ReorderTaskOptions describe which order of tasks is to be changed on Todoist
+type ReorderTaskOptions struct {
+	// DayOrder changes the order of the tasks in the Today and Next 7 days views instead of their order among their siblings
+	DayOrder bool
+}
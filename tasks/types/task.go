@@ -2,39 +2,214 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/fatih/color"
+	"github.com/kpdowns/todoist-cli/output"
 )
 
+var priorityNames = map[int16]string{
+	4: "Very Urgent",
+	3: "Urgent",
+	2: "Normal",
+	1: "Low",
+}
+
+var priorityColors = map[int16]color.Attribute{
+	4: color.FgRed,
+	3: color.FgYellow,
+	2: color.FgBlue,
+	1: color.FgWhite,
+}
+
 // Task is an item to do
 type Task struct {
-	ID        uint32
-	TodoistID int64
-	DayOrder  int32
-	Checked   int16
-	Content   string
-	DueDate   time.Time
-	Priority  int16
+	ID              uint32
+	TodoistID       int64
+	TemporaryID     string
+	ProjectID       int64
+	ProjectName     string
+	SectionID       int64
+	ParentID        int64
+	ChildOrder      int32
+	DayOrder        int32
+	Checked         int16
+	Content         string
+	Description     string
+	DueDate         time.Time
+	DueHasTime      bool
+	DueTimezone     string
+	DueString       string
+	DueLang         string
+	IsRecurring     bool
+	Deadline        time.Time
+	Priority        int16
+	Labels          []string
+	ResponsibleID   int64
+	ResponsibleName string
+	AssignedToMe    bool
 }
 
 // AsString returns a tab delimited string representing the task
 func (i *Task) AsString() string {
+	return i.AsIndentedString(0, 0)
+}
+
+// AsIndentedString returns a tab delimited string representing the task with its content indented by the depth of the task
+// in a tree of sub-tasks, the number of sub-tasks that are collapsed below the task is shown after the content
+func (i *Task) AsIndentedString(depth int, collapsedSubTasks int) string {
 	priorityString := ""
-	switch priority := i.Priority; priority {
-	case 4:
-		priorityString = color.RedString("Very Urgent")
-	case 3:
-		priorityString = color.YellowString("Urgent")
-	case 2:
-		priorityString = color.BlueString("Normal")
-	case 1:
-		priorityString = color.WhiteString("Low")
-	}
-
-	return fmt.Sprintf("[%d]\t%s\t%s",
+	if priorityColor, ok := priorityColors[i.Priority]; ok {
+		priorityString = color.New(priorityColor).Sprint(PriorityName(i.Priority))
+	}
+
+	projectString := ""
+	if i.ProjectName != "" {
+		projectString = color.CyanString("#%s", i.ProjectName)
+	}
+
+	contentString := strings.Repeat("  ", depth) + i.Content
+	for _, label := range i.Labels {
+		contentString += " " + color.MagentaString("@%s", label)
+	}
+	if i.HasDueDate() {
+		contentString += " " + color.GreenString(i.DisplayedDueDate())
+	}
+	if i.IsRecurring {
+		contentString += " " + color.HiBlackString("(%s)", i.recurrenceString())
+	}
+	if i.HasDeadline() {
+		contentString += " " + color.RedString("deadline %s", i.Deadline.Format("2006-01-02"))
+	}
+	if collapsedSubTasks == 1 {
+		contentString += " " + color.HiBlackString("(+1 sub-task)")
+	} else if collapsedSubTasks > 1 {
+		contentString += " " + color.HiBlackString("(+%d sub-tasks)", collapsedSubTasks)
+	}
+
+	return fmt.Sprintf("[%d]\t%s\t%s\t%s",
 		i.ID,
 		priorityString,
-		i.Content,
+		projectString,
+		contentString,
 	)
 }
+
+// PriorityName returns the name of a priority as it is displayed, Todoist uses 4 for the most urgent tasks
+func PriorityName(priority int16) string {
+	return priorityNames[priority]
+}
+
+// TemplateFuncs returns the functions available to templates that format tasks, in addition to the general helpers
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"priority": PriorityName,
+	}
+}
+
+// AsRecord returns the fields of the task written in machine readable output
+func (i *Task) AsRecord() output.Record {
+	return output.Record{
+		{Name: "id", Value: i.ID},
+		{Name: "todoist_id", Value: i.TodoistID},
+		{Name: "project_id", Value: i.ProjectID},
+		{Name: "project", Value: i.ProjectName},
+		{Name: "content", Value: i.Content},
+		{Name: "description", Value: i.Description},
+		{Name: "due", Value: i.dueDateString()},
+		{Name: "priority", Value: i.Priority},
+		{Name: "completed", Value: i.Checked == 1},
+		{Name: "labels", Value: i.labelNames()},
+		{Name: "parent_id", Value: i.ParentID},
+		{Name: "due_string", Value: i.DueString},
+		{Name: "due_timezone", Value: i.DueTimezone},
+		{Name: "due_lang", Value: i.DueLang},
+		{Name: "recurring", Value: i.IsRecurring},
+		{Name: "deadline", Value: i.deadlineString()},
+	}
+}
+
+// IsSubTask returns true if the task has a parent task
+func (i *Task) IsSubTask() bool {
+	return i.ParentID != 0
+}
+
+// IsAssigned returns true if the task is assigned to anyone, including the user
+func (i *Task) IsAssigned() bool {
+	return i.ResponsibleID != 0
+}
+
+// labelNames returns the labels of the task, never nil so that tasks without labels have an empty list in machine readable output
+func (i *Task) labelNames() []string {
+	if i.Labels == nil {
+		return []string{}
+	}
+	return i.Labels
+}
+
+// HasDueDate returns true if the task is due on a date
+func (i *Task) HasDueDate() bool {
+	return !i.DueDate.IsZero()
+}
+
+// HasDeadline returns true if the task has to be done by a date
+func (i *Task) HasDeadline() bool {
+	return !i.Deadline.IsZero()
+}
+
+// deadlineString returns the deadline in machine readable output
+func (i *Task) deadlineString() string {
+	if !i.HasDeadline() {
+		return ""
+	}
+	return i.Deadline.Format("2006-01-02")
+}
+
+// dueDateString returns the due date in machine readable output, dates with a time are written in ISO 8601 without a timezone as the time
+// is the wall clock time in the timezone of the user
+func (i *Task) dueDateString() string {
+	if !i.HasDueDate() {
+		return ""
+	}
+	if i.DueHasTime {
+		return i.DueDate.Format("2006-01-02T15:04:05")
+	}
+	return i.DueDate.Format("2006-01-02")
+}
+
+// DisplayedDueDate returns the due date as it is shown to people, with the time when the task is due at a time
+func (i *Task) DisplayedDueDate() string {
+	if i.DueHasTime {
+		return i.DueDate.Format("2006-01-02 15:04")
+	}
+	return i.DueDate.Format("2006-01-02")
+}
+
+// recurrenceString describes how often a recurring task is due, using the plain-text date it was created with when it is known
+func (i *Task) recurrenceString() string {
+	if i.DueString == "" {
+		return "recurring"
+	}
+	return i.DueString
+}
+
+// BelongsToProject returns true if the task is in the project with the provided name or Todoist id. Names are not case sensitive.
+func (i *Task) BelongsToProject(nameOrID string) bool {
+	if strings.EqualFold(i.ProjectName, nameOrID) {
+		return true
+	}
+
+	projectID, err := strconv.ParseInt(nameOrID, 10, 64)
+	return err == nil && projectID == i.ProjectID
+}
+
+// TodoistReference returns the identifier used to reference the task in commands sent to Todoist. Tasks created while offline are referenced by their temporary id until they have been synced.
+func (i *Task) TodoistReference() interface{} {
+	if i.TodoistID == 0 && i.TemporaryID != "" {
+		return i.TemporaryID
+	}
+	return i.TodoistID
+}
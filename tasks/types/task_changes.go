@@ -0,0 +1,20 @@
+package types
+
+// TaskChanges are the properties of an existing task that are to be updated, properties that are nil are left unchanged
+type TaskChanges struct {
+	Content     *string
+	Due         *string
+	Priority    *int
+	Description *string
+
+	// Deadline is the date the task has to be done by as YYYY-MM-DD, an empty date removes the deadline
+	Deadline *string
+
+	// Labels replace the labels of the task, an empty list removes all labels
+	Labels *[]string
+}
+
+// HasChanges returns true if at least one property of the task is to be updated
+func (c *TaskChanges) HasChanges() bool {
+	return c.Content != nil || c.Due != nil || c.Priority != nil || c.Description != nil || c.Deadline != nil || c.Labels != nil
+}
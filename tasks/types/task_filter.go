@@ -0,0 +1,160 @@
+package types
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+const (
+	errorInvalidDueFilter      = "'%s' is not a valid due filter, use today, tomorrow, overdue, this-week, before:YYYY-MM-DD or after:YYYY-MM-DD"
+	errorInvalidPriorityFilter = "'%s' is not a valid priority filter, use a priority from 1 to 4 optionally preceded by =, >, >=, < or <=, e.g. >=3"
+	errorInvalidSearchPattern  = "'%s' is not a valid regular expression"
+	errorInvalidLimit          = "The limit must be a positive number of tasks"
+
+	dateFormat = "2006-01-02"
+)
+
+// TaskPredicate returns true if the task should be kept when filtering
+type TaskPredicate func(task *Task) bool
+
+// Filter returns the tasks that satisfy every predicate, in their current order
+func (t TaskList) Filter(predicates ...TaskPredicate) TaskList {
+	var filteredTasks TaskList
+	for index := range t {
+		if satisfiesAll(&t[index], predicates) {
+			filteredTasks = append(filteredTasks, t[index])
+		}
+	}
+	return filteredTasks
+}
+
+// Limit returns at most the provided number of tasks, taken from the start of the list
+func (t TaskList) Limit(count int) (TaskList, error) {
+	if count < 0 {
+		return nil, failures.New(failures.Validation, errorInvalidLimit)
+	}
+	if count == 0 || count >= len(t) {
+		return t, nil
+	}
+	return t[:count], nil
+}
+
+func satisfiesAll(task *Task, predicates []TaskPredicate) bool {
+	for _, predicate := range predicates {
+		if !predicate(task) {
+			return false
+		}
+	}
+	return true
+}
+
+// InProject keeps tasks in the project with the provided name or Todoist id
+func InProject(nameOrID string) TaskPredicate {
+	return func(task *Task) bool {
+		return task.BelongsToProject(nameOrID)
+	}
+}
+
+// HasLabel keeps tasks with the label, label names are not case sensitive
+func HasLabel(name string) TaskPredicate {
+	name = strings.TrimPrefix(name, "@")
+	return func(task *Task) bool {
+		for _, label := range task.Labels {
+			if strings.EqualFold(label, name) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// DueBetween keeps tasks due on or after the start and before the end, tasks without a due date are never kept
+func DueBetween(start time.Time, end time.Time) TaskPredicate {
+	return func(task *Task) bool {
+		return task.HasDueDate() && !task.DueDate.Before(start) && task.DueDate.Before(end)
+	}
+}
+
+// ParseDueFilter creates a predicate from a due filter relative to the provided day. The filters are today, tomorrow,
+// overdue, this-week (Monday to Sunday), before:YYYY-MM-DD and after:YYYY-MM-DD.
+func ParseDueFilter(filter string, now time.Time) (TaskPredicate, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	switch strings.ToLower(filter) {
+	case "today":
+		return DueBetween(today, tomorrow), nil
+	case "tomorrow":
+		return DueBetween(tomorrow, tomorrow.AddDate(0, 0, 1)), nil
+	case "overdue":
+		return DueBetween(time.Time{}, today), nil
+	case "this-week":
+		daysSinceMonday := (int(today.Weekday()) + 6) % 7
+		monday := today.AddDate(0, 0, -daysSinceMonday)
+		return DueBetween(monday, monday.AddDate(0, 0, 7)), nil
+	}
+
+	if date := strings.TrimPrefix(filter, "before:"); date != filter {
+		parsedDate, err := time.Parse(dateFormat, date)
+		if err == nil {
+			return DueBetween(time.Time{}, parsedDate), nil
+		}
+	}
+
+	if date := strings.TrimPrefix(filter, "after:"); date != filter {
+		parsedDate, err := time.Parse(dateFormat, date)
+		if err == nil {
+			return DueBetween(parsedDate.AddDate(0, 0, 1), time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)), nil
+		}
+	}
+
+	return nil, failures.Newf(failures.Validation, errorInvalidDueFilter, filter)
+}
+
+// ParsePriorityFilter creates a predicate from a priority comparison such as 4, =4, >=3 or <2. Todoist uses 4 for the most urgent tasks.
+func ParsePriorityFilter(filter string) (TaskPredicate, error) {
+	operator := strings.TrimRight(filter, "0123456789 ")
+	priority, err := strconv.Atoi(strings.TrimSpace(filter[len(operator):]))
+	if err != nil || priority < 1 || priority > 4 {
+		return nil, failures.Newf(failures.Validation, errorInvalidPriorityFilter, filter)
+	}
+
+	value := int16(priority)
+	switch strings.TrimSpace(operator) {
+	case "", "=":
+		return func(task *Task) bool { return task.Priority == value }, nil
+	case ">":
+		return func(task *Task) bool { return task.Priority > value }, nil
+	case ">=":
+		return func(task *Task) bool { return task.Priority >= value }, nil
+	case "<":
+		return func(task *Task) bool { return task.Priority < value }, nil
+	case "<=":
+		return func(task *Task) bool { return task.Priority <= value }, nil
+	}
+
+	return nil, failures.Newf(failures.Validation, errorInvalidPriorityFilter, filter)
+}
+
+// ParseSearchFilter creates a predicate matching the content or description of tasks. Text is matched without regard to
+// case, text enclosed in slashes such as /^buy/ is a regular expression.
+func ParseSearchFilter(search string) (TaskPredicate, error) {
+	if len(search) > 1 && strings.HasPrefix(search, "/") && strings.HasSuffix(search, "/") {
+		pattern, err := regexp.Compile(search[1 : len(search)-1])
+		if err != nil {
+			return nil, failures.Newf(failures.Validation, errorInvalidSearchPattern, search)
+		}
+		return func(task *Task) bool {
+			return pattern.MatchString(task.Content) || pattern.MatchString(task.Description)
+		}, nil
+	}
+
+	search = strings.ToLower(search)
+	return func(task *Task) bool {
+		return strings.Contains(strings.ToLower(task.Content), search) || strings.Contains(strings.ToLower(task.Description), search)
+	}, nil
+}
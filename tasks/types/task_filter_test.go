@@ -0,0 +1,165 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGivenADueFilterWhenFilteringThenOnlyTasksDueInThatPeriodAreKept(t *testing.T) {
+	// Wednesday
+	now := time.Date(2020, 5, 13, 15, 30, 0, 0, time.Local)
+
+	tasks := TaskList{
+		{ID: 1, DueDate: date(2020, 5, 10)},
+		{ID: 2, DueDate: date(2020, 5, 12)},
+		{ID: 3, DueDate: date(2020, 5, 13)},
+		{ID: 4, DueDate: date(2020, 5, 14)},
+		{ID: 5, DueDate: date(2020, 5, 17)},
+		{ID: 6, DueDate: date(2020, 5, 18)},
+		{ID: 7},
+	}
+
+	var filtersToTest = []struct {
+		filter      string
+		expectedIDs []uint32
+	}{
+		{"today", []uint32{3}},
+		{"Tomorrow", []uint32{4}},
+		{"overdue", []uint32{1, 2}},
+		{"this-week", []uint32{2, 3, 4, 5}},
+		{"before:2020-05-13", []uint32{1, 2}},
+		{"after:2020-05-14", []uint32{5, 6}},
+	}
+
+	for _, filterToTest := range filtersToTest {
+		predicate, err := ParseDueFilter(filterToTest.filter, now)
+		if err != nil {
+			t.Errorf("Expected no error for '%s', got '%s'", filterToTest.filter, err.Error())
+			continue
+		}
+
+		actualIDs := idsOf(tasks.Filter(predicate))
+		if !equalIDs(filterToTest.expectedIDs, actualIDs) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", filterToTest.expectedIDs, filterToTest.filter, actualIDs)
+		}
+	}
+}
+
+func TestGivenAnInvalidFilterWhenParsingThenAValidationErrorIsReturned(t *testing.T) {
+	_, err := ParseDueFilter("someday", time.Now())
+	if failures.KindOf(err) != failures.Validation {
+		t.Errorf("Expected a validation error for the due filter, got '%v'", err)
+	}
+
+	_, err = ParseDueFilter("before:tomorrow", time.Now())
+	if failures.KindOf(err) != failures.Validation {
+		t.Errorf("Expected a validation error for the due filter, got '%v'", err)
+	}
+
+	for _, filter := range []string{"5", ">=0", "=>3", "high", ""} {
+		_, err = ParsePriorityFilter(filter)
+		if failures.KindOf(err) != failures.Validation {
+			t.Errorf("Expected a validation error for the priority filter '%s', got '%v'", filter, err)
+		}
+	}
+
+	_, err = ParseSearchFilter("/(unclosed/")
+	if failures.KindOf(err) != failures.Validation {
+		t.Errorf("Expected a validation error for the search filter, got '%v'", err)
+	}
+
+	_, err = TaskList{}.Limit(-1)
+	if failures.KindOf(err) != failures.Validation {
+		t.Errorf("Expected a validation error for the limit, got '%v'", err)
+	}
+}
+
+func TestGivenAPriorityFilterWhenFilteringThenTasksAreComparedToThePriority(t *testing.T) {
+	tasks := TaskList{
+		{ID: 1, Priority: 1},
+		{ID: 2, Priority: 2},
+		{ID: 3, Priority: 3},
+		{ID: 4, Priority: 4},
+	}
+
+	var filtersToTest = []struct {
+		filter      string
+		expectedIDs []uint32
+	}{
+		{"4", []uint32{4}},
+		{"=2", []uint32{2}},
+		{">=3", []uint32{3, 4}},
+		{"> 2", []uint32{3, 4}},
+		{"<2", []uint32{1}},
+		{"<=2", []uint32{1, 2}},
+	}
+
+	for _, filterToTest := range filtersToTest {
+		predicate, err := ParsePriorityFilter(filterToTest.filter)
+		if err != nil {
+			t.Errorf("Expected no error for '%s', got '%s'", filterToTest.filter, err.Error())
+			continue
+		}
+
+		actualIDs := idsOf(tasks.Filter(predicate))
+		if !equalIDs(filterToTest.expectedIDs, actualIDs) {
+			t.Errorf("Expected '%v' for '%s', got '%v'", filterToTest.expectedIDs, filterToTest.filter, actualIDs)
+		}
+	}
+}
+
+func TestGivenSeveralPredicatesWhenFilteringThenTasksMustSatisfyAllOfThem(t *testing.T) {
+	tasks := TaskList{
+		{ID: 1, Content: "Buy milk", Labels: []string{"errand"}, ProjectName: "Personal"},
+		{ID: 2, Content: "buy a present", Description: "for the party", Labels: []string{"Errand", "family"}, ProjectName: "Personal"},
+		{ID: 3, Content: "Write report", Description: "quarterly numbers", ProjectName: "Work"},
+	}
+
+	search, _ := ParseSearchFilter("BUY")
+	actualIDs := idsOf(tasks.Filter(search, HasLabel("@family")))
+	if !equalIDs([]uint32{2}, actualIDs) {
+		t.Errorf("Expected '[2]', got '%v'", actualIDs)
+	}
+
+	regularExpression, _ := ParseSearchFilter("/^(Buy|quarterly)/")
+	actualIDs = idsOf(tasks.Filter(regularExpression))
+	if !equalIDs([]uint32{1, 3}, actualIDs) {
+		t.Errorf("Expected '[1 3]', got '%v'", actualIDs)
+	}
+
+	actualIDs = idsOf(tasks.Filter(HasLabel("errand"), InProject("personal")))
+	if !equalIDs([]uint32{1, 2}, actualIDs) {
+		t.Errorf("Expected '[1 2]', got '%v'", actualIDs)
+	}
+
+	limitedTasks, _ := tasks.Filter().Limit(2)
+	if !equalIDs([]uint32{1, 2}, idsOf(limitedTasks)) {
+		t.Errorf("Expected '[1 2]', got '%v'", idsOf(limitedTasks))
+	}
+}
+
+func idsOf(tasks TaskList) []uint32 {
+	var ids []uint32
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func equalIDs(expected []uint32, actual []uint32) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for index := range expected {
+		if expected[index] != actual[index] {
+			return false
+		}
+	}
+	return true
+}
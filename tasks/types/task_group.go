@@ -0,0 +1,7 @@
+package types
+
+// TaskGroup is a named subset of tasks, for example all tasks belonging to a single project
+type TaskGroup struct {
+	Name  string
+	Tasks TaskList
+}
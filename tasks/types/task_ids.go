@@ -0,0 +1,79 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+const (
+	errorNoTaskIDs      = "At least one task id must be provided"
+	errorInvalidTaskID  = "'%s' is not a valid task id"
+	errorInvalidIDRange = "'%s' is not a valid range of task ids"
+	errorIDRangeTooLong = "'%s' spans more than %d task ids"
+
+	// maximumIDRangeLength is the most task ids a single range may span, ranges exist to save typing ids that are listed
+	// together, not to address every task that could ever exist
+	maximumIDRangeLength = 1000
+)
+
+// ParseTaskIDs parses a comma separated list of task ids and ranges of task ids, e.g. "1,3,7" or "2-5".
+// The ids are returned in the order they were provided without duplicates, a range may span at most maximumIDRangeLength ids.
+func ParseTaskIDs(value string) ([]uint32, error) {
+	var taskIDs []uint32
+	seen := make(map[uint32]bool)
+
+	add := func(taskID uint32) {
+		if !seen[taskID] {
+			seen[taskID] = true
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		bounds := strings.SplitN(part, "-", 2)
+		if len(bounds) == 1 {
+			taskID, err := parseTaskID(part)
+			if err != nil {
+				return nil, failures.Newf(failures.Validation, errorInvalidTaskID, part)
+			}
+			add(taskID)
+			continue
+		}
+
+		first, firstErr := parseTaskID(bounds[0])
+		last, lastErr := parseTaskID(bounds[1])
+		if firstErr != nil || lastErr != nil || first > last {
+			return nil, failures.Newf(failures.Validation, errorInvalidIDRange, part)
+		}
+		if last-first >= maximumIDRangeLength {
+			return nil, failures.Newf(failures.Validation, errorIDRangeTooLong, part, maximumIDRangeLength)
+		}
+		for taskID := first; ; taskID++ {
+			add(taskID)
+			if taskID == last {
+				break
+			}
+		}
+	}
+
+	if len(taskIDs) == 0 {
+		return nil, failures.New(failures.Validation, errorNoTaskIDs)
+	}
+
+	return taskIDs, nil
+}
+
+func parseTaskID(value string) (uint32, error) {
+	taskID, err := strconv.ParseUint(strings.TrimSpace(value), 10, 32)
+	if err != nil || taskID == 0 {
+		return 0, failures.New(failures.Validation, errorInvalidTaskID)
+	}
+	return uint32(taskID), nil
+}
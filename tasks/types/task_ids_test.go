@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingTaskIDs(t *testing.T) {
+
+	var validValuesToTest = []struct {
+		value           string
+		expectedTaskIDs []uint32
+	}{
+		{"1", []uint32{1}},
+		{"1,3,7", []uint32{1, 3, 7}},
+		{"2-5", []uint32{2, 3, 4, 5}},
+		{"7, 1-3, 2", []uint32{7, 1, 2, 3}},
+		{"4-4", []uint32{4}},
+		{"4294967294-4294967295", []uint32{4294967294, 4294967295}},
+	}
+
+	for _, valueToTest := range validValuesToTest {
+		valueToTest := valueToTest
+
+		t.Run("Given '"+valueToTest.value+"', when parsing, then the ids are returned in order without duplicates", func(t *testing.T) {
+			taskIDs, err := ParseTaskIDs(valueToTest.value)
+			assert.Nil(t, err)
+			assert.Equal(t, valueToTest.expectedTaskIDs, taskIDs)
+		})
+	}
+
+	var invalidValuesToTest = []struct {
+		value         string
+		expectedError string
+	}{
+		{"", errorNoTaskIDs},
+		{" , ", errorNoTaskIDs},
+		{"a", fmt.Sprintf(errorInvalidTaskID, "a")},
+		{"0", fmt.Sprintf(errorInvalidTaskID, "0")},
+		{"1,-2", fmt.Sprintf(errorInvalidIDRange, "-2")},
+		{"5-2", fmt.Sprintf(errorInvalidIDRange, "5-2")},
+		{"2-x", fmt.Sprintf(errorInvalidIDRange, "2-x")},
+		{"1-1001", fmt.Sprintf(errorIDRangeTooLong, "1-1001", maximumIDRangeLength)},
+		{"1-4000000000", fmt.Sprintf(errorIDRangeTooLong, "1-4000000000", maximumIDRangeLength)},
+	}
+
+	for _, valueToTest := range invalidValuesToTest {
+		valueToTest := valueToTest
+
+		t.Run("Given '"+valueToTest.value+"', when parsing, then an error is returned", func(t *testing.T) {
+			taskIDs, err := ParseTaskIDs(valueToTest.value)
+			assert.Nil(t, taskIDs)
+			if assert.NotNil(t, err) {
+				assert.Equal(t, valueToTest.expectedError, err.Error())
+			}
+		})
+	}
+
+}
@@ -1,11 +1,16 @@
 package types
 
-import "sort"
+import (
+	"sort"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
 
 // TaskList is a list of unordered tasks
 type TaskList []Task
 
-// SortByDueDateThenSortByPriority sorts the slice of tasks by due date, then priority. Returns a new slice of tasks.
+// SortByDueDateThenSortByPriority sorts the slice of tasks by due date, then priority, tasks without a due date are last. Returns a new slice of tasks.
 func (t TaskList) SortByDueDateThenSortByPriority() TaskList {
 	daysSeen := make(map[int64]int64)
 	tasksGroupedByDay := make(map[int64]TaskList)
@@ -23,7 +28,13 @@ func (t TaskList) SortByDueDateThenSortByPriority() TaskList {
 		days = append(days, day)
 	}
 
-	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+	undated := time.Time{}.Unix()
+	sort.Slice(days, func(i, j int) bool {
+		if days[i] == undated || days[j] == undated {
+			return days[j] == undated && days[i] != undated
+		}
+		return days[i] < days[j]
+	})
 
 	var tasksGroupedByDayAndOrdererByPriority TaskList
 	for _, day := range days {
@@ -44,3 +55,46 @@ func (t TaskList) Less(i, j int) bool {
 
 // Swap swaps two different tasks in the slice
 func (t TaskList) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+
+// FilterByProject returns the tasks that belong to the project with the provided name or Todoist id. Names are not case sensitive.
+func (t TaskList) FilterByProject(nameOrID string) TaskList {
+	return t.Filter(InProject(nameOrID))
+}
+
+// GroupByProject splits the tasks into groups named after their project, ordered by project name. The order of tasks within a group is preserved.
+func (t TaskList) GroupByProject() []TaskGroup {
+	var projectNames []string
+	tasksGroupedByProject := make(map[string]TaskList)
+	for _, task := range t {
+		if _, projectAlreadySeen := tasksGroupedByProject[task.ProjectName]; !projectAlreadySeen {
+			projectNames = append(projectNames, task.ProjectName)
+		}
+		tasksGroupedByProject[task.ProjectName] = append(tasksGroupedByProject[task.ProjectName], task)
+	}
+
+	sort.Strings(projectNames)
+
+	groups := make([]TaskGroup, 0, len(projectNames))
+	for _, projectName := range projectNames {
+		groups = append(groups, TaskGroup{
+			Name:  projectName,
+			Tasks: tasksGroupedByProject[projectName],
+		})
+	}
+
+	return groups
+}
+
+// AsRecords returns the tasks as records written in machine readable output
+func (t TaskList) AsRecords() []output.Record {
+	records := make([]output.Record, len(t))
+	for index := range t {
+		records[index] = t[index].AsRecord()
+	}
+	return records
+}
+
+// RecordColumns returns the names of the fields of a task in machine readable output
+func RecordColumns() []string {
+	return (&Task{}).AsRecord().Names()
+}
@@ -44,8 +44,55 @@ func TestGivenListOfTasksWhenSortingTasksThenListOfTasksIsOrderedByDueDateThenPr
 	assert.Equal(t, sortedTasks[4].TodoistID, int64(5))
 }
 
+func TestGivenTasksWithoutADueDateWhenSortingTasksThenTheyAreOrderedAfterTasksWithADueDate(t *testing.T) {
+	tasks := TaskList{
+		Task{TodoistID: 1, Priority: 4},
+		Task{TodoistID: 2, DueDate: getDateDisregardingError("2020-04-11"), Priority: 1},
+		Task{TodoistID: 3, DueDate: getDateDisregardingError("2020-04-10"), Priority: 1},
+	}
+
+	sortedTasks := tasks.SortByDueDateThenSortByPriority()
+	assert.Equal(t, int64(3), sortedTasks[0].TodoistID)
+	assert.Equal(t, int64(2), sortedTasks[1].TodoistID)
+	assert.Equal(t, int64(1), sortedTasks[2].TodoistID)
+}
+
 func getDateDisregardingError(dateString string) time.Time {
 	expectedDateFormat := "2006-01-02"
 	date, _ := time.Parse(expectedDateFormat, dateString)
 	return date
 }
+
+func TestGivenListOfTasksWhenFilteringByProjectThenOnlyTasksInThatProjectAreReturned(t *testing.T) {
+	tasks := TaskList{
+		Task{TodoistID: 1, ProjectID: 100, ProjectName: "Work"},
+		Task{TodoistID: 2, ProjectID: 200, ProjectName: "Personal"},
+		Task{TodoistID: 3, ProjectID: 100, ProjectName: "Work"},
+	}
+
+	filteredByName := tasks.FilterByProject("work")
+	assert.Len(t, filteredByName, 2)
+	assert.Equal(t, int64(1), filteredByName[0].TodoistID)
+	assert.Equal(t, int64(3), filteredByName[1].TodoistID)
+
+	filteredByID := tasks.FilterByProject("200")
+	assert.Len(t, filteredByID, 1)
+	assert.Equal(t, int64(2), filteredByID[0].TodoistID)
+
+	assert.Empty(t, tasks.FilterByProject("Groceries"))
+}
+
+func TestGivenListOfTasksWhenGroupingByProjectThenGroupsAreOrderedByProjectNameAndTaskOrderIsPreserved(t *testing.T) {
+	tasks := TaskList{
+		Task{TodoistID: 1, ProjectName: "Work"},
+		Task{TodoistID: 2, ProjectName: "Personal"},
+		Task{TodoistID: 3, ProjectName: "Work"},
+	}
+
+	groups := tasks.GroupByProject()
+	assert.Len(t, groups, 2)
+	assert.Equal(t, "Personal", groups[0].Name)
+	assert.Equal(t, TaskList{tasks[1]}, groups[0].Tasks)
+	assert.Equal(t, "Work", groups[1].Name)
+	assert.Equal(t, TaskList{tasks[0], tasks[2]}, groups[1].Tasks)
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+const (
+	errorInvalidTodoistID = "'%s' is not a valid Todoist id, Todoist ids are written as t:123456"
+	errorAmbiguousTask    = "'%s' matches %d tasks, use the id of one of them:%s"
+)
+
+var (
+	idPattern        = regexp.MustCompile(`^\d+(\s*-\s*\d+)?$`)
+	todoistIDPattern = regexp.MustCompile(`(?i)^t:`)
+)
+
+// TaskReference identifies a task given on the command line, either by its id, by its Todoist id or by part of its content
+type TaskReference struct {
+	ID        uint32
+	TodoistID int64
+	Content   string
+}
+
+// String returns the reference as it can be written on the command line
+func (r TaskReference) String() string {
+	switch {
+	case r.ID != 0:
+		return strconv.FormatUint(uint64(r.ID), 10)
+	case r.TodoistID != 0:
+		return fmt.Sprintf("t:%d", r.TodoistID)
+	}
+	return r.Content
+}
+
+// ParseTaskReferences parses a comma separated list of task ids, ranges of task ids and Todoist ids prefixed with t:,
+// e.g. "1,3,7", "2-5" or "t:123456". A value that is not made up only of ids, such as "call mom, 3", is part of the content of a single task.
+func ParseTaskReferences(value string) ([]TaskReference, error) {
+	parts := strings.Split(value, ",")
+
+	isListOfIDs := false
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if !idPattern.MatchString(part) && !todoistIDPattern.MatchString(part) {
+			isListOfIDs = false
+			break
+		}
+		isListOfIDs = true
+	}
+
+	if !isListOfIDs {
+		content := strings.TrimSpace(value)
+		if content == "" {
+			return nil, failures.New(failures.Validation, errorNoTaskIDs)
+		}
+		return []TaskReference{{Content: content}}, nil
+	}
+
+	var references []TaskReference
+	seen := make(map[TaskReference]bool)
+	add := func(reference TaskReference) {
+		if !seen[reference] {
+			seen[reference] = true
+			references = append(references, reference)
+		}
+	}
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		if todoistIDPattern.MatchString(part) {
+			todoistID, err := strconv.ParseInt(part[2:], 10, 64)
+			if err != nil || todoistID <= 0 {
+				return nil, failures.Newf(failures.Validation, errorInvalidTodoistID, part)
+			}
+			add(TaskReference{TodoistID: todoistID})
+			continue
+		}
+
+		taskIDs, err := ParseTaskIDs(part)
+		if err != nil {
+			return nil, err
+		}
+		for _, taskID := range taskIDs {
+			add(TaskReference{ID: taskID})
+		}
+	}
+
+	return references, nil
+}
+
+// MatchContent returns the tasks whose content contains the text, ignoring case. When no content contains the text, the tasks
+// whose content contains every word of the text are returned. A task whose content is the text is the only match.
+func (t TaskList) MatchContent(text string) TaskList {
+	text = strings.ToLower(strings.TrimSpace(text))
+
+	var matches TaskList
+	for _, task := range t {
+		content := strings.ToLower(task.Content)
+		if content == text {
+			return TaskList{task}
+		}
+		if strings.Contains(content, text) {
+			matches = append(matches, task)
+		}
+	}
+	if len(matches) > 0 {
+		return matches
+	}
+
+	words := strings.Fields(text)
+	for _, task := range t {
+		content := strings.ToLower(task.Content)
+		containsEveryWord := len(words) > 0
+		for _, word := range words {
+			if !strings.Contains(content, word) {
+				containsEveryWord = false
+				break
+			}
+		}
+		if containsEveryWord {
+			matches = append(matches, task)
+		}
+	}
+	return matches
+}
+
+// AmbiguousTaskError is returned when a reference to a task matches several tasks
+type AmbiguousTaskError struct {
+	Reference string
+	Matches   TaskList
+}
+
+func (e *AmbiguousTaskError) Error() string {
+	var candidates string
+	for _, task := range e.Matches {
+		candidates += fmt.Sprintf("\n[%d] %s", task.ID, task.Content)
+	}
+	return fmt.Sprintf(errorAmbiguousTask, e.Reference, len(e.Matches), candidates)
+}
+
+// Kind returns failures.Validation, the reference has to be more specific
+func (e *AmbiguousTaskError) Kind() failures.Kind {
+	return failures.Validation
+}
+
+// IsAmbiguousTask returns true if the error occurred because a reference matched several tasks
+func IsAmbiguousTask(err error) bool {
+	_, isAmbiguousTask := err.(*AmbiguousTaskError)
+	return isAmbiguousTask
+}
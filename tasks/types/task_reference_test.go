@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/failures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsingTaskReferences(t *testing.T) {
+
+	var validValuesToTest = []struct {
+		value              string
+		expectedReferences []TaskReference
+	}{
+		{"3", []TaskReference{{ID: 3}}},
+		{"1-2, 1", []TaskReference{{ID: 1}, {ID: 2}}},
+		{"t:123456", []TaskReference{{TodoistID: 123456}}},
+		{"2,T:100,4", []TaskReference{{ID: 2}, {TodoistID: 100}, {ID: 4}}},
+		{"buy milk", []TaskReference{{Content: "buy milk"}}},
+		{" eggs, milk and bread ", []TaskReference{{Content: "eggs, milk and bread"}}},
+		{"call at 5pm", []TaskReference{{Content: "call at 5pm"}}},
+		{"call mom, 3", []TaskReference{{Content: "call mom, 3"}}},
+		{"3,milk", []TaskReference{{Content: "3,milk"}}},
+	}
+
+	for _, valueToTest := range validValuesToTest {
+		valueToTest := valueToTest
+
+		t.Run("Given '"+valueToTest.value+"', when parsing, then the references are returned in order without duplicates", func(t *testing.T) {
+			references, err := ParseTaskReferences(valueToTest.value)
+			assert.Nil(t, err)
+			assert.Equal(t, valueToTest.expectedReferences, references)
+		})
+	}
+
+	var invalidValuesToTest = []struct {
+		value         string
+		expectedError string
+	}{
+		{"", errorNoTaskIDs},
+		{" ", errorNoTaskIDs},
+		{"t:abc", fmt.Sprintf(errorInvalidTodoistID, "t:abc")},
+		{"5-2", fmt.Sprintf(errorInvalidIDRange, "5-2")},
+	}
+
+	for _, valueToTest := range invalidValuesToTest {
+		valueToTest := valueToTest
+
+		t.Run("Given '"+valueToTest.value+"', when parsing, then a validation error is returned", func(t *testing.T) {
+			_, err := ParseTaskReferences(valueToTest.value)
+			if assert.NotNil(t, err) {
+				assert.Equal(t, valueToTest.expectedError, err.Error())
+				assert.Equal(t, failures.Validation, failures.KindOf(err))
+			}
+		})
+	}
+
+}
+
+func TestWritingTaskReferences(t *testing.T) {
+	assert.Equal(t, "3", TaskReference{ID: 3}.String())
+	assert.Equal(t, "t:100", TaskReference{TodoistID: 100}.String())
+	assert.Equal(t, "buy milk", TaskReference{Content: "buy milk"}.String())
+}
+
+func TestMatchingTasksByContent(t *testing.T) {
+
+	tasks := TaskList{
+		{ID: 1, Content: "Buy milk"},
+		{ID: 2, Content: "Buy oat milk"},
+		{ID: 3, Content: "Milk"},
+		{ID: 4, Content: "Write the quarterly report"},
+	}
+
+	var textsToTest = []struct {
+		text        string
+		expectedIDs []uint32
+	}{
+		{"buy", []uint32{1, 2}},
+		{"OAT", []uint32{2}},
+		{"milk", []uint32{3}},
+		{"quarterly write", []uint32{4}},
+		{"report  write", []uint32{4}},
+		{"holiday", nil},
+	}
+
+	for _, textToTest := range textsToTest {
+		var actualIDs []uint32
+		for _, task := range tasks.MatchContent(textToTest.text) {
+			actualIDs = append(actualIDs, task.ID)
+		}
+		assert.Equal(t, textToTest.expectedIDs, actualIDs, textToTest.text)
+	}
+
+}
+
+func TestDescribingAmbiguousReferences(t *testing.T) {
+	err := &AmbiguousTaskError{
+		Reference: "buy",
+		Matches:   TaskList{{ID: 1, Content: "Buy milk"}, {ID: 2, Content: "Buy oat milk"}},
+	}
+
+	assert.Equal(t, "'buy' matches 2 tasks, use the id of one of them:\n[1] Buy milk\n[2] Buy oat milk", err.Error())
+	assert.Equal(t, failures.Validation, failures.KindOf(err))
+	assert.True(t, IsAmbiguousTask(err))
+}
@@ -1,6 +1,12 @@
 package types
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/output"
+)
 
 func TestGivenATaskWhenConvertingToStringThenThePriorityIsAStringCorrespondingToTheValue(t *testing.T) {
 	var tasksToTest = []struct {
@@ -13,7 +19,7 @@ func TestGivenATaskWhenConvertingToStringThenThePriorityIsAStringCorrespondingTo
 				Priority: 1,
 				Content:  "test",
 			},
-			"[1]\tLow\ttest",
+			"[1]\tLow\t\ttest",
 		},
 		{
 			Task{
@@ -21,7 +27,7 @@ func TestGivenATaskWhenConvertingToStringThenThePriorityIsAStringCorrespondingTo
 				Priority: 2,
 				Content:  "test2",
 			},
-			"[2]\tNormal\ttest2",
+			"[2]\tNormal\t\ttest2",
 		},
 		{
 			Task{
@@ -29,7 +35,7 @@ func TestGivenATaskWhenConvertingToStringThenThePriorityIsAStringCorrespondingTo
 				Priority: 3,
 				Content:  "test3",
 			},
-			"[3]\tUrgent\ttest3",
+			"[3]\tUrgent\t\ttest3",
 		},
 		{
 			Task{
@@ -37,7 +43,86 @@ func TestGivenATaskWhenConvertingToStringThenThePriorityIsAStringCorrespondingTo
 				Priority: 4,
 				Content:  "test4",
 			},
-			"[4]\tVery Urgent\ttest4",
+			"[4]\tVery Urgent\t\ttest4",
+		},
+		{
+			Task{
+				ID:          5,
+				Priority:    1,
+				ProjectName: "Work",
+				Content:     "test5",
+			},
+			"[5]\tLow\t#Work\ttest5",
+		},
+		{
+			Task{
+				ID:       6,
+				Priority: 1,
+				Content:  "test6",
+				Labels:   []string{"errand", "focus"},
+			},
+			"[6]\tLow\t\ttest6 @errand @focus",
+		},
+	}
+
+	for _, taskToTest := range tasksToTest {
+		stringRepresentation := taskToTest.task.AsString()
+		if stringRepresentation != taskToTest.expectedString {
+			t.Errorf("Expected '%s', got '%s'", taskToTest.expectedString, stringRepresentation)
+		}
+	}
+}
+
+func TestGivenATaskInATreeWhenConvertingToStringThenTheContentIsIndentedAndCollapsedSubTasksAreCounted(t *testing.T) {
+	task := Task{
+		ID:       7,
+		Priority: 1,
+		Content:  "test7",
+	}
+
+	var depthsToTest = []struct {
+		depth             int
+		collapsedSubTasks int
+		expectedString    string
+	}{
+		{0, 0, "[7]\tLow\t\ttest7"},
+		{2, 0, "[7]\tLow\t\t    test7"},
+		{1, 1, "[7]\tLow\t\t  test7 (+1 sub-task)"},
+		{0, 3, "[7]\tLow\t\ttest7 (+3 sub-tasks)"},
+	}
+
+	for _, depthToTest := range depthsToTest {
+		stringRepresentation := task.AsIndentedString(depthToTest.depth, depthToTest.collapsedSubTasks)
+		if stringRepresentation != depthToTest.expectedString {
+			t.Errorf("Expected '%s', got '%s'", depthToTest.expectedString, stringRepresentation)
+		}
+	}
+}
+
+func TestGivenATaskWithADueDateWhenConvertingToStringThenTheDueDateTimeAndRecurrenceAreShown(t *testing.T) {
+	var tasksToTest = []struct {
+		task           Task
+		expectedString string
+	}{
+		{
+			Task{ID: 1, Priority: 1, Content: "test", DueDate: time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC)},
+			"[1]\tLow\t\ttest 2020-04-10",
+		},
+		{
+			Task{ID: 2, Priority: 1, Content: "test2", DueDate: time.Date(2020, 4, 10, 17, 0, 0, 0, time.UTC), DueHasTime: true},
+			"[2]\tLow\t\ttest2 2020-04-10 17:00",
+		},
+		{
+			Task{ID: 3, Priority: 1, Content: "test3", DueDate: time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC), DueString: "every day", IsRecurring: true},
+			"[3]\tLow\t\ttest3 2020-04-10 (every day)",
+		},
+		{
+			Task{ID: 4, Priority: 1, Content: "test4", DueDate: time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC), IsRecurring: true},
+			"[4]\tLow\t\ttest4 2020-04-10 (recurring)",
+		},
+		{
+			Task{ID: 5, Priority: 1, Content: "test5", DueDate: time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC), Deadline: time.Date(2020, 4, 15, 0, 0, 0, 0, time.UTC)},
+			"[5]\tLow\t\ttest5 2020-04-10 deadline 2020-04-15",
 		},
 	}
 
@@ -48,3 +133,120 @@ func TestGivenATaskWhenConvertingToStringThenThePriorityIsAStringCorrespondingTo
 		}
 	}
 }
+
+func TestGivenATaskWithATimeWhenConvertingToARecordThenTheDueDateIncludesTheTime(t *testing.T) {
+	task := Task{DueDate: time.Date(2020, 4, 10, 17, 30, 0, 0, time.UTC), DueHasTime: true, DueTimezone: "Europe/Madrid"}
+
+	record := task.AsRecord()
+	if record[6].Value != "2020-04-10T17:30:00" {
+		t.Errorf("Expected '2020-04-10T17:30:00', got '%v'", record[6].Value)
+	}
+	if record[12].Value != "Europe/Madrid" {
+		t.Errorf("Expected 'Europe/Madrid', got '%v'", record[12].Value)
+	}
+}
+
+func TestGivenATaskWhenCheckingWhetherItBelongsToAProjectThenTheNameOrTodoistIDIsMatched(t *testing.T) {
+	task := Task{
+		ProjectID:   100,
+		ProjectName: "Work",
+	}
+
+	var projectsToTest = []struct {
+		nameOrID       string
+		expectedResult bool
+	}{
+		{"Work", true},
+		{"work", true},
+		{"100", true},
+		{"Personal", false},
+		{"200", false},
+		{"", false},
+	}
+
+	for _, projectToTest := range projectsToTest {
+		if task.BelongsToProject(projectToTest.nameOrID) != projectToTest.expectedResult {
+			t.Errorf("Expected '%t' for '%s'", projectToTest.expectedResult, projectToTest.nameOrID)
+		}
+	}
+}
+
+func TestGivenATaskWhenReferencingItInACommandThenTheTemporaryIDIsUsedUntilItHasBeenSynced(t *testing.T) {
+	syncedTask := Task{TodoistID: 100, TemporaryID: "temp-1"}
+	if syncedTask.TodoistReference() != int64(100) {
+		t.Errorf("Expected '100', got '%v'", syncedTask.TodoistReference())
+	}
+
+	unsyncedTask := Task{TemporaryID: "temp-1"}
+	if unsyncedTask.TodoistReference() != "temp-1" {
+		t.Errorf("Expected 'temp-1', got '%v'", unsyncedTask.TodoistReference())
+	}
+}
+
+func TestGivenATaskWhenConvertingToARecordThenTheFieldsHaveStableNames(t *testing.T) {
+	task := Task{
+		ID:          1,
+		TodoistID:   100,
+		ProjectID:   10,
+		ProjectName: "Work",
+		Content:     "test",
+		Description: "details",
+		DueDate:     time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC),
+		DueString:   "every sunday",
+		DueLang:     "en",
+		IsRecurring: true,
+		Deadline:    time.Date(2020, 5, 20, 0, 0, 0, 0, time.UTC),
+		Priority:    4,
+		Checked:     1,
+		Labels:      []string{"errand"},
+		ParentID:    50,
+	}
+
+	expectedRecord := output.Record{
+		{Name: "id", Value: uint32(1)},
+		{Name: "todoist_id", Value: int64(100)},
+		{Name: "project_id", Value: int64(10)},
+		{Name: "project", Value: "Work"},
+		{Name: "content", Value: "test"},
+		{Name: "description", Value: "details"},
+		{Name: "due", Value: "2020-05-17"},
+		{Name: "priority", Value: int16(4)},
+		{Name: "completed", Value: true},
+		{Name: "labels", Value: []string{"errand"}},
+		{Name: "parent_id", Value: int64(50)},
+		{Name: "due_string", Value: "every sunday"},
+		{Name: "due_timezone", Value: ""},
+		{Name: "due_lang", Value: "en"},
+		{Name: "recurring", Value: true},
+		{Name: "deadline", Value: "2020-05-20"},
+	}
+
+	record := task.AsRecord()
+	if !reflect.DeepEqual(expectedRecord, record) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord, record)
+	}
+
+	if !reflect.DeepEqual(expectedRecord.Names(), RecordColumns()) {
+		t.Errorf("Expected '%v', got '%v'", expectedRecord.Names(), RecordColumns())
+	}
+}
+
+func TestGivenAPriorityWhenNamingItThenTheDisplayedNameIsReturned(t *testing.T) {
+	var prioritiesToTest = []struct {
+		priority     int16
+		expectedName string
+	}{
+		{4, "Very Urgent"},
+		{3, "Urgent"},
+		{2, "Normal"},
+		{1, "Low"},
+		{0, ""},
+	}
+
+	for _, priorityToTest := range prioritiesToTest {
+		name := TemplateFuncs()["priority"].(func(int16) string)(priorityToTest.priority)
+		if name != priorityToTest.expectedName {
+			t.Errorf("Expected '%s', got '%s'", priorityToTest.expectedName, name)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package types
+
+import "sort"
+
+// TaskTree is a task together with the trees of its sub-tasks
+type TaskTree struct {
+	Task     Task
+	SubTasks []TaskTree
+}
+
+// CountSubTasks returns the number of sub-tasks below the task, including the sub-tasks of its sub-tasks
+func (t TaskTree) CountSubTasks() int {
+	count := len(t.SubTasks)
+	for _, subTask := range t.SubTasks {
+		count += subTask.CountSubTasks()
+	}
+	return count
+}
+
+// Tree arranges the tasks into trees of sub-tasks. Tasks whose parent is not in the list are at the top level in the order of the list,
+// sub-tasks are ordered as they are on Todoist.
+func (t TaskList) Tree() []TaskTree {
+	tasksInList := make(map[int64]bool)
+	for _, task := range t {
+		if task.TodoistID != 0 {
+			tasksInList[task.TodoistID] = true
+		}
+	}
+
+	var topLevelTasks TaskList
+	subTasksByParent := make(map[int64]TaskList)
+	for _, task := range t {
+		if task.IsSubTask() && tasksInList[task.ParentID] {
+			subTasksByParent[task.ParentID] = append(subTasksByParent[task.ParentID], task)
+			continue
+		}
+		topLevelTasks = append(topLevelTasks, task)
+	}
+
+	return buildTrees(topLevelTasks, subTasksByParent)
+}
+
+func buildTrees(tasks TaskList, subTasksByParent map[int64]TaskList) []TaskTree {
+	trees := make([]TaskTree, len(tasks))
+	for index, task := range tasks {
+		subTasks := subTasksByParent[task.TodoistID]
+		sort.SliceStable(subTasks, func(i, j int) bool {
+			return subTasks[i].ChildOrder < subTasks[j].ChildOrder
+		})
+
+		trees[index] = TaskTree{
+			Task:     task,
+			SubTasks: buildTrees(subTasks, subTasksByParent),
+		}
+	}
+	return trees
+}
+
+// SubTasksOf returns the sub-tasks of the task with the provided Todoist id, each followed by its own sub-tasks
+func (t TaskList) SubTasksOf(todoistID int64) TaskList {
+	var subTasks TaskList
+	if todoistID == 0 {
+		return subTasks
+	}
+
+	for _, task := range t {
+		if task.ParentID == todoistID {
+			subTasks = append(subTasks, task)
+			subTasks = append(subTasks, t.SubTasksOf(task.TodoistID)...)
+		}
+	}
+	return subTasks
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+var tasksWithSubTasks = TaskList{
+	{ID: 1, TodoistID: 100, Content: "Plan holiday"},
+	{ID: 2, TodoistID: 300, ParentID: 100, ChildOrder: 2, Content: "Book hotel"},
+	{ID: 3, TodoistID: 200, ParentID: 100, ChildOrder: 1, Content: "Book flights"},
+	{ID: 4, TodoistID: 400, ParentID: 200, ChildOrder: 1, Content: "Compare prices"},
+	{ID: 5, TodoistID: 500, Content: "Buy milk"},
+	{ID: 6, TodoistID: 600, ParentID: 900, Content: "Sub-task of a completed task"},
+}
+
+func TestGivenTasksWithSubTasksWhenArrangingThemIntoATreeThenSubTasksAreBelowTheirParentInTodoistOrder(t *testing.T) {
+	trees := tasksWithSubTasks.Tree()
+
+	if len(trees) != 3 {
+		t.Fatalf("Expected 3 top level tasks, got %d", len(trees))
+	}
+
+	expectedTopLevelIDs := []uint32{1, 5, 6}
+	for index, expectedID := range expectedTopLevelIDs {
+		if trees[index].Task.ID != expectedID {
+			t.Errorf("Expected top level task %d to be '%d', got '%d'", index, expectedID, trees[index].Task.ID)
+		}
+	}
+
+	holiday := trees[0]
+	if len(holiday.SubTasks) != 2 || holiday.SubTasks[0].Task.ID != 3 || holiday.SubTasks[1].Task.ID != 2 {
+		t.Errorf("Expected the sub-tasks of the first task to be '3' then '2', got '%v'", holiday.SubTasks)
+	}
+	if len(holiday.SubTasks[0].SubTasks) != 1 || holiday.SubTasks[0].SubTasks[0].Task.ID != 4 {
+		t.Errorf("Expected task '4' below task '3', got '%v'", holiday.SubTasks[0].SubTasks)
+	}
+}
+
+func TestGivenATaskTreeWhenCountingSubTasksThenSubTasksAtEveryLevelAreCounted(t *testing.T) {
+	trees := tasksWithSubTasks.Tree()
+
+	var treesToTest = []struct {
+		tree          TaskTree
+		expectedCount int
+	}{
+		{trees[0], 3},
+		{trees[0].SubTasks[0], 1},
+		{trees[1], 0},
+	}
+
+	for _, treeToTest := range treesToTest {
+		if count := treeToTest.tree.CountSubTasks(); count != treeToTest.expectedCount {
+			t.Errorf("Expected %d sub-tasks below '%s', got %d", treeToTest.expectedCount, treeToTest.tree.Task.Content, count)
+		}
+	}
+}
+
+func TestGivenATaskWhenGettingItsSubTasksThenSubTasksAtEveryLevelAreReturned(t *testing.T) {
+	var tasksToTest = []struct {
+		todoistID   int64
+		expectedIDs []uint32
+	}{
+		{100, []uint32{2, 3, 4}},
+		{200, []uint32{4}},
+		{500, nil},
+		{0, nil},
+	}
+
+	for _, taskToTest := range tasksToTest {
+		var actualIDs []uint32
+		for _, subTask := range tasksWithSubTasks.SubTasksOf(taskToTest.todoistID) {
+			actualIDs = append(actualIDs, subTask.ID)
+		}
+
+		if !reflect.DeepEqual(taskToTest.expectedIDs, actualIDs) {
+			t.Errorf("Expected '%v' for '%d', got '%v'", taskToTest.expectedIDs, taskToTest.todoistID, actualIDs)
+		}
+	}
+}
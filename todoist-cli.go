@@ -1,9 +1,13 @@
 package main
 
 import (
+	"os"
+
 	"github.com/kpdowns/todoist-cli/actions"
+	"github.com/kpdowns/todoist-cli/failures"
 )
 
 func main() {
-	actions.Initialize()
+	err := actions.Initialize()
+	os.Exit(failures.ExitCode(err))
 }
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/kpdowns/todoist-cli/config"
+	"github.com/kpdowns/todoist-cli/failures"
 	"github.com/kpdowns/todoist-cli/rest"
 	"github.com/kpdowns/todoist-cli/todoist/requests"
 	"github.com/kpdowns/todoist-cli/todoist/responses"
@@ -16,19 +18,32 @@ const (
 	errorRetrievingAccessToken            = "An error occurred while retrieving your access token, please try again later"
 	errorRevokingAccessToken              = "An error occurred while attempting to revoke your access token, please try again later"
 	errorCommunicatingWithTodoistAPI      = "An error occurred while attempting to communicate with Todoist, please try again later"
+	errorNoResponseFromTodoistAPI         = "Todoist did not respond, your request may or may not have been handled"
 	errorExecutingQuery                   = "An error occurred while executing your query, please try again later"
 	errorExecutingQueryMalformedQuery     = "An error occurred while executing your query, the query was not valid"
 	errorExecutingCommand                 = "An error occurred while executing your command, please try again later"
 	errorExecutingCommandMalformedCommand = "An error occurred while executing your command, the command was not valid"
+	errorQuickAddingTask                  = "An error occurred while adding your task, please try again later"
+	errorUploadingFile                    = "An error occurred while uploading your file, please try again later"
 	errorMalformedResponse                = "An error occurred while trying to decode the response from Todoist, please try again later"
+	errorMissingCommandStatus             = "Todoist did not report the result of the command"
+	errorRateLimited                      = "Todoist is limiting the number of requests that can be made, please try again later"
 )
 
+// ErrUnreachable is returned when Todoist could not be reached, for example when there is no network connection
+var ErrUnreachable = failures.New(failures.Network, errorCommunicatingWithTodoistAPI)
+
+// ErrNoResponse is returned when a request was sent to Todoist but no response arrived, Todoist may or may not have handled the request
+var ErrNoResponse = failures.New(failures.Network, errorNoResponseFromTodoistAPI)
+
 // API provides functions for interacting with the Todoist API
 type API interface {
 	GetAccessToken(code string) (*responses.AccessToken, error)
 	RevokeAccessToken(accessToken string) error
 	ExecuteSyncQuery(query requests.Query) (*responses.Query, error)
-	ExecuteSyncCommand(command requests.Command) error
+	ExecuteSyncCommand(command requests.Command) (*responses.Command, error)
+	QuickAdd(quickAdd requests.QuickAdd) (*responses.Item, error)
+	UploadFile(upload requests.Upload) (*responses.FileAttachment, error)
 }
 
 type api struct {
@@ -55,11 +70,11 @@ func (a *api) GetAccessToken(code string) (*responses.AccessToken, error) {
 	var buffer []byte
 	response, err := rest.Post(accessTokenURL, "application/json", bytes.NewBuffer(buffer))
 	if err != nil {
-		return nil, errors.New(errorCommunicatingWithTodoistAPI)
+		return nil, ErrUnreachable
 	}
 
 	if response.StatusCode != 200 {
-		return nil, errors.New(errorRetrievingAccessToken)
+		return nil, statusError(response.StatusCode, errorRetrievingAccessToken)
 	}
 
 	defer response.Body.Close()
@@ -76,7 +91,7 @@ func (a *api) GetAccessToken(code string) (*responses.AccessToken, error) {
 // RevokeAccessToken revokes the current access token effectively logging the user out
 func (a *api) RevokeAccessToken(accessToken string) error {
 	if accessToken == "" {
-		return errors.New(errorRevokingAccessToken)
+		return failures.New(failures.NotAuthenticated, errorRevokingAccessToken)
 	}
 
 	revokeAccessTokenURL := fmt.Sprintf("%s/sync/v8/access_tokens/revoke", a.config.TodoistURL)
@@ -94,12 +109,12 @@ func (a *api) RevokeAccessToken(accessToken string) error {
 
 	response, err := rest.Post(revokeAccessTokenURL, "application/json", bytes.NewBuffer(jsonRequestBody))
 	if err != nil {
-		return errors.New(errorCommunicatingWithTodoistAPI)
+		return ErrUnreachable
 	}
 
 	defer response.Body.Close()
 	if response.StatusCode != 204 {
-		return errors.New(errorRevokingAccessToken)
+		return statusError(response.StatusCode, errorRevokingAccessToken)
 	}
 
 	return nil
@@ -107,17 +122,16 @@ func (a *api) RevokeAccessToken(accessToken string) error {
 
 // ExecuteSyncQuery executes a query against Todoist and returns the response
 func (a *api) ExecuteSyncQuery(query requests.Query) (*responses.Query, error) {
-	url := fmt.Sprintf("%s/sync/v8/sync?%s", a.config.TodoistURL, query.ToQueryString())
+	url := fmt.Sprintf("%s/sync/v8/sync", a.config.TodoistURL)
 
-	var buffer []byte
-	response, err := rest.Post(url, "application/json", bytes.NewBuffer(buffer))
+	response, err := rest.PostRetryableForm(url, query.ToFormValues(), query.Token)
 	if err != nil {
-		return nil, errors.New(errorCommunicatingWithTodoistAPI)
+		return nil, ErrUnreachable
 	}
 	defer response.Body.Close()
 
 	if response.StatusCode != 200 {
-		return nil, errors.New(errorExecutingQuery)
+		return nil, statusError(response.StatusCode, errorExecutingQuery)
 	}
 
 	var queryResponse responses.Query
@@ -129,19 +143,145 @@ func (a *api) ExecuteSyncQuery(query requests.Query) (*responses.Query, error) {
 	return &queryResponse, nil
 }
 
-// ExecuteSyncCommand executes a command against Todoist and returns the response
-func (a *api) ExecuteSyncCommand(command requests.Command) error {
-	url := fmt.Sprintf("%s/sync/v8/sync?%s", a.config.TodoistURL, command.ToQueryString())
+// ExecuteSyncCommand executes a command against Todoist and returns the response.
+// Commands are sent in batches of at most requests.MaximumCommandsPerRequest, temporary ids mapped by earlier batches are resolved in later ones.
+// If Todoist rejects any of the commands, the response is returned along with CommandErrors describing each rejected command, a command
+// whose result Todoist did not report counts as rejected.
+func (a *api) ExecuteSyncCommand(command requests.Command) (*responses.Command, error) {
+	commandResponse := &responses.Command{
+		SyncStatus:    make(map[string]responses.CommandStatus),
+		TempIDMapping: make(map[string]int64),
+	}
+
+	var commandErrors CommandErrors
+	for _, batch := range command.Split(requests.MaximumCommandsPerRequest) {
+		batch = batch.ResolveTemporaryIDs(commandResponse.TempIDMapping)
+
+		batchResponse, err := a.executeSyncCommandBatch(batch)
+		if err != nil {
+			return nil, err
+		}
 
-	response, err := rest.Get(url)
+		for uuid, status := range batchResponse.SyncStatus {
+			commandResponse.SyncStatus[uuid] = status
+		}
+		for temporaryID, todoistID := range batchResponse.TempIDMapping {
+			commandResponse.TempIDMapping[temporaryID] = todoistID
+		}
+
+		for _, commandDetail := range batch.Commands {
+			status, ok := batchResponse.SyncStatus[commandDetail.UUID]
+			if !ok {
+				status = responses.CommandStatus{Error: errorMissingCommandStatus}
+			}
+			if !status.Ok {
+				commandErrors = append(commandErrors, &CommandError{
+					UUID:    commandDetail.UUID,
+					Code:    status.ErrorCode,
+					Message: status.Error,
+				})
+			}
+		}
+	}
+
+	if len(commandErrors) > 0 {
+		return commandResponse, commandErrors
+	}
+
+	return commandResponse, nil
+}
+
+func (a *api) executeSyncCommandBatch(command requests.Command) (*responses.Command, error) {
+	url := fmt.Sprintf("%s/sync/v8/sync", a.config.TodoistURL)
+
+	response, err := rest.PostRetryableForm(url, command.ToFormValues(), command.Token)
 	if err != nil {
-		return errors.New(errorCommunicatingWithTodoistAPI)
+		return nil, ErrUnreachable
 	}
 	defer response.Body.Close()
 
 	if response.StatusCode != 200 {
-		return errors.New(errorExecutingCommand)
+		return nil, statusError(response.StatusCode, errorExecutingCommand)
 	}
 
-	return nil
+	var commandResponse responses.Command
+	err = json.NewDecoder(response.Body).Decode(&commandResponse)
+	if err != nil {
+		return nil, errors.New(errorMalformedResponse)
+	}
+
+	return &commandResponse, nil
+}
+
+// QuickAdd adds a task by letting Todoist parse the due date, project, labels and priority out of the text, the added item is returned.
+// Quick add requests have no UUID, so they are sent once and ErrNoResponse is returned when the task may have been added without a response arriving.
+func (a *api) QuickAdd(quickAdd requests.QuickAdd) (*responses.Item, error) {
+	url := fmt.Sprintf("%s/sync/v8/quick/add", a.config.TodoistURL)
+
+	response, err := rest.PostForm(url, quickAdd.ToFormValues(), quickAdd.Token)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != 200 {
+		return nil, statusError(response.StatusCode, errorQuickAddingTask)
+	}
+
+	var item responses.Item
+	err = json.NewDecoder(response.Body).Decode(&item)
+	if err != nil {
+		return nil, errors.New(errorMalformedResponse)
+	}
+
+	return &item, nil
+}
+
+// UploadFile uploads a file to Todoist, the returned file attachment can be attached to comments.
+// Uploads are sent once, since sending an upload again would upload the file again when only the response was lost.
+func (a *api) UploadFile(upload requests.Upload) (*responses.FileAttachment, error) {
+	url := fmt.Sprintf("%s/sync/v8/uploads/add", a.config.TodoistURL)
+
+	body, contentType, err := upload.ToMultipartBody()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := rest.PostAuthorized(url, contentType, body, upload.Token)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != 200 {
+		return nil, statusError(response.StatusCode, errorUploadingFile)
+	}
+
+	var fileAttachment responses.FileAttachment
+	err = json.NewDecoder(response.Body).Decode(&fileAttachment)
+	if err != nil {
+		return nil, errors.New(errorMalformedResponse)
+	}
+
+	return &fileAttachment, nil
+}
+
+// requestError describes a request that failed without a response, Todoist cannot have handled requests that never reached it
+func requestError(err error) error {
+	if rest.IsConnectionError(err) {
+		return ErrUnreachable
+	}
+	return ErrNoResponse
+}
+
+// statusError describes a response that Todoist did not answer successfully, Todoist responds with 401 or 403 when the access token is not accepted
+// and with 429 when it is rate limiting requests for longer than the retrying client waits
+func statusError(statusCode int, message string) error {
+	if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+		return failures.New(failures.NotAuthenticated, message)
+	}
+	if statusCode == http.StatusTooManyRequests {
+		return failures.New(failures.Rejected, errorRateLimited)
+	}
+	return failures.New(failures.Rejected, message)
 }
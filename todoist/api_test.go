@@ -6,10 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/kpdowns/todoist-cli/config"
+	"github.com/kpdowns/todoist-cli/failures"
 	"github.com/kpdowns/todoist-cli/mocks"
 	"github.com/kpdowns/todoist-cli/rest"
 	"github.com/kpdowns/todoist-cli/todoist/requests"
@@ -174,7 +178,7 @@ func TestRevokingAccessToken(t *testing.T) {
 func TestExecutingSyncQueries(t *testing.T) {
 	config := config.TodoistCliConfiguration{}
 
-	t.Run("When executing a sync query and the Todoist API is unavailable, then an error is returned", func(t *testing.T) {
+	t.Run("When executing a sync query and the Todoist API is unavailable, then the unreachable error is returned", func(t *testing.T) {
 
 		rest.Client = &mocks.MockHTTPClient{
 			DoFunction: func(r *http.Request) (*http.Response, error) {
@@ -187,9 +191,7 @@ func TestExecutingSyncQueries(t *testing.T) {
 		query := requests.NewQuery("token", "*", []requests.ResourceType{"all"})
 		response, err := api.ExecuteSyncQuery(query)
 		assert.Nil(t, response)
-		if assert.NotNil(t, err) {
-			assert.Equal(t, err.Error(), errorCommunicatingWithTodoistAPI)
-		}
+		assert.Equal(t, ErrUnreachable, err)
 	})
 
 	t.Run("When executing a sync query and the response does not indicate success, then an error is returned", func(t *testing.T) {
@@ -266,7 +268,7 @@ func TestExecutingSyncQueries(t *testing.T) {
 func TestExecutingSyncCommands(t *testing.T) {
 	config := config.TodoistCliConfiguration{}
 
-	t.Run("When executing a sync command and the Todoist API is unavailable, then an error is returned", func(t *testing.T) {
+	t.Run("When executing a sync command and the Todoist API is unavailable, then the unreachable error is returned", func(t *testing.T) {
 
 		rest.Client = &mocks.MockHTTPClient{
 			DoFunction: func(r *http.Request) (*http.Response, error) {
@@ -280,10 +282,8 @@ func TestExecutingSyncCommands(t *testing.T) {
 		arguments["content"] = "test-content"
 
 		command := requests.NewCommand("test-token", "item_add", arguments)
-		err := api.ExecuteSyncCommand(command)
-		if assert.NotNil(t, err) {
-			assert.Equal(t, err.Error(), errorCommunicatingWithTodoistAPI)
-		}
+		_, err := api.ExecuteSyncCommand(command)
+		assert.Equal(t, ErrUnreachable, err)
 	})
 
 	t.Run("When executing a sync command and the status code does not indicate success, then an error is returned", func(t *testing.T) {
@@ -303,13 +303,53 @@ func TestExecutingSyncCommands(t *testing.T) {
 		arguments["content"] = "test-content"
 
 		command := requests.NewCommand("test-token", "item_add", arguments)
-		err := api.ExecuteSyncCommand(command)
+		_, err := api.ExecuteSyncCommand(command)
 		if assert.NotNil(t, err) {
 			assert.Equal(t, err.Error(), errorExecutingCommand)
+			assert.Equal(t, failures.Rejected, failures.KindOf(err))
+		}
+	})
+
+	t.Run("When executing a sync command and the access token is not accepted, then a not authenticated error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 401,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		command := requests.NewCommand("test-token", "item_add", map[string]interface{}{"content": "test-content"})
+		_, err := api.ExecuteSyncCommand(command)
+		assert.Equal(t, failures.NotAuthenticated, failures.KindOf(err))
+	})
+
+	t.Run("When executing a sync command and Todoist is rate limiting requests, then a rate limited error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 429,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		command := requests.NewCommand("test-token", "item_add", map[string]interface{}{"content": "test-content"})
+		_, err := api.ExecuteSyncCommand(command)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorRateLimited, err.Error())
+			assert.Equal(t, failures.Rejected, failures.KindOf(err))
 		}
 	})
 
-	t.Run("When executing a sync command and the status code indicates success, then it is assumed the command executed successfully", func(t *testing.T) {
+	t.Run("When executing a sync command and the response can't be decoded, then an error is returned", func(t *testing.T) {
 
 		rest.Client = &mocks.MockHTTPClient{
 			DoFunction: func(r *http.Request) (*http.Response, error) {
@@ -322,11 +362,377 @@ func TestExecutingSyncCommands(t *testing.T) {
 
 		api := NewAPI(config)
 
-		arguments := make(map[string]interface{})
-		arguments["content"] = "test-content"
+		command := requests.NewCommand("test-token", "item_add", map[string]interface{}{"content": "test-content"})
+		response, err := api.ExecuteSyncCommand(command)
+		assert.Nil(t, response)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorMalformedResponse, err.Error())
+		}
+	})
 
-		command := requests.NewCommand("test-token", "item_add", arguments)
-		err := api.ExecuteSyncCommand(command)
+	t.Run("When executing a sync command and every command succeeds, then the temporary id mapping is returned", func(t *testing.T) {
+
+		command := requests.NewCommand("test-token", "item_add", map[string]interface{}{"content": "test-content"})
+		commandDetail := command.Commands[0]
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				body := fmt.Sprintf(`{"sync_status":{"%s":"ok"},"temp_id_mapping":{"%s":12345}}`, commandDetail.UUID, commandDetail.TemporaryID)
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		response, err := api.ExecuteSyncCommand(command)
 		assert.Nil(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, int64(12345), response.TempIDMapping[commandDetail.TemporaryID])
+		}
 	})
+
+	t.Run("When executing a sync command and Todoist rejects a command, then an error for that command is returned", func(t *testing.T) {
+
+		command := requests.Command{
+			Token: "test-token",
+			Commands: []requests.CommandDetail{
+				{Type: "item_add", UUID: "uuid-1"},
+				{Type: "item_update", UUID: "uuid-2"},
+			},
+		}
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				body := `{"sync_status":{"uuid-1":"ok","uuid-2":{"error_code":36,"error":"Date is invalid"}},"temp_id_mapping":{}}`
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		response, err := api.ExecuteSyncCommand(command)
+		assert.NotNil(t, response)
+		assert.True(t, IsCommandRejection(err))
+		assert.Equal(t, CommandErrors{{UUID: "uuid-2", Code: 36, Message: "Date is invalid"}}, err)
+	})
+
+	t.Run("When Todoist does not report the result of a command, then the command is treated as rejected", func(t *testing.T) {
+
+		command := requests.NewCommand("test-token", "item_close", map[string]interface{}{"id": 1})
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"sync_status":{},"temp_id_mapping":{}}`))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		_, err := api.ExecuteSyncCommand(command)
+		assert.Equal(t, CommandErrors{{UUID: command.Commands[0].UUID, Message: errorMissingCommandStatus}}, err)
+	})
+
+	t.Run("When executing more commands than Todoist accepts in a request, then they are split into batches and temporary ids are resolved across batches", func(t *testing.T) {
+
+		builder := requests.NewCommandBuilder("test-token")
+		projectTemporaryID := builder.Add("project_add", map[string]interface{}{"name": "Work"})
+		for index := 1; index < requests.MaximumCommandsPerRequest; index++ {
+			builder.Add("item_close", map[string]interface{}{"id": index})
+		}
+		builder.Add("item_add", map[string]interface{}{"content": "test", "project_id": projectTemporaryID})
+		command := builder.Build()
+
+		var executedBatches [][]requests.CommandDetail
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				var batch []requests.CommandDetail
+				r.ParseForm()
+				json.Unmarshal([]byte(r.PostForm.Get("commands")), &batch)
+				executedBatches = append(executedBatches, batch)
+
+				body := fmt.Sprintf(`{"sync_status":%s,"temp_id_mapping":{}}`, okSyncStatus(batch))
+				if len(executedBatches) == 1 {
+					body = fmt.Sprintf(`{"sync_status":%s,"temp_id_mapping":{"%s":100}}`, okSyncStatus(batch), projectTemporaryID)
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		response, err := api.ExecuteSyncCommand(command)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(100), response.TempIDMapping[projectTemporaryID])
+		if assert.Len(t, executedBatches, 2) {
+			assert.Len(t, executedBatches[0], requests.MaximumCommandsPerRequest)
+			assert.Len(t, executedBatches[1], 1)
+			assert.Equal(t, float64(100), executedBatches[1][0].Arguments["project_id"])
+		}
+	})
+}
+
+func TestQuickAddingTasks(t *testing.T) {
+	config := config.TodoistCliConfiguration{}
+
+	t.Run("When quick adding a task and the Todoist API cannot be connected to, then the unreachable error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+			},
+		}
+
+		api := NewAPI(config)
+
+		item, err := api.QuickAdd(requests.NewQuickAdd("token", "Buy milk"))
+		assert.Nil(t, item)
+		assert.Equal(t, ErrUnreachable, err)
+	})
+
+	t.Run("When quick adding a task and no response arrives after it was sent, then the no response error is returned and it is sent only once", func(t *testing.T) {
+
+		attempts := 0
+		rest.Client = rest.NewRetryingClient(&mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				attempts++
+				return nil, errors.New("timeout awaiting response headers")
+			},
+		}, rest.RetryPolicy{MaximumAttempts: 3})
+
+		api := NewAPI(config)
+
+		item, err := api.QuickAdd(requests.NewQuickAdd("token", "Buy milk"))
+		assert.Nil(t, item)
+		assert.Equal(t, ErrNoResponse, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("When quick adding a task and the response does not indicate success, then a rejected error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 400,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		item, err := api.QuickAdd(requests.NewQuickAdd("token", "Buy milk"))
+		assert.Nil(t, item)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorQuickAddingTask, err.Error())
+			assert.Equal(t, failures.Rejected, failures.KindOf(err))
+		}
+	})
+
+	t.Run("When quick adding a task and the response can't be decoded, then an error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		item, err := api.QuickAdd(requests.NewQuickAdd("token", "Buy milk"))
+		assert.Nil(t, item)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorMalformedResponse, err.Error())
+		}
+	})
+}
+
+func TestUploadingFiles(t *testing.T) {
+	config := config.TodoistCliConfiguration{}
+
+	t.Run("When uploading a file and the Todoist API is unavailable, then the unreachable error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+			},
+		}
+
+		api := NewAPI(config)
+
+		fileAttachment, err := api.UploadFile(requests.NewUpload("token", "notes.txt", []byte("contents")))
+		assert.Nil(t, fileAttachment)
+		assert.Equal(t, ErrUnreachable, err)
+	})
+
+	t.Run("When uploading a file and no response arrives, then the upload is sent once and the no response error is returned", func(t *testing.T) {
+
+		attempts := 0
+		rest.Client = rest.NewRetryingClient(&mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				attempts++
+				return nil, errors.New("timeout awaiting response headers")
+			},
+		}, rest.RetryPolicy{MaximumAttempts: 3})
+
+		api := NewAPI(config)
+
+		fileAttachment, err := api.UploadFile(requests.NewUpload("token", "notes.txt", []byte("contents")))
+		assert.Nil(t, fileAttachment)
+		assert.Equal(t, ErrNoResponse, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("When uploading a file and the response does not indicate success, then a rejected error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 400,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		fileAttachment, err := api.UploadFile(requests.NewUpload("token", "notes.txt", []byte("contents")))
+		assert.Nil(t, fileAttachment)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorUploadingFile, err.Error())
+			assert.Equal(t, failures.Rejected, failures.KindOf(err))
+		}
+	})
+
+	t.Run("When uploading a file and the response can't be decoded, then an error is returned", func(t *testing.T) {
+
+		rest.Client = &mocks.MockHTTPClient{
+			DoFunction: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+				}, nil
+			},
+		}
+
+		api := NewAPI(config)
+
+		fileAttachment, err := api.UploadFile(requests.NewUpload("token", "notes.txt", []byte("contents")))
+		assert.Nil(t, fileAttachment)
+		if assert.NotNil(t, err) {
+			assert.Equal(t, errorMalformedResponse, err.Error())
+		}
+	})
+}
+
+func TestSyncRequestsAgainstAServer(t *testing.T) {
+
+	var receivedRequest *http.Request
+	responseBody := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		receivedRequest = r
+		if responseBody != "" {
+			w.Write([]byte(responseBody))
+			return
+		}
+
+		var commands []requests.CommandDetail
+		json.Unmarshal([]byte(r.PostForm.Get("commands")), &commands)
+		fmt.Fprintf(w, `{"sync_token":"new-token","sync_status":%s,"temp_id_mapping":{}}`, okSyncStatus(commands))
+	}))
+	defer server.Close()
+
+	api := NewAPI(config.TodoistCliConfiguration{TodoistURL: server.URL})
+
+	t.Run("When executing a sync query, then it is posted as a form with the token in the authorization header", func(t *testing.T) {
+		rest.Client = server.Client()
+
+		query := requests.NewQuery("secret-token", "*", requests.ResourceTypes{"items"})
+		response, err := api.ExecuteSyncQuery(query)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "new-token", response.SyncToken)
+		assert.Equal(t, http.MethodPost, receivedRequest.Method)
+		assert.Equal(t, "/sync/v8/sync", receivedRequest.URL.Path)
+		assert.Equal(t, "", receivedRequest.URL.RawQuery)
+		assert.Equal(t, "Bearer secret-token", receivedRequest.Header.Get("Authorization"))
+		assert.Equal(t, "*", receivedRequest.PostForm.Get("sync_token"))
+		assert.Equal(t, `["items"]`, receivedRequest.PostForm.Get("resource_types"))
+		assert.Empty(t, receivedRequest.PostForm.Get("token"))
+	})
+
+	t.Run("When executing a sync command with long content, then it is posted as a form with the token in the authorization header", func(t *testing.T) {
+		rest.Client = server.Client()
+
+		content := strings.Repeat("a very long task ", 1000)
+		command := requests.NewCommand("secret-token", "item_add", map[string]interface{}{"content": content})
+		_, err := api.ExecuteSyncCommand(command)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, receivedRequest.Method)
+		assert.Equal(t, "", receivedRequest.URL.RawQuery)
+		assert.Equal(t, "application/x-www-form-urlencoded", receivedRequest.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer secret-token", receivedRequest.Header.Get("Authorization"))
+		assert.Contains(t, receivedRequest.PostForm.Get("commands"), content)
+		assert.Empty(t, receivedRequest.PostForm.Get("token"))
+	})
+
+	t.Run("When quick adding a task, then the text is posted as a form to the quick add endpoint and the added item is returned", func(t *testing.T) {
+		rest.Client = server.Client()
+		responseBody = `{"id":100,"content":"Buy milk","project_id":10,"priority":3}`
+
+		item, err := api.QuickAdd(requests.NewQuickAdd("secret-token", "Buy milk tomorrow #Groceries p2"))
+
+		assert.Nil(t, err)
+		assert.Equal(t, &responses.Item{TodoistID: 100, Content: "Buy milk", ProjectID: 10, Priority: 3}, item)
+		assert.Equal(t, http.MethodPost, receivedRequest.Method)
+		assert.Equal(t, "/sync/v8/quick/add", receivedRequest.URL.Path)
+		assert.Equal(t, "Bearer secret-token", receivedRequest.Header.Get("Authorization"))
+		assert.Equal(t, "Buy milk tomorrow #Groceries p2", receivedRequest.PostForm.Get("text"))
+	})
+
+	t.Run("When uploading a file, then it is posted as a multipart form to the uploads endpoint and the file attachment is returned", func(t *testing.T) {
+		rest.Client = server.Client()
+		responseBody = `{"file_name":"notes.txt","file_size":8,"file_type":"text/plain","file_url":"https://example.com/notes.txt","upload_state":"completed"}`
+
+		fileAttachment, err := api.UploadFile(requests.NewUpload("secret-token", "notes.txt", []byte("contents")))
+
+		assert.Nil(t, err)
+		assert.Equal(t, &responses.FileAttachment{
+			FileName:    "notes.txt",
+			FileSize:    8,
+			FileType:    "text/plain",
+			FileURL:     "https://example.com/notes.txt",
+			UploadState: "completed",
+		}, fileAttachment)
+		assert.Equal(t, http.MethodPost, receivedRequest.Method)
+		assert.Equal(t, "/sync/v8/uploads/add", receivedRequest.URL.Path)
+		assert.Equal(t, "Bearer secret-token", receivedRequest.Header.Get("Authorization"))
+		assert.Contains(t, receivedRequest.Header.Get("Content-Type"), "multipart/form-data")
+	})
+
+}
+
+// okSyncStatus returns the sync_status Todoist answers with when every command of the batch was executed
+func okSyncStatus(commands []requests.CommandDetail) string {
+	statuses := make(map[string]string)
+	for _, command := range commands {
+		statuses[command.UUID] = "ok"
+	}
+	contents, _ := json.Marshal(statuses)
+	return string(contents)
 }
@@ -0,0 +1,57 @@
+package todoist
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+// CommandError is returned when Todoist rejects an individual command of a sync request
+type CommandError struct {
+	UUID    string
+	Code    int
+	Message string
+
+	// Queued is true when the command had been queued while offline and was sent along with a later command
+	Queued bool
+}
+
+func (e *CommandError) Error() string {
+	command := "the command"
+	if e.Queued {
+		command = "a command queued while offline"
+	}
+
+	if e.Code == 0 {
+		return fmt.Sprintf("Todoist rejected %s: %s", command, e.Message)
+	}
+	return fmt.Sprintf("Todoist rejected %s: %s (error code %d)", command, e.Message, e.Code)
+}
+
+// Kind returns failures.Rejected, Todoist received the command but did not execute it
+func (e *CommandError) Kind() failures.Kind {
+	return failures.Rejected
+}
+
+// CommandErrors contains an error for every command of a sync request that Todoist rejected
+type CommandErrors []*CommandError
+
+func (e CommandErrors) Error() string {
+	messages := make([]string, len(e))
+	for index, commandError := range e {
+		messages[index] = commandError.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Kind returns failures.Rejected so that the commands determine the exit code
+func (e CommandErrors) Kind() failures.Kind {
+	return failures.Rejected
+}
+
+// IsCommandRejection returns true if the error occurred because Todoist rejected commands rather than because Todoist could not be reached
+func IsCommandRejection(err error) bool {
+	_, isCommandRejection := err.(CommandErrors)
+	return isCommandRejection
+}
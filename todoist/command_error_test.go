@@ -0,0 +1,41 @@
+package todoist
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpdowns/todoist-cli/failures"
+)
+
+func TestCommandErrors(t *testing.T) {
+
+	t.Run("Given several rejected commands, when converting to a string, then every rejection is described on its own line", func(t *testing.T) {
+		err := CommandErrors{
+			{UUID: "uuid-1", Code: 36, Message: "Date is invalid"},
+			{UUID: "uuid-2", Code: 22, Message: "Item not found"},
+		}
+
+		assert.Equal(t, "Todoist rejected the command: Date is invalid (error code 36)\nTodoist rejected the command: Item not found (error code 22)", err.Error())
+	})
+
+	t.Run("Given a rejected command that was queued or has no error code, when converting to a string, then it is described as such", func(t *testing.T) {
+		assert.Equal(t, "Todoist rejected a command queued while offline: Item not found (error code 22)", (&CommandError{Code: 22, Message: "Item not found", Queued: true}).Error())
+		assert.Equal(t, "Todoist rejected the command: Todoist did not report the result of the command", (&CommandError{Message: errorMissingCommandStatus}).Error())
+	})
+
+	t.Run("Given an error, when checking whether it is a command rejection, then only command errors are rejections", func(t *testing.T) {
+		assert.True(t, IsCommandRejection(CommandErrors{}))
+		assert.False(t, IsCommandRejection(ErrUnreachable))
+		assert.False(t, IsCommandRejection(errors.New("test error")))
+		assert.False(t, IsCommandRejection(nil))
+	})
+
+	t.Run("Given rejected commands, when checking the kind of error, then they are rejections", func(t *testing.T) {
+		assert.Equal(t, failures.Rejected, failures.KindOf(CommandErrors{}))
+		assert.Equal(t, failures.Rejected, failures.KindOf(&CommandError{}))
+		assert.Equal(t, failures.Network, failures.KindOf(ErrUnreachable))
+	})
+
+}
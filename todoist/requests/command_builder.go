@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"github.com/beevik/guid"
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+)
+
+// MaximumCommandsPerRequest is the number of commands Todoist accepts in a single sync request
+const MaximumCommandsPerRequest = 100
+
+// CommandBuilder accumulates commands so that they can be sent to Todoist in a single sync request
+type CommandBuilder struct {
+	token    string
+	commands []CommandDetail
+}
+
+// NewCommandBuilder creates a new instance of a command builder for the provided access token
+func NewCommandBuilder(token string) *CommandBuilder {
+	return &CommandBuilder{
+		token: token,
+	}
+}
+
+// Add appends a command and returns its temporary id, which can be used as an argument of later commands to reference the resource it creates
+func (b *CommandBuilder) Add(commandType commands.CommandType, arguments map[string]interface{}) string {
+	temporaryID := guid.NewString()
+	b.commands = append(b.commands, CommandDetail{
+		Type:        commandType,
+		TemporaryID: temporaryID,
+		UUID:        guid.NewString(),
+		Arguments:   arguments,
+	})
+	return temporaryID
+}
+
+// Len returns the number of commands that have been added
+func (b *CommandBuilder) Len() int {
+	return len(b.commands)
+}
+
+// Build creates a command containing all of the commands that have been added, in the order they were added
+func (b *CommandBuilder) Build() Command {
+	return Command{
+		Token:    b.token,
+		Commands: b.commands,
+	}
+}
@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildingCommands(t *testing.T) {
+
+	t.Run("Given several commands, when building, then a single command containing all of them in order is created", func(t *testing.T) {
+		builder := NewCommandBuilder("token")
+		builder.Add(commands.ItemClose, map[string]interface{}{"id": 1})
+		builder.Add(commands.ItemClose, map[string]interface{}{"id": 2})
+
+		command := builder.Build()
+
+		assert.Equal(t, 2, builder.Len())
+		assert.Equal(t, "token", command.Token)
+		if assert.Len(t, command.Commands, 2) {
+			assert.Equal(t, 1, command.Commands[0].Arguments["id"])
+			assert.Equal(t, 2, command.Commands[1].Arguments["id"])
+			assert.NotEqual(t, command.Commands[0].UUID, command.Commands[1].UUID)
+		}
+	})
+
+	t.Run("Given a command creating a resource, when adding it, then its temporary id can be referenced by later commands", func(t *testing.T) {
+		builder := NewCommandBuilder("token")
+		projectTemporaryID := builder.Add(commands.ProjectAdd, map[string]interface{}{"name": "Work"})
+		builder.Add(commands.ItemAdd, map[string]interface{}{"content": "test", "project_id": projectTemporaryID})
+
+		command := builder.Build()
+
+		assert.NotEmpty(t, projectTemporaryID)
+		assert.Equal(t, projectTemporaryID, command.Commands[0].TemporaryID)
+		assert.Equal(t, projectTemporaryID, command.Commands[1].Arguments["project_id"])
+	})
+
+}
@@ -4,9 +4,60 @@ package commands
 type CommandType string
 
 const (
-	// ItemClose is a command that marks a task as completed, it is a simplified version of item_complete
+	// ItemClose is a command that marks a task as completed, it is a simplified version of item_complete. Recurring tasks are rescheduled to their next occurrence instead.
 	ItemClose CommandType = CommandType("item_close")
 
+	// ItemComplete is a command that marks a task and its sub-tasks as completed, recurring tasks are completed rather than rescheduled
+	ItemComplete CommandType = CommandType("item_complete")
+
+	// ItemUncomplete is a command that marks a completed task as uncompleted again
+	ItemUncomplete CommandType = CommandType("item_uncomplete")
+
 	// ItemAdd is a command that adds an item based on the arguments provided
 	ItemAdd CommandType = CommandType("item_add")
+
+	// ItemUpdate is a command that updates the properties of an existing item
+	ItemUpdate CommandType = CommandType("item_update")
+
+	// ItemDelete is a command that deletes an item and all of its sub-items
+	ItemDelete CommandType = CommandType("item_delete")
+
+	// ItemMove is a command that moves an item and its sub-items to another project, section or parent item
+	ItemMove CommandType = CommandType("item_move")
+
+	// ItemReorder is a command that changes the order of items among their siblings
+	ItemReorder CommandType = CommandType("item_reorder")
+
+	// ItemUpdateDayOrders is a command that changes the order of items in the Today and Next 7 days views
+	ItemUpdateDayOrders CommandType = CommandType("item_update_day_orders")
+
+	// ProjectAdd is a command that adds a project based on the arguments provided
+	ProjectAdd CommandType = CommandType("project_add")
+
+	// ProjectUpdate is a command that updates the properties of an existing project
+	ProjectUpdate CommandType = CommandType("project_update")
+
+	// ProjectDelete is a command that deletes a project and all of its tasks
+	ProjectDelete CommandType = CommandType("project_delete")
+
+	// ProjectArchive is a command that archives a project
+	ProjectArchive CommandType = CommandType("project_archive")
+
+	// ProjectUnarchive is a command that restores an archived project
+	ProjectUnarchive CommandType = CommandType("project_unarchive")
+
+	// LabelAdd is a command that adds a personal label
+	LabelAdd CommandType = CommandType("label_add")
+
+	// LabelUpdate is a command that updates the properties of an existing label
+	LabelUpdate CommandType = CommandType("label_update")
+
+	// LabelDelete is a command that deletes a label and removes it from all tasks
+	LabelDelete CommandType = CommandType("label_delete")
+
+	// NoteAdd is a command that posts a comment on a task, optionally with a file attachment that has been uploaded
+	NoteAdd CommandType = CommandType("note_add")
+
+	// NoteDelete is a command that deletes a comment
+	NoteDelete CommandType = CommandType("note_delete")
 )
@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"time"
+)
+
+// Deadline is the representation of a deadline sent to Todoist, Todoist only accepts dates without a time as deadlines
+type Deadline struct {
+	Date string `json:"date"`
+	Lang string `json:"lang,omitempty"`
+}
+
+// NewDeadline creates the deadline sent to Todoist for a date provided by the user as YYYY-MM-DD. An empty value returns nil, which removes
+// the deadline of a task, and an error is returned when the value is not a date.
+func NewDeadline(value string) (*Deadline, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Deadline{Date: date.Format("2006-01-02")}, nil
+}
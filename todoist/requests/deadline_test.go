@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatingDeadlines(t *testing.T) {
+
+	t.Run("Given a date, when creating a deadline, then the date is sent", func(t *testing.T) {
+		deadline, err := NewDeadline("2020-05-15")
+		assert.Nil(t, err)
+		assert.Equal(t, &Deadline{Date: "2020-05-15"}, deadline)
+
+		serialized, _ := json.Marshal(deadline)
+		assert.Equal(t, `{"date":"2020-05-15"}`, string(serialized))
+	})
+
+	t.Run("Given no date, when creating a deadline, then there is no deadline", func(t *testing.T) {
+		deadline, err := NewDeadline("")
+		assert.Nil(t, err)
+		assert.Nil(t, deadline)
+	})
+
+	t.Run("Given something other than a date, when creating a deadline, then an error is returned", func(t *testing.T) {
+		for _, value := range []string{"tomorrow", "2020-05-15T17:00"} {
+			_, err := NewDeadline(value)
+			assert.NotNil(t, err)
+		}
+	})
+
+}
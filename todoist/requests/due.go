@@ -1,6 +1,44 @@
 package requests
 
-// Due is the representation of a due date (only supports simple plain-text dates)
+import (
+	"time"
+)
+
+// Due is the representation of a due date sent to Todoist. Either Value is set to a plain-text date for Todoist to interpret, e.g. "every day at 5pm",
+// or Date is set to a date, a floating date and time that is due at that time wherever the user is, or a fixed date and time in UTC.
+// Todoist treats fixed dates without a Timezone as being in the timezone of the user.
 type Due struct {
-	Value string `json:"string"`
+	Value       string `json:"string,omitempty"`
+	Date        string `json:"date,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
+	Lang        string `json:"lang,omitempty"`
+	IsRecurring bool   `json:"is_recurring,omitempty"`
+}
+
+var floatingDateFormats = []string{"2006-01-02", "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04"}
+
+// NewDue creates the due date sent to Todoist for a date provided by the user. Dates such as 2020-04-10 or 2020-04-10T17:00 are sent as floating
+// dates, dates with a timezone offset such as 2020-04-10T17:00:00+02:00 are sent as fixed dates in UTC, and anything else is sent as plain-text.
+// An empty value returns nil, which removes the due date of a task.
+func NewDue(value string) *Due {
+	if value == "" {
+		return nil
+	}
+
+	for _, format := range floatingDateFormats {
+		date, err := time.Parse(format, value)
+		if err == nil {
+			if format == floatingDateFormats[0] {
+				return &Due{Date: date.Format("2006-01-02")}
+			}
+			return &Due{Date: date.Format("2006-01-02T15:04:05")}
+		}
+	}
+
+	fixedDate, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return &Due{Date: fixedDate.UTC().Format("2006-01-02T15:04:05Z")}
+	}
+
+	return &Due{Value: value}
 }
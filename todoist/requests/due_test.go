@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatingDueDates(t *testing.T) {
+
+	var valuesToTest = []struct {
+		value       string
+		expectedDue *Due
+	}{
+		{"", nil},
+		{"tomorrow 5pm", &Due{Value: "tomorrow 5pm"}},
+		{"every day", &Due{Value: "every day"}},
+		{"2020-04-10", &Due{Date: "2020-04-10"}},
+		{"2020-04-10T17:00", &Due{Date: "2020-04-10T17:00:00"}},
+		{"2020-04-10 17:00", &Due{Date: "2020-04-10T17:00:00"}},
+		{"2020-04-10T17:00:30", &Due{Date: "2020-04-10T17:00:30"}},
+		{"2020-04-10T17:00:00+02:00", &Due{Date: "2020-04-10T15:00:00Z"}},
+		{"2020-04-10T15:00:00Z", &Due{Date: "2020-04-10T15:00:00Z"}},
+	}
+
+	for _, valueToTest := range valuesToTest {
+		valueToTest := valueToTest
+
+		t.Run("Given '"+valueToTest.value+"', when creating a due date, then exact dates are sent as dates and anything else as plain-text", func(t *testing.T) {
+			assert.Equal(t, valueToTest.expectedDue, NewDue(valueToTest.value))
+		})
+	}
+
+	t.Run("Given a due date, when serializing it, then only the fields that are set are sent", func(t *testing.T) {
+		serialized, _ := json.Marshal(NewDue("2020-04-10"))
+		assert.Equal(t, `{"date":"2020-04-10"}`, string(serialized))
+
+		serialized, _ = json.Marshal(NewDue("every day"))
+		assert.Equal(t, `{"string":"every day"}`, string(serialized))
+	})
+
+}
@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"net/url"
+)
+
+// QuickAdd is a request to add a task from a line of text the way Todoist's quick add does, the text can contain a due date,
+// #project, @labels and a priority
+type QuickAdd struct {
+	Token string
+	Text  string
+}
+
+// NewQuickAdd creates a new instance of a QuickAdd request
+func NewQuickAdd(token string, text string) QuickAdd {
+	return QuickAdd{
+		Token: token,
+		Text:  text,
+	}
+}
+
+// ToFormValues converts the QuickAdd into the form values sent in the body of requests to Todoist, the token is sent in the authorization header instead
+func (q *QuickAdd) ToFormValues() url.Values {
+	return url.Values{
+		"text": {q.Text},
+	}
+}
@@ -0,0 +1,21 @@
+package requests
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuickAddSerialization(t *testing.T) {
+	t.Run("Given a quick add request, when converting it to form values, the text is included but the token is not", func(t *testing.T) {
+		quickAdd := NewQuickAdd("token", "Buy milk tomorrow #Groceries p2")
+
+		expected := url.Values{
+			"text": {"Buy milk tomorrow #Groceries p2"},
+		}
+		actual := quickAdd.ToFormValues()
+
+		assert.Equal(t, expected, actual)
+	})
+}
@@ -2,10 +2,8 @@ package requests
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/url"
 
-	"github.com/beevik/guid"
 	"github.com/kpdowns/todoist-cli/todoist/requests/commands"
 )
 
@@ -25,23 +23,67 @@ type CommandDetail struct {
 
 // NewCommand creates a new instance of a Todoist Sync Command
 func NewCommand(token string, commandType commands.CommandType, arguments map[string]interface{}) Command {
-	return Command{
-		Token: token,
-		Commands: []CommandDetail{
-			{
-				Type:        commandType,
-				TemporaryID: guid.NewString(),
-				UUID:        guid.NewString(),
-				Arguments:   arguments,
-			},
-		},
+	builder := NewCommandBuilder(token)
+	builder.Add(commandType, arguments)
+	return builder.Build()
+}
+
+// Split divides the commands into requests of at most the provided size, keeping them in order
+func (c *Command) Split(size int) []Command {
+	var batches []Command
+	for start := 0; start < len(c.Commands); start += size {
+		end := start + size
+		if end > len(c.Commands) {
+			end = len(c.Commands)
+		}
+		batches = append(batches, Command{
+			Token:    c.Token,
+			Commands: c.Commands[start:end],
+		})
+	}
+	return batches
+}
+
+// ResolveTemporaryIDs returns a copy of the command where arguments referencing a temporary id that Todoist has already mapped are replaced by the real id,
+// including references in lists such as the labels of an item
+func (c *Command) ResolveTemporaryIDs(temporaryIDMapping map[string]int64) Command {
+	resolved := Command{
+		Token:    c.Token,
+		Commands: make([]CommandDetail, len(c.Commands)),
+	}
+
+	for index, commandDetail := range c.Commands {
+		arguments := make(map[string]interface{}, len(commandDetail.Arguments))
+		for key, value := range commandDetail.Arguments {
+			arguments[key] = resolveTemporaryID(value, temporaryIDMapping)
+			if references, ok := value.([]interface{}); ok {
+				resolvedReferences := make([]interface{}, len(references))
+				for index, reference := range references {
+					resolvedReferences[index] = resolveTemporaryID(reference, temporaryIDMapping)
+				}
+				arguments[key] = resolvedReferences
+			}
+		}
+		commandDetail.Arguments = arguments
+		resolved.Commands[index] = commandDetail
+	}
+
+	return resolved
+}
+
+func resolveTemporaryID(value interface{}, temporaryIDMapping map[string]int64) interface{} {
+	if temporaryID, ok := value.(string); ok {
+		if todoistID, ok := temporaryIDMapping[temporaryID]; ok {
+			return todoistID
+		}
 	}
+	return value
 }
 
-// ToQueryString generates a query string to be provided as part of the URL in a sync command
-func (c *Command) ToQueryString() string {
+// ToFormValues generates the form values sent in the body of a sync command, the commands are a JSON array and the token is sent in the authorization header instead
+func (c *Command) ToFormValues() url.Values {
 	commandStringAsJSON, _ := json.Marshal(c.Commands)
-	commandString := string(commandStringAsJSON)
-	commandStringAsQueryString := url.QueryEscape(commandString)
-	return fmt.Sprintf("token=%s&commands=%s", c.Token, commandStringAsQueryString)
+	return url.Values{
+		"commands": {string(commandStringAsJSON)},
+	}
 }
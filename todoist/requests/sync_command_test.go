@@ -12,7 +12,7 @@ import (
 
 func TestSyncCommandSerialization(t *testing.T) {
 
-	t.Run("Given a command, when converting the command to query string, the token is a parameter, and the commands are a URL escaped JSON object", func(t *testing.T) {
+	t.Run("Given a command, when converting the command to form values, the commands are a JSON array and the token is not included", func(t *testing.T) {
 		arguments := make(map[string]interface{})
 		arguments["color"] = 1
 		arguments["name"] = "project1"
@@ -24,12 +24,55 @@ func TestSyncCommandSerialization(t *testing.T) {
 		command.Commands[0].TemporaryID = tempID
 		command.Commands[0].UUID = uuid
 
-		commandString := fmt.Sprintf(`[{"type":"project_add","temp_id":"%s","uuid":"%s","args":{"color":1,"name":"project1"}}]`, tempID, uuid)
-		urlEscaptedCommandString := url.QueryEscape(commandString)
-
-		expected := fmt.Sprintf(`token=token&commands=%s`, urlEscaptedCommandString)
-		actual := command.ToQueryString()
+		expected := url.Values{
+			"commands": {fmt.Sprintf(`[{"type":"project_add","temp_id":"%s","uuid":"%s","args":{"color":1,"name":"project1"}}]`, tempID, uuid)},
+		}
+		actual := command.ToFormValues()
 
 		assert.Equal(t, expected, actual)
 	})
 }
+
+func TestSplittingSyncCommands(t *testing.T) {
+
+	t.Run("Given more commands than fit in a request, when splitting, then the commands are divided into ordered batches", func(t *testing.T) {
+		builder := NewCommandBuilder("token")
+		for index := 0; index < 5; index++ {
+			builder.Add("item_close", map[string]interface{}{"id": index})
+		}
+		command := builder.Build()
+
+		batches := command.Split(2)
+
+		if assert.Len(t, batches, 3) {
+			assert.Equal(t, command.Commands[0:2], batches[0].Commands)
+			assert.Equal(t, command.Commands[2:4], batches[1].Commands)
+			assert.Equal(t, command.Commands[4:5], batches[2].Commands)
+			assert.Equal(t, "token", batches[2].Token)
+		}
+	})
+
+	t.Run("Given no commands, when splitting, then there are no batches", func(t *testing.T) {
+		command := Command{Token: "token"}
+		assert.Empty(t, command.Split(2))
+	})
+
+}
+
+func TestResolvingTemporaryIDs(t *testing.T) {
+	command := Command{
+		Token: "token",
+		Commands: []CommandDetail{
+			{Type: "item_add", Arguments: map[string]interface{}{"content": "test", "project_id": "temp-1"}},
+			{Type: "item_close", Arguments: map[string]interface{}{"id": "temp-2"}},
+			{Type: "item_update", Arguments: map[string]interface{}{"id": int64(1), "labels": []interface{}{int64(200), "temp-1", "temp-2"}}},
+		},
+	}
+
+	resolved := command.ResolveTemporaryIDs(map[string]int64{"temp-1": 100})
+
+	assert.Equal(t, map[string]interface{}{"content": "test", "project_id": int64(100)}, resolved.Commands[0].Arguments)
+	assert.Equal(t, map[string]interface{}{"id": "temp-2"}, resolved.Commands[1].Arguments)
+	assert.Equal(t, []interface{}{int64(200), int64(100), "temp-2"}, resolved.Commands[2].Arguments["labels"])
+	assert.Equal(t, "temp-1", command.Commands[0].Arguments["project_id"])
+}
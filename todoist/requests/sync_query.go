@@ -1,7 +1,7 @@
 package requests
 
 import (
-	"fmt"
+	"net/url"
 )
 
 // Query is a type of query that can be made against the Todoist API
@@ -20,7 +20,10 @@ func NewQuery(token string, syncToken string, resourceTypes ResourceTypes) Query
 	}
 }
 
-// ToQueryString converts the Query into a url query string to be provided on requests to Todoist
-func (q *Query) ToQueryString() string {
-	return fmt.Sprintf(`token=%s&sync_token=%s&resource_types=%s`, q.Token, q.SyncToken, q.ResourceTypes.ToString())
+// ToFormValues converts the Query into the form values sent in the body of requests to Todoist, the token is sent in the authorization header instead
+func (q *Query) ToFormValues() url.Values {
+	return url.Values{
+		"sync_token":     {q.SyncToken},
+		"resource_types": {q.ResourceTypes.ToString()},
+	}
 }
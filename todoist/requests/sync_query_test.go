@@ -1,19 +1,22 @@
 package requests
 
 import (
-	"fmt"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestSyncQuerySerialization(t *testing.T) {
-	t.Run("Given a sync query, when converting the query to a query string, the string is a valid query string", func(t *testing.T) {
+	t.Run("Given a sync query, when converting the query to form values, the sync token and resource types are included but the token is not", func(t *testing.T) {
 		resourceTypes := ResourceTypes{"all"}
 		syncQuery := NewQuery("token", "sync_token", resourceTypes)
 
-		expected := fmt.Sprintf(`token=token&sync_token=sync_token&resource_types=%s`, resourceTypes.ToString())
-		actual := syncQuery.ToQueryString()
+		expected := url.Values{
+			"sync_token":     {"sync_token"},
+			"resource_types": {resourceTypes.ToString()},
+		}
+		actual := syncQuery.ToFormValues()
 
 		assert.Equal(t, expected, actual)
 	})
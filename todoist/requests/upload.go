@@ -0,0 +1,51 @@
+package requests
+
+import (
+	"bytes"
+	"mime/multipart"
+)
+
+// Upload is a request to upload a file to Todoist so that it can be attached to a comment
+type Upload struct {
+	Token    string
+	FileName string
+	Contents []byte
+}
+
+// NewUpload creates a new instance of an Upload request
+func NewUpload(token string, fileName string, contents []byte) Upload {
+	return Upload{
+		Token:    token,
+		FileName: fileName,
+		Contents: contents,
+	}
+}
+
+// ToMultipartBody converts the Upload into the multipart form sent in the body of requests to Todoist along with its content type,
+// the token is sent in the authorization header instead
+func (u *Upload) ToMultipartBody() (*bytes.Buffer, string, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	err := writer.WriteField("file_name", u.FileName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	part, err := writer.CreateFormFile("file", u.FileName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = part.Write(u.Contents)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadSerialization(t *testing.T) {
+	t.Run("Given an upload request, when converting it to a multipart body, the file name and contents are included but the token is not", func(t *testing.T) {
+		upload := NewUpload("token", "notes.txt", []byte("contents"))
+
+		body, contentType, err := upload.ToMultipartBody()
+		assert.Nil(t, err)
+
+		mediaType, parameters, _ := mime.ParseMediaType(contentType)
+		assert.Equal(t, "multipart/form-data", mediaType)
+
+		form, err := multipart.NewReader(body, parameters["boundary"]).ReadForm(1024)
+		if assert.Nil(t, err) {
+			assert.Equal(t, []string{"notes.txt"}, form.Value["file_name"])
+			assert.Nil(t, form.Value["token"])
+			if assert.Len(t, form.File["file"], 1) {
+				assert.Equal(t, "notes.txt", form.File["file"][0].Filename)
+				file, _ := form.File["file"][0].Open()
+				contents, _ := ioutil.ReadAll(file)
+				assert.Equal(t, "contents", string(contents))
+			}
+		}
+	})
+}
@@ -0,0 +1,9 @@
+package responses
+
+// Collaborator is a person sharing at least one project with the user, tasks in shared projects can be assigned to them
+type Collaborator struct {
+	TodoistID int64  `json:"id"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	IsDeleted int16  `json:"is_deleted"`
+}
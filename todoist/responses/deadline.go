@@ -0,0 +1,14 @@
+package responses
+
+import "time"
+
+// Deadline is the date an item has to be done by at the latest. Unlike the due date it is always a date without a time and it does not recur.
+type Deadline struct {
+	Date string `json:"date"`
+	Lang string `json:"lang"`
+}
+
+// Time returns the date of the deadline at midnight in UTC, so that it can be compared with calendar days
+func (d *Deadline) Time() (time.Time, error) {
+	return time.Parse("2006-01-02", d.Date)
+}
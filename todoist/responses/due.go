@@ -1,6 +1,48 @@
 package responses
 
-// Due is the due date
+import (
+	"fmt"
+	"time"
+)
+
+const errorInvalidDueDate = "'%s' is not a due date that can be read"
+
+// Due is the due date of an item. The date is either a date, e.g. 2020-04-10, a floating date and time without a timezone that is due at
+// that time wherever the user is, e.g. 2020-04-10T17:00:00, or a fixed date and time in UTC, e.g. 2020-04-10T15:00:00Z, along with the
+// Timezone it was set in. Newer versions of the Todoist API provide dates with a time separately as the datetime.
 type Due struct {
-	DateString string `json:"date"`
+	DateString     string `json:"date"`
+	DatetimeString string `json:"datetime,omitempty"`
+	Timezone       string `json:"timezone"`
+	String         string `json:"string"`
+	Lang           string `json:"lang"`
+	IsRecurring    bool   `json:"is_recurring"`
+}
+
+// Time returns the wall clock date and time the item is due at in the location, in UTC so that it can be compared with calendar days.
+// Fixed dates are converted to the location while floating dates are due at the same wall clock time in every location.
+// hasTime is false for dates without a time, which are returned at midnight.
+func (d *Due) Time(location *time.Location) (due time.Time, hasTime bool, err error) {
+	value := d.DateString
+	if d.DatetimeString != "" {
+		value = d.DatetimeString
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err == nil {
+		return date, false, nil
+	}
+
+	fixedDate, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		localDate := fixedDate.In(location)
+		return time.Date(localDate.Year(), localDate.Month(), localDate.Day(), localDate.Hour(), localDate.Minute(), localDate.Second(), 0, time.UTC), true, nil
+	}
+
+	floatingDate, err := time.Parse("2006-01-02T15:04:05", value)
+	if err == nil {
+		return floatingDate, true, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf(errorInvalidDueDate, value)
 }
@@ -0,0 +1,78 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadingDueDates(t *testing.T) {
+
+	madrid, _ := time.LoadLocation("Europe/Madrid")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+
+	var duesToTest = []struct {
+		description     string
+		due             Due
+		location        *time.Location
+		expectedTime    time.Time
+		expectedHasTime bool
+	}{
+		{"a date", Due{DateString: "2020-04-10"}, madrid, time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC), false},
+		{"a floating date and time", Due{DateString: "2020-04-10T17:00:00"}, madrid, time.Date(2020, 4, 10, 17, 0, 0, 0, time.UTC), true},
+		{"a floating date and time in another location", Due{DateString: "2020-04-10T17:00:00"}, tokyo, time.Date(2020, 4, 10, 17, 0, 0, 0, time.UTC), true},
+		{"a fixed date and time", Due{DateString: "2020-04-10T15:00:00Z", Timezone: "Europe/Madrid"}, madrid, time.Date(2020, 4, 10, 17, 0, 0, 0, time.UTC), true},
+		{"a fixed date and time in another location", Due{DateString: "2020-04-10T15:00:00Z", Timezone: "Europe/Madrid"}, tokyo, time.Date(2020, 4, 11, 0, 0, 0, 0, time.UTC), true},
+		{"a datetime provided separately from the date", Due{DateString: "2020-04-10", DatetimeString: "2020-04-10T17:00:00"}, madrid, time.Date(2020, 4, 10, 17, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, dueToTest := range duesToTest {
+		dueToTest := dueToTest
+
+		t.Run("Given "+dueToTest.description+", when reading it, then the wall clock time it is due at in the location is returned", func(t *testing.T) {
+			dueTime, hasTime, err := dueToTest.due.Time(dueToTest.location)
+			assert.Nil(t, err)
+			assert.Equal(t, dueToTest.expectedTime, dueTime)
+			assert.Equal(t, dueToTest.expectedHasTime, hasTime)
+		})
+	}
+
+	t.Run("Given a date that cannot be read, when reading it, then an error is returned", func(t *testing.T) {
+		dueTime, _, err := (&Due{DateString: "someday"}).Time(madrid)
+		assert.NotNil(t, err)
+		assert.True(t, dueTime.IsZero())
+	})
+
+	t.Run("Given an item with a recurring due date that cannot be read, when converting it to a task, then the task has no date but keeps the recurrence", func(t *testing.T) {
+		item := Item{Due: &Due{DateString: "someday", String: "every day", Lang: "en", IsRecurring: true}}
+
+		task := item.ToTask(madrid)
+		assert.False(t, task.HasDueDate())
+		assert.Equal(t, "every day", task.DueString)
+		assert.Equal(t, "en", task.DueLang)
+		assert.True(t, task.IsRecurring)
+	})
+
+	t.Run("Given an item with a deadline, when converting it to a task, then the task has the deadline", func(t *testing.T) {
+		item := Item{Deadline: &Deadline{Date: "2020-05-15", Lang: "en"}}
+
+		task := item.ToTask(madrid)
+		assert.True(t, task.HasDeadline())
+		assert.Equal(t, time.Date(2020, 5, 15, 0, 0, 0, 0, time.UTC), task.Deadline)
+	})
+
+	t.Run("Given an item with a deadline that cannot be read, when converting it to a task, then the task has no deadline", func(t *testing.T) {
+		item := Item{Deadline: &Deadline{Date: "someday"}}
+
+		task := item.ToTask(madrid)
+		assert.False(t, task.HasDeadline())
+	})
+
+}
+
+func TestLocatingTheUser(t *testing.T) {
+	assert.Equal(t, "Europe/Madrid", (&User{TzInfo: TzInfo{Timezone: "Europe/Madrid"}}).Location().String())
+	assert.Equal(t, time.Local, (&User{}).Location())
+	assert.Equal(t, time.Local, (&User{TzInfo: TzInfo{Timezone: "Not/AZone"}}).Location())
+}
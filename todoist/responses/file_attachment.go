@@ -0,0 +1,11 @@
+package responses
+
+// FileAttachment is a file uploaded to Todoist that is attached to a comment
+type FileAttachment struct {
+	FileName     string `json:"file_name"`
+	FileSize     int64  `json:"file_size"`
+	FileType     string `json:"file_type"`
+	FileURL      string `json:"file_url"`
+	ResourceType string `json:"resource_type"`
+	UploadState  string `json:"upload_state"`
+}
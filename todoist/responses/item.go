@@ -8,29 +8,63 @@ import (
 
 // Item is a task on Todoist
 type Item struct {
-	TodoistID int64  `json:"id"`
-	DayOrder  int32  `json:"day_order"`
-	Checked   int16  `json:"checked"`
-	Content   string `json:"content"`
-	Due       *Due   `json:"due"`
-	Priority  int16  `json:"priority"`
+	TodoistID      int64     `json:"id"`
+	TemporaryID    string    `json:"temp_id,omitempty"`
+	ProjectID      int64     `json:"project_id"`
+	SectionID      int64     `json:"section_id"`
+	ParentID       int64     `json:"parent_id"`
+	ChildOrder     int32     `json:"child_order"`
+	DayOrder       int32     `json:"day_order"`
+	Checked        int16     `json:"checked"`
+	Content        string    `json:"content"`
+	Description    string    `json:"description"`
+	Due            *Due      `json:"due"`
+	Deadline       *Deadline `json:"deadline"`
+	Priority       int16     `json:"priority"`
+	Labels         []int64   `json:"labels"`
+	ResponsibleUID int64     `json:"responsible_uid"`
+	IsDeleted      int16     `json:"is_deleted"`
 }
 
-// ToTask converts the item into a domain task
-func (i *Item) ToTask() types.Task {
-	dateFormat := "2006-01-02"
-	dueDate, err := time.Parse(dateFormat, i.Due.DateString)
-	if err != nil {
-		dueDate = time.Now()
+// IsRecurring returns true if the item is due repeatedly, closing a recurring item reschedules it to its next occurrence
+func (i *Item) IsRecurring() bool {
+	return i.Due != nil && i.Due.IsRecurring
+}
+
+// ToTask converts the item into a domain task with fixed due dates converted to the location, tasks without a due date have a zero due date.
+// Due dates that cannot be read are left without a date but keep the plain-text date they were created with, deadlines that cannot be read are left out.
+func (i *Item) ToTask(location *time.Location) types.Task {
+	var dueDate time.Time
+	var dueHasTime bool
+	if i.Due != nil {
+		dueDate, dueHasTime, _ = i.Due.Time(location)
 	}
 
 	newTask := types.Task{
-		Checked:   i.Checked,
-		Content:   i.Content,
-		DayOrder:  i.DayOrder,
-		DueDate:   dueDate,
-		Priority:  i.Priority,
-		TodoistID: i.TodoistID,
+		Checked:       i.Checked,
+		Content:       i.Content,
+		Description:   i.Description,
+		DayOrder:      i.DayOrder,
+		DueDate:       dueDate,
+		Priority:      i.Priority,
+		TodoistID:     i.TodoistID,
+		TemporaryID:   i.TemporaryID,
+		ProjectID:     i.ProjectID,
+		SectionID:     i.SectionID,
+		ParentID:      i.ParentID,
+		ChildOrder:    i.ChildOrder,
+		ResponsibleID: i.ResponsibleUID,
+	}
+
+	if i.Due != nil {
+		newTask.DueHasTime = dueHasTime
+		newTask.DueTimezone = i.Due.Timezone
+		newTask.DueString = i.Due.String
+		newTask.DueLang = i.Due.Lang
+		newTask.IsRecurring = i.Due.IsRecurring
+	}
+	if i.Deadline != nil {
+		newTask.Deadline, _ = i.Deadline.Time()
 	}
 
 	return newTask
@@ -0,0 +1,28 @@
+package responses
+
+import (
+	"github.com/kpdowns/todoist-cli/labels/types"
+)
+
+// Label is a personal label on Todoist that can be attached to tasks
+type Label struct {
+	TodoistID   int64  `json:"id"`
+	TemporaryID string `json:"temp_id,omitempty"`
+	Name        string `json:"name"`
+	Color       int32  `json:"color"`
+	ItemOrder   int32  `json:"item_order"`
+	IsFavorite  int16  `json:"is_favorite"`
+	IsDeleted   int16  `json:"is_deleted"`
+}
+
+// ToLabel converts the Todoist label into a domain label
+func (l *Label) ToLabel() types.Label {
+	return types.Label{
+		TodoistID:   l.TodoistID,
+		TemporaryID: l.TemporaryID,
+		Name:        l.Name,
+		Color:       l.Color,
+		ItemOrder:   l.ItemOrder,
+		IsFavorite:  l.IsFavorite,
+	}
+}
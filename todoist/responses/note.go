@@ -0,0 +1,44 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/kpdowns/todoist-cli/notes/types"
+)
+
+// Note is a comment on Todoist, item notes are posted on a task and project notes on a project
+type Note struct {
+	TodoistID          int64           `json:"id"`
+	TemporaryID        string          `json:"temp_id,omitempty"`
+	PostedUID          int64           `json:"posted_uid"`
+	ItemID             int64           `json:"item_id"`
+	ItemTemporaryID    string          `json:"item_temp_id,omitempty"`
+	ProjectID          int64           `json:"project_id"`
+	ProjectTemporaryID string          `json:"project_temp_id,omitempty"`
+	Content            string          `json:"content"`
+	FileAttachment     *FileAttachment `json:"file_attachment"`
+	Posted             string          `json:"posted"`
+	IsDeleted          int16           `json:"is_deleted"`
+}
+
+// ToNote converts the Todoist note into a domain note posted at a time in the location, by the collaborator with the name
+// in the provided names. Notes that have not been synced yet have no posted time.
+func (n *Note) ToNote(location *time.Location, collaboratorNames map[int64]string) types.Note {
+	note := types.Note{
+		TodoistID:   n.TodoistID,
+		TemporaryID: n.TemporaryID,
+		Content:     n.Content,
+		PostedBy:    collaboratorNames[n.PostedUID],
+	}
+
+	if posted, err := time.Parse(time.RFC3339, n.Posted); err == nil {
+		note.Posted = posted.In(location)
+	}
+
+	if n.FileAttachment != nil {
+		note.AttachmentName = n.FileAttachment.FileName
+		note.AttachmentURL = n.FileAttachment.FileURL
+	}
+
+	return note
+}
@@ -0,0 +1,43 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kpdowns/todoist-cli/notes/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertingNotes(t *testing.T) {
+
+	t.Run("Given a synced note with an attachment, when converting it, then it is posted in the location by the named collaborator", func(t *testing.T) {
+		location := time.FixedZone("UTC+2", 2*60*60)
+		note := Note{
+			TodoistID:      10,
+			PostedUID:      1,
+			Content:        "Quote",
+			Posted:         "2020-04-10T09:30:00Z",
+			FileAttachment: &FileAttachment{FileName: "quote.pdf", FileURL: "https://example.com/quote.pdf"},
+		}
+
+		converted := note.ToNote(location, map[int64]string{1: "Alice"})
+
+		assert.Equal(t, types.Note{
+			TodoistID:      10,
+			Content:        "Quote",
+			Posted:         time.Date(2020, 4, 10, 11, 30, 0, 0, location),
+			PostedBy:       "Alice",
+			AttachmentName: "quote.pdf",
+			AttachmentURL:  "https://example.com/quote.pdf",
+		}, converted)
+	})
+
+	t.Run("Given a note added while offline, when converting it, then it has no posted time", func(t *testing.T) {
+		note := Note{TemporaryID: "temp-1", Content: "Called the plumber"}
+
+		converted := note.ToNote(time.UTC, map[int64]string{})
+
+		assert.Equal(t, types.Note{TemporaryID: "temp-1", Content: "Called the plumber"}, converted)
+	})
+
+}
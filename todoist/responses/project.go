@@ -0,0 +1,30 @@
+package responses
+
+import (
+	"github.com/kpdowns/todoist-cli/projects/types"
+)
+
+// Project is a project on Todoist
+type Project struct {
+	TodoistID   int64  `json:"id"`
+	TemporaryID string `json:"temp_id,omitempty"`
+	Name        string `json:"name"`
+	Color       int32  `json:"color"`
+	ChildOrder  int32  `json:"child_order"`
+	IsArchived  int16  `json:"is_archived"`
+	IsFavorite  int16  `json:"is_favorite"`
+	IsDeleted   int16  `json:"is_deleted"`
+}
+
+// ToProject converts the Todoist project into a domain project
+func (p *Project) ToProject() types.Project {
+	return types.Project{
+		TodoistID:   p.TodoistID,
+		TemporaryID: p.TemporaryID,
+		Name:        p.Name,
+		Color:       p.Color,
+		ChildOrder:  p.ChildOrder,
+		IsArchived:  p.IsArchived,
+		IsFavorite:  p.IsFavorite,
+	}
+}
@@ -0,0 +1,10 @@
+package responses
+
+// Section is a section within a project on Todoist
+type Section struct {
+	TodoistID    int64  `json:"id"`
+	Name         string `json:"name"`
+	ProjectID    int64  `json:"project_id"`
+	SectionOrder int32  `json:"section_order"`
+	IsDeleted    int16  `json:"is_deleted"`
+}
@@ -0,0 +1,32 @@
+package responses
+
+import (
+	"encoding/json"
+)
+
+const commandStatusOk = "ok"
+
+// Command is the response received as a result of executing sync commands
+type Command struct {
+	SyncStatus    map[string]CommandStatus `json:"sync_status"`
+	TempIDMapping map[string]int64         `json:"temp_id_mapping"`
+}
+
+// CommandStatus is the result of an individual command, Todoist answers either with "ok" or with the error that occurred
+type CommandStatus struct {
+	Ok        bool
+	ErrorCode int    `json:"error_code"`
+	Error     string `json:"error"`
+}
+
+// UnmarshalJSON decodes a command status that is either the string "ok" or an object describing the error
+func (s *CommandStatus) UnmarshalJSON(data []byte) error {
+	var status string
+	if json.Unmarshal(data, &status) == nil {
+		s.Ok = status == commandStatusOk
+		return nil
+	}
+
+	type commandError CommandStatus
+	return json.Unmarshal(data, (*commandError)(s))
+}
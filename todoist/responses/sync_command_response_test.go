@@ -0,0 +1,38 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodingCommandResponses(t *testing.T) {
+
+	t.Run("Given a response with successful and failed commands, when decoding, then the status of each command is available", func(t *testing.T) {
+		body := `{
+			"sync_status": {
+				"uuid-1": "ok",
+				"uuid-2": {"error_code": 15, "error": "Invalid temporary id"}
+			},
+			"temp_id_mapping": {"temp-1": 12345}
+		}`
+
+		var response Command
+		err := json.Unmarshal([]byte(body), &response)
+
+		assert.Nil(t, err)
+		assert.Equal(t, CommandStatus{Ok: true}, response.SyncStatus["uuid-1"])
+		assert.Equal(t, CommandStatus{ErrorCode: 15, Error: "Invalid temporary id"}, response.SyncStatus["uuid-2"])
+		assert.Equal(t, int64(12345), response.TempIDMapping["temp-1"])
+	})
+
+	t.Run("Given a status that is not an object or 'ok', when decoding, then the command is not considered successful", func(t *testing.T) {
+		var status CommandStatus
+		err := json.Unmarshal([]byte(`"unknown"`), &status)
+
+		assert.Nil(t, err)
+		assert.False(t, status.Ok)
+	})
+
+}
@@ -2,7 +2,14 @@ package responses
 
 // Query is the response received as a result of a sync query
 type Query struct {
-	IsFullSync bool   `json:"full_sync"`
-	Items      []Item `json:"items"`
-	SyncToken  string `json:"sync_token"`
+	IsFullSync    bool           `json:"full_sync"`
+	Items         []Item         `json:"items"`
+	Projects      []Project      `json:"projects"`
+	Sections      []Section      `json:"sections"`
+	Labels        []Label        `json:"labels"`
+	User          *User          `json:"user"`
+	Collaborators []Collaborator `json:"collaborators"`
+	Notes         []Note         `json:"notes"`
+	ProjectNotes  []Note         `json:"project_notes"`
+	SyncToken     string         `json:"sync_token"`
 }
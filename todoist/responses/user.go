@@ -0,0 +1,29 @@
+package responses
+
+import (
+	"time"
+)
+
+// User is the Todoist user that is logged in
+type User struct {
+	TodoistID int64  `json:"id"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	TzInfo    TzInfo `json:"tz_info"`
+}
+
+// TzInfo describes the timezone of the user
+type TzInfo struct {
+	Timezone string `json:"timezone"`
+}
+
+// Location returns the timezone of the user, or the timezone of the machine the cli runs on when the timezone of the user is not known
+func (u *User) Location() *time.Location {
+	if u.TzInfo.Timezone != "" {
+		location, err := time.LoadLocation(u.TzInfo.Timezone)
+		if err == nil {
+			return location
+		}
+	}
+	return time.Local
+}